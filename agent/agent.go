@@ -46,6 +46,7 @@ import (
 	rpcRate "github.com/hashicorp/consul/agent/consul/rate"
 	"github.com/hashicorp/consul/agent/consul/servercert"
 	"github.com/hashicorp/consul/agent/dns"
+	"github.com/hashicorp/consul/agent/dns/dnstap"
 	external "github.com/hashicorp/consul/agent/grpc-external"
 	grpcDNS "github.com/hashicorp/consul/agent/grpc-external/services/dns"
 	middleware "github.com/hashicorp/consul/agent/grpc-middleware"
@@ -60,6 +61,7 @@ import (
 	"github.com/hashicorp/consul/agent/structs"
 	"github.com/hashicorp/consul/agent/systemd"
 	"github.com/hashicorp/consul/agent/token"
+	"github.com/hashicorp/consul/agent/workloadapi"
 	"github.com/hashicorp/consul/agent/xds"
 	"github.com/hashicorp/consul/api"
 	"github.com/hashicorp/consul/api/watch"
@@ -231,6 +233,10 @@ type Agent struct {
 	// opposed to the multiplexed "server" port).
 	externalGRPCServer *grpc.Server
 
+	// workloadAPIServer is the gRPC server of the SPIFFE Workload API, served
+	// on a Unix socket when enabled.
+	workloadAPIServer *grpc.Server
+
 	// state stores a local representation of the node,
 	// services and checks. Used for anti-entropy.
 	State *local.State
@@ -293,6 +299,10 @@ type Agent struct {
 	// osServiceClient is the client for performing OS service checks.
 	osServiceClient *checks.OSServiceClient
 
+	// checkScheduler limits how many checks of each type run concurrently
+	// and jitters their intervals.
+	checkScheduler *checks.Scheduler
+
 	// eventCh is used to receive user events
 	eventCh chan serf.UserEvent
 
@@ -325,6 +335,10 @@ type Agent struct {
 	// dnsServer provides the DNS API
 	dnsServers []*DNSServer
 
+	// dnstap is the sink DNS queries are logged to when the dnstap query
+	// log is enabled.
+	dnstap *dnstap.Sink
+
 	// apiServers listening for connections. If any of these server goroutines
 	// fail, the agent will be shutdown.
 	apiServers *apiServers
@@ -456,6 +470,7 @@ func New(bd BaseDeps) (*Agent, error) {
 		cache:           bd.Cache,
 		routineManager:  routine.NewManager(bd.Logger),
 		scadaProvider:   bd.HCP.Provider,
+		checkScheduler:  checks.NewScheduler(bd.RuntimeConfig.CheckConcurrency, bd.RuntimeConfig.CheckJitterPercent),
 	}
 
 	// TODO: create rpcClientHealth in BaseDeps once NetRPC is available without Agent
@@ -759,6 +774,9 @@ func (a *Agent) Start(ctx context.Context) error {
 	// Start handling events.
 	go a.handleEvents()
 
+	// Start enforcing scheduled maintenance windows for this node.
+	go a.enforceMaintenanceWindows()
+
 	// Start sending network coordinate to the server.
 	if !c.DisableCoordinates {
 		go a.sendCoordinate()
@@ -770,6 +788,9 @@ func (a *Agent) Start(ctx context.Context) error {
 	}
 
 	// start DNS servers
+	if err := a.startDNSQueryLog(); err != nil {
+		return err
+	}
 	if err := a.listenAndServeDNS(); err != nil {
 		return err
 	}
@@ -796,6 +817,11 @@ func (a *Agent) Start(ctx context.Context) error {
 		return err
 	}
 
+	// Start the SPIFFE Workload API server.
+	if err := a.listenAndServeWorkloadAPI(); err != nil {
+		return err
+	}
+
 	// Start a goroutine to terminate excess xDS sessions.
 	go a.baseDeps.XDSStreamLimiter.Run(&lib.StopChannelContext{StopCh: a.shutdownCh})
 
@@ -946,9 +972,43 @@ func (a *Agent) listenAndServeGRPC() error {
 	return nil
 }
 
+func (a *Agent) listenAndServeWorkloadAPI() error {
+	path := a.config.ConnectWorkloadAPISocketPath
+	if path == "" {
+		return nil
+	}
+	if !workloadapi.PeerCredentialsSupported {
+		return fmt.Errorf("the SPIFFE Workload API is not supported on this platform")
+	}
+
+	l, err := a.listenSocket(path)
+	if err != nil {
+		return fmt.Errorf("failed to listen on the Workload API socket: %w", err)
+	}
+
+	logger := a.logger.Named(logging.WorkloadAPI)
+	a.workloadAPIServer = grpc.NewServer(grpc.Creds(workloadapi.Credentials()))
+	workloadapi.NewServer(workloadapi.Config{
+		Logger:     logger,
+		Cache:      a.cache,
+		LocalState: a.State,
+		Tokens:     a.tokens,
+		Datacenter: a.config.Datacenter,
+	}).Register(a.workloadAPIServer)
+
+	go func() {
+		logger.Info("Started Workload API server", "path", path)
+		if err := a.workloadAPIServer.Serve(l); err != nil {
+			logger.Error("Workload API server failed", "error", err)
+		}
+	}()
+	return nil
+}
+
 func (a *Agent) listenAndServeDNS() error {
-	notif := make(chan net.Addr, len(a.config.DNSAddrs))
-	errCh := make(chan error, len(a.config.DNSAddrs))
+	numAddrs := len(a.config.DNSAddrs) + len(a.config.DNSTLSAddrs)
+	notif := make(chan net.Addr, numAddrs)
+	errCh := make(chan error, numAddrs)
 	for _, addr := range a.config.DNSAddrs {
 		// create server
 		s, err := NewDNSServer(a)
@@ -967,6 +1027,22 @@ func (a *Agent) listenAndServeDNS() error {
 			}
 		}(addr)
 	}
+	for _, addr := range a.config.DNSTLSAddrs {
+		s, err := NewDNSServer(a)
+		if err != nil {
+			return err
+		}
+		a.dnsServers = append(a.dnsServers, s)
+
+		a.wgServers.Add(1)
+		go func(addr net.Addr) {
+			defer a.wgServers.Done()
+			err := s.ListenAndServeTLS(addr.String(), a.tlsConfigurator.IncomingDNSOverTLSConfig(), func() { notif <- addr })
+			if err != nil && !strings.Contains(err.Error(), "accept") {
+				errCh <- err
+			}
+		}(addr)
+	}
 	s, _ := NewDNSServer(a)
 
 	grpcDNS.NewServer(grpcDNS.Config{
@@ -980,7 +1056,7 @@ func (a *Agent) listenAndServeDNS() error {
 	// wait for servers to be up
 	timeout := time.After(time.Second)
 	var merr *multierror.Error
-	for range a.config.DNSAddrs {
+	for i := 0; i < numAddrs; i++ {
 		select {
 		case addr := <-notif:
 			a.logger.Info("Started DNS server",
@@ -1128,6 +1204,55 @@ func (a *Agent) listenHTTP() ([]apiServer, error) {
 		closeListeners(ln)
 		return nil, err
 	}
+
+	dnsServers, err := a.listenDNSOverHTTPS()
+	if err != nil {
+		closeListeners(ln)
+		return nil, err
+	}
+	return append(servers, dnsServers...), nil
+}
+
+// listenDNSOverHTTPS binds listeners for the DNS over HTTPS addresses and
+// returns pre-configured servers which are not yet started, like listenHTTP.
+func (a *Agent) listenDNSOverHTTPS() ([]apiServer, error) {
+	if len(a.config.DNSHTTPSAddrs) == 0 {
+		return nil, nil
+	}
+
+	listeners, err := a.startListeners(a.config.DNSHTTPSAddrs)
+	if err != nil {
+		return nil, err
+	}
+
+	// A single DNS server handles the queries of all listeners so that it
+	// is reloaded along with the other DNS servers.
+	s, err := NewDNSServer(a)
+	if err != nil {
+		closeListeners(listeners)
+		return nil, err
+	}
+	a.dnsServers = append(a.dnsServers, s)
+
+	var servers []apiServer
+	for _, l := range listeners {
+		tlscfg := a.tlsConfigurator.IncomingHTTPSConfig()
+		l = tls.NewListener(l, tlscfg)
+
+		httpServer := &http.Server{
+			Addr:           l.Addr().String(),
+			TLSConfig:      tlscfg,
+			Handler:        s,
+			MaxHeaderBytes: a.config.HTTPMaxHeaderBytes,
+		}
+		connLimitFn := a.httpConnLimiter.HTTPConnStateFuncWithDefault429Handler(10 * time.Millisecond)
+		if err := setupHTTPS(httpServer, connLimitFn, a.config.HTTPSHandshakeTimeout); err != nil {
+			closeListeners(listeners)
+			return nil, err
+		}
+
+		servers = append(servers, newAPIServerHTTP("dns-https", l, httpServer))
+	}
 	return servers, nil
 }
 
@@ -1510,6 +1635,8 @@ func newConsulConfig(runtimeCfg *config.RuntimeConfig, logger hclog.Logger) (*co
 	cfg.SerfLANConfig = consul.CloneSerfLANConfig(cfg.SerfLANConfig)
 
 	cfg.PeeringEnabled = runtimeCfg.PeeringEnabled
+	cfg.DNSSECEnabled = runtimeCfg.DNSSECEnabled
+	cfg.DNSSECKeyPublishDelay = runtimeCfg.DNSSECKeyPublishDelay
 	cfg.PeeringTestAllowPeerRegistrations = runtimeCfg.PeeringTestAllowPeerRegistrations
 
 	cfg.RequestLimitsMode = runtimeCfg.RequestLimitsMode.String()
@@ -1670,6 +1797,9 @@ func (a *Agent) ShutdownAgent() error {
 	if a.externalGRPCServer != nil {
 		a.externalGRPCServer.Stop()
 	}
+	if a.workloadAPIServer != nil {
+		a.workloadAPIServer.Stop()
+	}
 
 	// Stop the proxy config manager
 	if a.proxyConfig != nil {
@@ -1736,6 +1866,10 @@ func (a *Agent) ShutdownEndpoints() {
 	if err := a.apiServers.WaitForShutdown(); err != nil {
 		a.logger.Error(err.Error())
 	}
+	if a.dnstap != nil {
+		a.dnstap.Close()
+		a.dnstap = nil
+	}
 	a.logger.Info("Endpoints down")
 }
 
@@ -2431,6 +2565,7 @@ func (a *Agent) addServiceInternal(req addServiceInternalRequest) error {
 			ServiceName:    service.Service,
 			ServiceTags:    service.Tags,
 			Type:           chkType.Type(),
+			DependsOn:      chkType.DependsOn,
 			EnterpriseMeta: service.EnterpriseMeta,
 		}
 		if chkType.Status != "" {
@@ -2818,6 +2953,9 @@ func (a *Agent) addCheck(check *structs.HealthCheck, chkType *structs.CheckType,
 	if check.CheckID == "" {
 		return fmt.Errorf("CheckID missing")
 	}
+	if check.DependsOn != "" && check.DependsOn == check.CheckID {
+		return fmt.Errorf("Check %q cannot depend on itself", check.CheckID)
+	}
 
 	if chkType != nil {
 		if err := chkType.Validate(); err != nil {
@@ -2833,6 +2971,10 @@ func (a *Agent) addCheck(check *structs.HealthCheck, chkType *structs.CheckType,
 				return fmt.Errorf("Scripts are disabled on this agent from remote calls; to enable, configure 'enable_script_checks' to true")
 			}
 		}
+
+		if chkType.DynamicWeight && check.ServiceID == "" {
+			return fmt.Errorf("DynamicWeight requires the check to be associated with a service")
+		}
 	}
 
 	if check.ServiceID != "" {
@@ -2870,9 +3012,17 @@ func (a *Agent) addCheck(check *structs.HealthCheck, chkType *structs.CheckType,
 			}
 		}
 
-		statusHandler := checks.NewStatusHandler(a.State, a.logger, chkType.SuccessBeforePassing, chkType.FailuresBeforeWarning, chkType.FailuresBeforeCritical)
 		sid := check.CompoundServiceID()
 
+		// Checks that report a dynamic weight feed it back into the weights
+		// of their service on every update.
+		var checkNotifier checks.CheckNotifier = a.State
+		if chkType.DynamicWeight {
+			checkNotifier = checks.NewWeightHandler(a.State, sid, a.logger)
+		}
+
+		statusHandler := checks.NewStatusHandler(checkNotifier, a.logger, chkType.SuccessBeforePassing, chkType.FailuresBeforeWarning, chkType.FailuresBeforeCritical)
+
 		cid := check.CompoundCheckID()
 
 		switch {
@@ -2884,7 +3034,7 @@ func (a *Agent) addCheck(check *structs.HealthCheck, chkType *structs.CheckType,
 			}
 
 			ttl := &checks.CheckTTL{
-				Notify:        a.State,
+				Notify:        checkNotifier,
 				CheckID:       cid,
 				ServiceID:     sid,
 				TTL:           chkType.TTL,
@@ -2926,12 +3076,14 @@ func (a *Agent) addCheck(check *structs.HealthCheck, chkType *structs.CheckType,
 				Method:           chkType.Method,
 				Body:             chkType.Body,
 				DisableRedirects: chkType.DisableRedirects,
+				DynamicWeight:    chkType.DynamicWeight,
 				Interval:         chkType.Interval,
 				Timeout:          chkType.Timeout,
 				Logger:           a.logger,
 				OutputMaxSize:    maxOutputSize,
 				TLSClientConfig:  tlsClientConfig,
 				StatusHandler:    statusHandler,
+				Scheduler:        a.checkScheduler,
 			}
 
 			if proxy != nil && proxy.Proxy.Expose.Checks {
@@ -2971,6 +3123,7 @@ func (a *Agent) addCheck(check *structs.HealthCheck, chkType *structs.CheckType,
 				Timeout:       chkType.Timeout,
 				Logger:        a.logger,
 				StatusHandler: statusHandler,
+				Scheduler:     a.checkScheduler,
 			}
 			tcp.Start()
 			a.checkTCPs[cid] = tcp
@@ -2996,6 +3149,7 @@ func (a *Agent) addCheck(check *structs.HealthCheck, chkType *structs.CheckType,
 				Timeout:       chkType.Timeout,
 				Logger:        a.logger,
 				StatusHandler: statusHandler,
+				Scheduler:     a.checkScheduler,
 			}
 			udp.Start()
 			a.checkUDPs[cid] = udp
@@ -3027,6 +3181,7 @@ func (a *Agent) addCheck(check *structs.HealthCheck, chkType *structs.CheckType,
 				Logger:          a.logger,
 				TLSClientConfig: tlsClientConfig,
 				StatusHandler:   statusHandler,
+				Scheduler:       a.checkScheduler,
 			}
 
 			if proxy != nil && proxy.Proxy.Expose.Checks {
@@ -3078,6 +3233,7 @@ func (a *Agent) addCheck(check *structs.HealthCheck, chkType *structs.CheckType,
 				Logger:            a.logger,
 				Client:            a.dockerClient,
 				StatusHandler:     statusHandler,
+				Scheduler:         a.checkScheduler,
 			}
 			dockerCheck.Start()
 			a.checkDockers[cid] = dockerCheck
@@ -3114,6 +3270,7 @@ func (a *Agent) addCheck(check *structs.HealthCheck, chkType *structs.CheckType,
 				Logger:        a.logger,
 				Client:        a.osServiceClient,
 				StatusHandler: statusHandler,
+				Scheduler:     a.checkScheduler,
 			}
 			osServiceCheck.Start()
 			a.checkOSServices[cid] = osServiceCheck
@@ -3131,7 +3288,7 @@ func (a *Agent) addCheck(check *structs.HealthCheck, chkType *structs.CheckType,
 				chkType.Interval = checks.MinInterval
 			}
 			monitor := &checks.CheckMonitor{
-				Notify:        a.State,
+				Notify:        checkNotifier,
 				CheckID:       cid,
 				ServiceID:     sid,
 				ScriptArgs:    chkType.ScriptArgs,
@@ -3140,6 +3297,7 @@ func (a *Agent) addCheck(check *structs.HealthCheck, chkType *structs.CheckType,
 				Logger:        a.logger,
 				OutputMaxSize: maxOutputSize,
 				StatusHandler: statusHandler,
+				Scheduler:     a.checkScheduler,
 			}
 			monitor.Start()
 			a.checkMonitors[cid] = monitor
@@ -3171,6 +3329,7 @@ func (a *Agent) addCheck(check *structs.HealthCheck, chkType *structs.CheckType,
 				Logger:          a.logger,
 				TLSClientConfig: tlsClientConfig,
 				StatusHandler:   statusHandler,
+				Scheduler:       a.checkScheduler,
 			}
 
 			h2ping.Start()
@@ -3203,6 +3362,8 @@ func (a *Agent) addCheck(check *structs.HealthCheck, chkType *structs.CheckType,
 				CheckID:        cid,
 				Node:           chkType.AliasNode,
 				ServiceID:      aliasServiceID,
+				Datacenter:     chkType.AliasDatacenter,
+				PeerName:       chkType.AliasPeer,
 				EnterpriseMeta: check.EnterpriseMeta,
 			}
 			chkImpl.Start()
@@ -7771,6 +7771,116 @@ func TestAgentConnectAuthorize_deny(t *testing.T) {
 	assert.Contains(t, obj.Reason, "Matched")
 }
 
+func TestAgentConnectAuthorize_L7(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+	target := "db"
+
+	// Create an L7 intention
+	for _, entry := range []structs.ConfigEntry{
+		&structs.ServiceConfigEntry{
+			Kind:     structs.ServiceDefaults,
+			Name:     target,
+			Protocol: "http",
+		},
+		&structs.JWTProviderConfigEntry{
+			Kind: structs.JWTProvider,
+			Name: "okta",
+			JSONWebKeySet: &structs.JSONWebKeySet{
+				Remote: &structs.RemoteJWKS{
+					URI: "https://example.com/.well-known/jwks.json",
+				},
+			},
+		},
+		&structs.ServiceIntentionsConfigEntry{
+			Kind: structs.ServiceIntentions,
+			Name: target,
+			Sources: []*structs.SourceIntention{
+				{
+					Name: "web",
+					Permissions: []*structs.IntentionPermission{
+						{
+							Action: structs.IntentionActionAllow,
+							HTTP: &structs.IntentionHTTPPermission{
+								PathPrefix: "/api",
+								Methods:    []string{"GET"},
+							},
+						},
+						{
+							Action: structs.IntentionActionDeny,
+							HTTP: &structs.IntentionHTTPPermission{
+								PathPrefix: "/admin",
+							},
+							JWT: &structs.IntentionJWTRequirement{
+								Providers: []*structs.IntentionJWTProvider{
+									{Name: "okta"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	} {
+		req := structs.ConfigEntryRequest{
+			Datacenter: "dc1",
+			Entry:      entry,
+		}
+		var out bool
+		require.NoError(t, a.RPC(context.Background(), "ConfigEntry.Apply", &req, &out))
+	}
+
+	authorize := func(t *testing.T, httpReq *structs.IntentionCheckHTTPRequest) *connectAuthorizeResp {
+		args := &structs.ConnectAuthorizeRequest{
+			Target:        target,
+			ClientCertURI: connect.TestSpiffeIDService(t, "web").URI().String(),
+			HTTP:          httpReq,
+		}
+		req, _ := http.NewRequest("POST", "/v1/agent/connect/authorize", jsonReader(args))
+		resp := httptest.NewRecorder()
+		a.srv.h.ServeHTTP(resp, req)
+		require.Equal(t, 200, resp.Code)
+
+		obj := &connectAuthorizeResp{}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(obj))
+		return obj
+	}
+
+	t.Run("without an HTTP request", func(t *testing.T) {
+		obj := authorize(t, nil)
+		require.False(t, obj.Authorized)
+		require.Contains(t, obj.Reason, "Matched L7 intention")
+	})
+
+	t.Run("allowed by permission", func(t *testing.T) {
+		obj := authorize(t, &structs.IntentionCheckHTTPRequest{Method: "GET", Path: "/api/users"})
+		require.True(t, obj.Authorized)
+		require.Contains(t, obj.Reason, "permission 0")
+	})
+
+	t.Run("denied by permission with a JWT requirement", func(t *testing.T) {
+		// JWT requirements are not evaluated, the same as in the RBAC
+		// filters, so they do not prevent a deny from matching.
+		obj := authorize(t, &structs.IntentionCheckHTTPRequest{Method: "GET", Path: "/admin/users"})
+		require.False(t, obj.Authorized)
+		require.Contains(t, obj.Reason, "permission 1")
+	})
+
+	t.Run("no matching permission", func(t *testing.T) {
+		obj := authorize(t, &structs.IntentionCheckHTTPRequest{Method: "POST", Path: "/api/users"})
+		require.True(t, obj.Authorized)
+		require.Contains(t, obj.Reason, "no permission matched")
+	})
+}
+
 // Test when there is an intention allowing service with a different trust
 // domain. We allow this because migration between trust domains shouldn't cause
 // an outage even if we have stale info about current trusted domains. It's safe
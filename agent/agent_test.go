@@ -40,6 +40,8 @@ import (
 	"golang.org/x/sync/errgroup"
 	"golang.org/x/time/rate"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/encoding/protojson"
 	"gopkg.in/square/go-jose.v2/jwt"
 
@@ -53,6 +55,8 @@ import (
 	"github.com/hashicorp/consul/agent/hcp/scada"
 	"github.com/hashicorp/consul/agent/structs"
 	"github.com/hashicorp/consul/agent/token"
+	"github.com/hashicorp/consul/agent/workloadapi"
+	"github.com/hashicorp/consul/agent/workloadapi/pbworkload"
 	"github.com/hashicorp/consul/api"
 	"github.com/hashicorp/consul/internal/go-sso/oidcauth/oidcauthtest"
 	"github.com/hashicorp/consul/ipaddr"
@@ -6245,3 +6249,65 @@ func assertDeepEqual(t *testing.T, x, y interface{}, opts ...cmp.Option) {
 		t.Fatalf("assertion failed: values are not equal\n--- expected\n+++ actual\n%v", diff)
 	}
 }
+
+func TestAgent_WorkloadAPI(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+	if !workloadapi.PeerCredentialsSupported {
+		t.Skip("peer credentials are not supported on this platform")
+	}
+
+	path := filepath.Join(testutil.TempDir(t, "workload"), "workload.sock")
+	a := StartTestAgent(t, TestAgent{HCL: `
+		connect {
+			enabled = true
+			workload_api { socket_path = "` + path + `" }
+		}
+	`})
+	defer a.Shutdown()
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+
+	svc := &structs.NodeService{
+		ID:      "web",
+		Service: "web",
+		Port:    8080,
+		Meta:    map[string]string{workloadapi.WorkloadUIDMetaKey: strconv.Itoa(os.Getuid())},
+	}
+	require.NoError(t, a.addServiceFromSource(svc, nil, false, "", ConfigSourceLocal))
+
+	conn, err := grpc.Dial("unix://"+path, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	ctx = metadata.AppendToOutgoingContext(ctx, "workload.spiffe.io", "true")
+	stream, err := pbworkload.NewSpiffeWorkloadAPIClient(conn).FetchX509SVID(ctx, &pbworkload.X509SVIDRequest{})
+	require.NoError(t, err)
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	require.Len(t, resp.Svids, 1)
+
+	roots, _, err := a.Client().Agent().ConnectCARoots(nil)
+	require.NoError(t, err)
+	spiffeID := connect.SpiffeIDService{
+		Host:       roots.TrustDomain,
+		Namespace:  "default",
+		Partition:  "default",
+		Datacenter: "dc1",
+		Service:    "web",
+	}
+	require.Equal(t, spiffeID.URI().String(), resp.Svids[0].SpiffeId)
+
+	certs, err := x509.ParseCertificates(resp.Svids[0].X509Svid)
+	require.NoError(t, err)
+	bundle, err := x509.ParseCertificates(resp.Svids[0].Bundle)
+	require.NoError(t, err)
+	pool := x509.NewCertPool()
+	for _, root := range bundle {
+		pool.AddCert(root)
+	}
+	_, err = certs[0].Verify(x509.VerifyOptions{Roots: pool})
+	require.NoError(t, err)
+}
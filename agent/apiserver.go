@@ -33,7 +33,7 @@ type apiServers struct {
 }
 
 type apiServer struct {
-	// Protocol supported by this server. One of: dns, http, https
+	// Protocol supported by this server. One of: dns, dns-https, http, https
 	Protocol string
 	// Addr the server is listening on
 	Addr net.Addr
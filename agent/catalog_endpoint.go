@@ -573,3 +573,96 @@ RETRY_ONCE:
 		s.nodeMetricsLabels())
 	return out.Services, nil
 }
+
+// CatalogMaintenanceWindows lists the scheduled maintenance windows on GET,
+// optionally restricted to a single node, and creates or updates a window on
+// PUT.
+func (s *HTTPHandlers) CatalogMaintenanceWindows(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	switch req.Method {
+	case "GET":
+		return s.catalogMaintenanceWindowList(resp, req)
+	case "PUT":
+		return s.catalogMaintenanceWindowUpsert(resp, req)
+	default:
+		return nil, MethodNotAllowedError{req.Method, []string{"GET", "PUT"}}
+	}
+}
+
+func (s *HTTPHandlers) catalogMaintenanceWindowList(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	metrics.IncrCounterWithLabels([]string{"client", "api", "catalog_maintenance_windows"}, 1,
+		s.nodeMetricsLabels())
+
+	args := structs.NodeSpecificRequest{}
+	if err := s.parseEntMetaNoWildcard(req, &args.EnterpriseMeta); err != nil {
+		return nil, err
+	}
+	if done := s.parse(resp, req, &args.Datacenter, &args.QueryOptions); done {
+		return nil, nil
+	}
+	args.Node = req.URL.Query().Get("node")
+
+	var out structs.IndexedMaintenanceWindows
+	defer setMeta(resp, &out.QueryMeta)
+	if err := s.agent.RPC(req.Context(), "Catalog.MaintenanceWindowList", &args, &out); err != nil {
+		metrics.IncrCounterWithLabels([]string{"client", "rpc", "error", "catalog_maintenance_windows"}, 1,
+			s.nodeMetricsLabels())
+		return nil, err
+	}
+
+	// Use empty list instead of nil
+	if out.Windows == nil {
+		out.Windows = make(structs.MaintenanceWindows, 0)
+	}
+	metrics.IncrCounterWithLabels([]string{"client", "api", "success", "catalog_maintenance_windows"}, 1,
+		s.nodeMetricsLabels())
+	return out.Windows, nil
+}
+
+func (s *HTTPHandlers) catalogMaintenanceWindowUpsert(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	args := structs.MaintenanceWindowRequest{
+		Op:     structs.MaintenanceWindowUpsert,
+		Window: &structs.MaintenanceWindow{},
+	}
+	if err := s.parseEntMetaNoWildcard(req, &args.Window.EnterpriseMeta); err != nil {
+		return nil, err
+	}
+	if err := s.rewordUnknownEnterpriseFieldError(decodeBody(req.Body, args.Window)); err != nil {
+		return nil, HTTPError{StatusCode: http.StatusBadRequest, Reason: fmt.Sprintf("Request decode failed: %v", err)}
+	}
+	s.parseDC(req, &args.Datacenter)
+	s.parseToken(req, &args.Token)
+
+	var id string
+	if err := s.agent.RPC(req.Context(), "Catalog.MaintenanceWindowApply", &args, &id); err != nil {
+		return nil, err
+	}
+	return maintenanceWindowIDResponse{ID: id}, nil
+}
+
+// CatalogMaintenanceWindowDelete removes a scheduled maintenance window.
+func (s *HTTPHandlers) CatalogMaintenanceWindowDelete(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	args := structs.MaintenanceWindowRequest{
+		Op:     structs.MaintenanceWindowDelete,
+		Window: &structs.MaintenanceWindow{},
+	}
+	if err := s.parseEntMetaNoWildcard(req, &args.Window.EnterpriseMeta); err != nil {
+		return nil, err
+	}
+	s.parseDC(req, &args.Datacenter)
+	s.parseToken(req, &args.Token)
+
+	args.Window.ID = strings.TrimPrefix(req.URL.Path, "/v1/catalog/maintenance/")
+	if args.Window.ID == "" {
+		return nil, HTTPError{StatusCode: http.StatusBadRequest, Reason: "Missing maintenance window ID"}
+	}
+
+	var id string
+	if err := s.agent.RPC(req.Context(), "Catalog.MaintenanceWindowApply", &args, &id); err != nil {
+		return nil, err
+	}
+	return true, nil
+}
+
+// maintenanceWindowIDResponse is returned when a maintenance window is
+// created or updated.
+type maintenanceWindowIDResponse struct{ ID string }
@@ -2028,3 +2028,103 @@ func TestCatalog_GatewayServices_Ingress(t *testing.T) {
 		require.Equal(r, expect, gatewayServices)
 	})
 }
+
+func TestCatalogMaintenanceWindows(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+
+	start := time.Date(2023, 6, 4, 2, 0, 0, 0, time.UTC)
+	body := map[string]interface{}{
+		"Node":       "foo",
+		"ServiceID":  "redis",
+		"Start":      start.Format(time.RFC3339),
+		"Duration":   "2h",
+		"Recurrence": "168h",
+		"Reason":     "weekly patching",
+	}
+	req, _ := http.NewRequest("PUT", "/v1/catalog/maintenance", jsonReader(body))
+	resp := httptest.NewRecorder()
+	obj, err := a.srv.CatalogMaintenanceWindows(resp, req)
+	require.NoError(t, err)
+	id := obj.(maintenanceWindowIDResponse).ID
+	require.NotEmpty(t, id)
+
+	t.Run("list", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/v1/catalog/maintenance", nil)
+		resp := httptest.NewRecorder()
+		obj, err := a.srv.CatalogMaintenanceWindows(resp, req)
+		require.NoError(t, err)
+		assertIndex(t, resp)
+
+		windows := obj.(structs.MaintenanceWindows)
+		require.Len(t, windows, 1)
+		w := windows[0]
+		require.Equal(t, id, w.ID)
+		require.Equal(t, "foo", w.Node)
+		require.Equal(t, "redis", w.ServiceID)
+		require.True(t, start.Equal(w.Start))
+		require.Equal(t, 2*time.Hour, w.Duration)
+		require.Equal(t, 168*time.Hour, w.Recurrence)
+		require.Equal(t, "weekly patching", w.Reason)
+	})
+
+	t.Run("list for another node", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/v1/catalog/maintenance?node=bar", nil)
+		resp := httptest.NewRecorder()
+		obj, err := a.srv.CatalogMaintenanceWindows(resp, req)
+		require.NoError(t, err)
+		require.Equal(t, structs.MaintenanceWindows{}, obj)
+	})
+
+	t.Run("invalid window", func(t *testing.T) {
+		body := map[string]interface{}{
+			"Node":  "foo",
+			"Start": start.Format(time.RFC3339),
+		}
+		req, _ := http.NewRequest("PUT", "/v1/catalog/maintenance", jsonReader(body))
+		resp := httptest.NewRecorder()
+		_, err := a.srv.CatalogMaintenanceWindows(resp, req)
+		require.ErrorContains(t, err, "Duration must be > 0")
+	})
+
+	t.Run("invalid duration", func(t *testing.T) {
+		body := map[string]interface{}{
+			"Node":     "foo",
+			"Start":    start.Format(time.RFC3339),
+			"Duration": "two hours",
+		}
+		req, _ := http.NewRequest("PUT", "/v1/catalog/maintenance", jsonReader(body))
+		resp := httptest.NewRecorder()
+		_, err := a.srv.CatalogMaintenanceWindows(resp, req)
+		require.Error(t, err)
+		require.True(t, isHTTPBadRequest(err), "expected bad request, got %v", err)
+	})
+
+	t.Run("delete without ID", func(t *testing.T) {
+		req, _ := http.NewRequest("DELETE", "/v1/catalog/maintenance/", nil)
+		resp := httptest.NewRecorder()
+		_, err := a.srv.CatalogMaintenanceWindowDelete(resp, req)
+		require.Error(t, err)
+		require.True(t, isHTTPBadRequest(err), "expected bad request, got %v", err)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		req, _ := http.NewRequest("DELETE", "/v1/catalog/maintenance/"+id, nil)
+		resp := httptest.NewRecorder()
+		obj, err := a.srv.CatalogMaintenanceWindowDelete(resp, req)
+		require.NoError(t, err)
+		require.Equal(t, true, obj)
+
+		req, _ = http.NewRequest("GET", "/v1/catalog/maintenance", nil)
+		resp = httptest.NewRecorder()
+		obj, err = a.srv.CatalogMaintenanceWindows(resp, req)
+		require.NoError(t, err)
+		require.Empty(t, obj.(structs.MaintenanceWindows))
+	})
+}
@@ -26,9 +26,15 @@ const (
 // this check is critical. If the service has no critical but warnings,
 // then this check is warning, and if a service has only passing checks, then
 // this check is passing.
+//
+// The aliased node may live in another datacenter or be imported from a
+// cluster peer, in which case Node must be set so that a blocking query can
+// be made against that datacenter or peer.
 type CheckAlias struct {
-	Node      string            // Node name of the service. If empty, assumed to be this node.
-	ServiceID structs.ServiceID // ID (not name) of the service to alias
+	Node       string            // Node name of the service. If empty, assumed to be this node.
+	ServiceID  structs.ServiceID // ID (not name) of the service to alias
+	Datacenter string            // Datacenter of the node. If empty, the request datacenter is used.
+	PeerName   string            // Peer the node was imported from. If empty, the node is local.
 
 	CheckID structs.CheckID             // ID of this check
 	RPC     RPC                         // Used to query remote server if necessary
@@ -143,9 +149,20 @@ func (c *CheckAlias) runLocal(stopCh chan struct{}) {
 // CheckIfServiceIDExists is used to determine if a service exists
 type CheckIfServiceIDExists func(*structs.ServiceID) bool
 
-func (c *CheckAlias) checkServiceExistsOnRemoteServer(serviceID *structs.ServiceID) (bool, error) {
+// remoteRequest returns a copy of the base request targeting the aliased
+// node, including its datacenter or peer when set.
+func (c *CheckAlias) remoteRequest() structs.NodeSpecificRequest {
 	args := c.RPCReq
 	args.Node = c.Node
+	if c.Datacenter != "" {
+		args.Datacenter = c.Datacenter
+	}
+	args.PeerName = c.PeerName
+	return args
+}
+
+func (c *CheckAlias) checkServiceExistsOnRemoteServer(serviceID *structs.ServiceID) (bool, error) {
+	args := c.remoteRequest()
 	args.AllowStale = true
 	args.EnterpriseMeta = c.EnterpriseMeta
 	// We are late at maximum of 15s compared to leader
@@ -170,8 +187,7 @@ RETRY_CALL:
 }
 
 func (c *CheckAlias) runQuery(stopCh chan struct{}) {
-	args := c.RPCReq
-	args.Node = c.Node
+	args := c.remoteRequest()
 	args.AllowStale = true
 	args.MaxQueryTime = 1 * time.Minute
 	args.EnterpriseMeta = c.EnterpriseMeta
@@ -282,9 +298,22 @@ func (c *CheckAlias) processChecks(checks []*structs.HealthCheck, CheckIfService
 	}
 	if !serviceFound {
 		if !CheckIfServiceIDExists(&c.ServiceID) {
-			msg = fmt.Sprintf("Service %s could not be found on node %s", c.ServiceID.ID, c.Node)
+			msg = fmt.Sprintf("Service %s could not be found on node %s", c.ServiceID.ID, c.nodeDescription())
 			health = api.HealthCritical
 		}
 	}
 	c.Notify.UpdateCheck(c.CheckID, health, msg)
 }
+
+// nodeDescription returns the aliased node name qualified by its datacenter
+// or peer, if any, for use in check output.
+func (c *CheckAlias) nodeDescription() string {
+	switch {
+	case c.PeerName != "":
+		return fmt.Sprintf("%s in peer %s", c.Node, c.PeerName)
+	case c.Datacenter != "":
+		return fmt.Sprintf("%s in datacenter %s", c.Node, c.Datacenter)
+	default:
+		return c.Node
+	}
+}
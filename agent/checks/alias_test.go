@@ -188,6 +188,73 @@ func TestCheckAlias_remotePassing(t *testing.T) {
 	})
 }
 
+// The request targets the configured datacenter or peer of the aliased node.
+func TestCheckAlias_remoteDatacenterAndPeer(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		datacenter string
+		peer       string
+		wantDC     string
+		wantPeer   string
+	}{
+		"local":      {wantDC: "dc1"},
+		"datacenter": {datacenter: "dc2", wantDC: "dc2"},
+		"peer":       {peer: "cluster-02", wantDC: "dc1", wantPeer: "cluster-02"},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			notify := newMockAliasNotify()
+			chkID := structs.NewCheckID("foo", nil)
+			rpc := &mockRPC{}
+			chk := &CheckAlias{
+				Node:       "remote",
+				ServiceID:  structs.ServiceID{ID: "web"},
+				Datacenter: tc.datacenter,
+				PeerName:   tc.peer,
+				CheckID:    chkID,
+				Notify:     notify,
+				RPC:        rpc,
+				RPCReq:     structs.NodeSpecificRequest{Datacenter: "dc1"},
+			}
+
+			rpc.AddReply("Health.NodeChecks", structs.IndexedHealthChecks{
+				HealthChecks: []*structs.HealthCheck{
+					{
+						Node:      "remote",
+						ServiceID: "web",
+						Status:    api.HealthPassing,
+						PeerName:  tc.peer,
+					},
+				},
+			})
+
+			chk.Start()
+			defer chk.Stop()
+			retry.Run(t, func(r *retry.R) {
+				if got, want := notify.State(chkID), api.HealthPassing; got != want {
+					r.Fatalf("got state %q want %q", got, want)
+				}
+			})
+
+			args, ok := rpc.Args.Load().(*structs.NodeSpecificRequest)
+			if !ok {
+				t.Fatalf("unexpected args type %T", rpc.Args.Load())
+			}
+			if args.Datacenter != tc.wantDC {
+				t.Fatalf("got datacenter %q want %q", args.Datacenter, tc.wantDC)
+			}
+			if args.PeerName != tc.wantPeer {
+				t.Fatalf("got peer %q want %q", args.PeerName, tc.wantPeer)
+			}
+		})
+	}
+}
+
 // Remote service has no healtchecks, but service exists on remote host
 func TestCheckAlias_remotePassingWithoutChecksButWithService(t *testing.T) {
 	t.Parallel()
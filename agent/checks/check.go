@@ -78,6 +78,7 @@ type CheckMonitor struct {
 	Logger        hclog.Logger
 	OutputMaxSize int
 	StatusHandler *StatusHandler
+	Scheduler     *Scheduler
 
 	stop     bool
 	stopCh   chan struct{}
@@ -112,8 +113,10 @@ func (c *CheckMonitor) run() {
 	for {
 		select {
 		case <-next:
-			c.check()
-			next = time.After(c.Interval)
+			if !c.Scheduler.Run(CheckTypeScript, c.stopCh, c.check) {
+				return
+			}
+			next = time.After(c.Scheduler.NextInterval(c.Interval))
 		case <-c.stopCh:
 			return
 		}
@@ -350,8 +353,13 @@ type CheckHTTP struct {
 	TLSClientConfig  *tls.Config
 	OutputMaxSize    int
 	StatusHandler    *StatusHandler
+	Scheduler        *Scheduler
 	DisableRedirects bool
 
+	// DynamicWeight, if set, reports the value of the WeightHeader response
+	// header in the check output so that it is used as the service weight.
+	DynamicWeight bool
+
 	httpClient *http.Client
 	stop       bool
 	stopCh     chan struct{}
@@ -374,6 +382,7 @@ func (c *CheckHTTP) CheckType() structs.CheckType {
 		ProxyHTTP:     c.ProxyHTTP,
 		Timeout:       c.Timeout,
 		OutputMaxSize: c.OutputMaxSize,
+		DynamicWeight: c.DynamicWeight,
 	}
 }
 
@@ -439,8 +448,10 @@ func (c *CheckHTTP) run() {
 	for {
 		select {
 		case <-next:
-			c.check()
-			next = time.After(c.Interval)
+			if !c.Scheduler.Run(CheckTypeHTTP, c.stopCh, c.check) {
+				return
+			}
+			next = time.After(c.Scheduler.NextInterval(c.Interval))
 		case <-c.stopCh:
 			return
 		}
@@ -502,6 +513,9 @@ func (c *CheckHTTP) check() {
 
 	// Format the response body
 	result := fmt.Sprintf("HTTP %s %s: %s Output: %s", method, target, resp.Status, output.String())
+	if weight := resp.Header.Get(WeightHeader); c.DynamicWeight && weight != "" {
+		result = fmt.Sprintf("%s\nConsul-Weight: %s", result, weight)
+	}
 
 	if resp.StatusCode >= 200 && resp.StatusCode <= 299 {
 		// PASSING (2xx)
@@ -526,6 +540,7 @@ type CheckH2PING struct {
 	Logger          hclog.Logger
 	TLSClientConfig *tls.Config
 	StatusHandler   *StatusHandler
+	Scheduler       *Scheduler
 
 	stop     bool
 	stopCh   chan struct{}
@@ -605,8 +620,10 @@ func (c *CheckH2PING) run() {
 	for {
 		select {
 		case <-next:
-			c.check()
-			next = time.After(c.Interval)
+			if !c.Scheduler.Run(CheckTypeH2PING, c.stopCh, c.check) {
+				return
+			}
+			next = time.After(c.Scheduler.NextInterval(c.Interval))
 		case <-c.stopCh:
 			return
 		}
@@ -638,6 +655,7 @@ type CheckTCP struct {
 	Timeout       time.Duration
 	Logger        hclog.Logger
 	StatusHandler *StatusHandler
+	Scheduler     *Scheduler
 
 	dialer   *net.Dialer
 	stop     bool
@@ -684,8 +702,10 @@ func (c *CheckTCP) run() {
 	for {
 		select {
 		case <-next:
-			c.check()
-			next = time.After(c.Interval)
+			if !c.Scheduler.Run(CheckTypeTCP, c.stopCh, c.check) {
+				return
+			}
+			next = time.After(c.Scheduler.NextInterval(c.Interval))
 		case <-c.stopCh:
 			return
 		}
@@ -721,6 +741,7 @@ type CheckUDP struct {
 	Timeout       time.Duration
 	Logger        hclog.Logger
 	StatusHandler *StatusHandler
+	Scheduler     *Scheduler
 
 	dialer   *net.Dialer
 	stop     bool
@@ -763,8 +784,10 @@ func (c *CheckUDP) run() {
 	for {
 		select {
 		case <-next:
-			c.check()
-			next = time.After(c.Interval)
+			if !c.Scheduler.Run(CheckTypeUDP, c.stopCh, c.check) {
+				return
+			}
+			next = time.After(c.Scheduler.NextInterval(c.Interval))
 		case <-c.stopCh:
 			return
 		}
@@ -852,6 +875,7 @@ type CheckDocker struct {
 	Logger            hclog.Logger
 	Client            *DockerClient
 	StatusHandler     *StatusHandler
+	Scheduler         *Scheduler
 
 	stop chan struct{}
 }
@@ -889,8 +913,10 @@ func (c *CheckDocker) run() {
 	for {
 		select {
 		case <-next:
-			c.check()
-			next = time.After(c.Interval)
+			if !c.Scheduler.Run(CheckTypeDocker, c.stop, c.check) {
+				return
+			}
+			next = time.After(c.Scheduler.NextInterval(c.Interval))
 		case <-c.stop:
 			return
 		}
@@ -978,6 +1004,7 @@ type CheckGRPC struct {
 	TLSClientConfig *tls.Config
 	Logger          hclog.Logger
 	StatusHandler   *StatusHandler
+	Scheduler       *Scheduler
 
 	probe    *GrpcHealthProbe
 	stop     bool
@@ -1019,8 +1046,10 @@ func (c *CheckGRPC) run() {
 	for {
 		select {
 		case <-next:
-			c.check()
-			next = time.After(c.Interval)
+			if !c.Scheduler.Run(CheckTypeGRPC, c.stopCh, c.check) {
+				return
+			}
+			next = time.After(c.Scheduler.NextInterval(c.Interval))
 		case <-c.stopCh:
 			return
 		}
@@ -1058,6 +1087,7 @@ type CheckOSService struct {
 	Timeout       time.Duration
 	Logger        hclog.Logger
 	StatusHandler *StatusHandler
+	Scheduler     *Scheduler
 	Client        *OSServiceClient
 
 	stop     bool
@@ -1104,8 +1134,10 @@ func (c *CheckOSService) run() {
 	for {
 		select {
 		case <-next:
-			c.check()
-			next = time.After(c.Interval)
+			if !c.Scheduler.Run(CheckTypeOSService, c.stopCh, c.check) {
+				return
+			}
+			next = time.After(c.Scheduler.NextInterval(c.Interval))
 		case <-c.stopCh:
 			return
 		}
@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package checks
+
+import (
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/armon/go-metrics/prometheus"
+
+	"github.com/hashicorp/consul/lib"
+)
+
+// Check types used to group checks for concurrency limits and metrics.
+const (
+	CheckTypeScript    = "script"
+	CheckTypeHTTP      = "http"
+	CheckTypeTCP       = "tcp"
+	CheckTypeUDP       = "udp"
+	CheckTypeGRPC      = "grpc"
+	CheckTypeH2PING    = "h2ping"
+	CheckTypeDocker    = "docker"
+	CheckTypeOSService = "os_service"
+)
+
+var SchedulerSummaries = []prometheus.SummaryDefinition{
+	{
+		Name: []string{"agent", "checks", "queue_wait"},
+		Help: "Measures the time a health check that is due waits for a free execution slot because the concurrency limit for its type is reached.",
+	},
+}
+
+// ConcurrencyLimits is the maximum number of checks of each type that an
+// agent executes at the same time. A zero value means unlimited.
+type ConcurrencyLimits struct {
+	Script    int
+	HTTP      int
+	TCP       int
+	UDP       int
+	GRPC      int
+	H2PING    int
+	Docker    int
+	OSService int
+}
+
+// Scheduler coordinates the execution of the periodic checks of an agent. It
+// bounds how many checks of each type run at once, so that many checks firing
+// together on a dense node do not cause load spikes or fork storms, and it
+// jitters the interval between runs so that checks drift apart instead of
+// staying aligned.
+//
+// A nil Scheduler is valid and runs checks without limits or jitter.
+type Scheduler struct {
+	slots  map[string]chan struct{}
+	jitter float64
+}
+
+// NewScheduler returns a Scheduler that enforces the given limits and
+// randomly shifts each interval by up to jitterPercent percent.
+func NewScheduler(limits ConcurrencyLimits, jitterPercent int) *Scheduler {
+	s := &Scheduler{
+		slots:  make(map[string]chan struct{}),
+		jitter: float64(jitterPercent) / 100,
+	}
+	for checkType, limit := range map[string]int{
+		CheckTypeScript:    limits.Script,
+		CheckTypeHTTP:      limits.HTTP,
+		CheckTypeTCP:       limits.TCP,
+		CheckTypeUDP:       limits.UDP,
+		CheckTypeGRPC:      limits.GRPC,
+		CheckTypeH2PING:    limits.H2PING,
+		CheckTypeDocker:    limits.Docker,
+		CheckTypeOSService: limits.OSService,
+	} {
+		if limit > 0 {
+			s.slots[checkType] = make(chan struct{}, limit)
+		}
+	}
+	return s
+}
+
+// Run executes fn once a slot for the given check type is available. It
+// returns false without executing fn if stopCh is closed while waiting.
+func (s *Scheduler) Run(checkType string, stopCh <-chan struct{}, fn func()) bool {
+	if s == nil || s.slots[checkType] == nil {
+		fn()
+		return true
+	}
+
+	slots := s.slots[checkType]
+	start := time.Now()
+	select {
+	case slots <- struct{}{}:
+	case <-stopCh:
+		return false
+	}
+	defer func() { <-slots }()
+
+	metrics.MeasureSinceWithLabels([]string{"agent", "checks", "queue_wait"}, start,
+		[]metrics.Label{{Name: "type", Value: checkType}})
+
+	fn()
+	return true
+}
+
+// NextInterval returns how long to wait before running a check with the given
+// interval again.
+func (s *Scheduler) NextInterval(interval time.Duration) time.Duration {
+	if s == nil || s.jitter <= 0 {
+		return interval
+	}
+	jitter := time.Duration(float64(interval) * s.jitter)
+	return interval - jitter + lib.RandomStagger(2*jitter)
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package checks
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduler_Run_limitsConcurrency(t *testing.T) {
+	t.Parallel()
+
+	s := NewScheduler(ConcurrencyLimits{Script: 2}, 0)
+	stopCh := make(chan struct{})
+
+	var running, maxRunning int32
+	check := func() {
+		n := atomic.AddInt32(&running, 1)
+		for {
+			max := atomic.LoadInt32(&maxRunning)
+			if n <= max || atomic.CompareAndSwapInt32(&maxRunning, max, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			require.True(t, s.Run(CheckTypeScript, stopCh, check))
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, int32(2), atomic.LoadInt32(&maxRunning))
+}
+
+func TestScheduler_Run_stop(t *testing.T) {
+	t.Parallel()
+
+	s := NewScheduler(ConcurrencyLimits{HTTP: 1}, 0)
+	stopCh := make(chan struct{})
+
+	blockCh := make(chan struct{})
+	startedCh := make(chan struct{})
+	go s.Run(CheckTypeHTTP, stopCh, func() {
+		close(startedCh)
+		<-blockCh
+	})
+	<-startedCh
+	defer close(blockCh)
+
+	close(stopCh)
+	ran := false
+	require.False(t, s.Run(CheckTypeHTTP, stopCh, func() { ran = true }))
+	require.False(t, ran)
+
+	// Types without a limit are not affected by the full HTTP slots.
+	require.True(t, s.Run(CheckTypeTCP, stopCh, func() { ran = true }))
+	require.True(t, ran)
+}
+
+func TestScheduler_NextInterval(t *testing.T) {
+	t.Parallel()
+
+	var nilScheduler *Scheduler
+	require.Equal(t, 10*time.Second, nilScheduler.NextInterval(10*time.Second))
+	require.Equal(t, 10*time.Second, NewScheduler(ConcurrencyLimits{}, 0).NextInterval(10*time.Second))
+
+	s := NewScheduler(ConcurrencyLimits{}, 20)
+	for i := 0; i < 100; i++ {
+		next := s.NextInterval(10 * time.Second)
+		require.GreaterOrEqual(t, next, 8*time.Second)
+		require.Less(t, next, 12*time.Second)
+	}
+}
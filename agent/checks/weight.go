@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package checks
+
+import (
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+const (
+	// WeightHeader is the HTTP response header that an HTTP check with
+	// DynamicWeight enabled reads the service weight from.
+	WeightHeader = "X-Consul-Weight"
+
+	// MaxWeight is the largest weight a check can report. It matches the
+	// range of the weight field of DNS SRV records.
+	MaxWeight = 65535
+)
+
+// weightRe matches a weight reported in check output. It accepts a
+// "Consul-Weight: 10" line, a "consul_weight=10" pair or a JSON field such
+// as {"consul-weight": 10}.
+var weightRe = regexp.MustCompile(`(?i)consul[-_]weight"?\s*[:=]\s*"?(\d+)`)
+
+// ParseWeight extracts the service weight reported in the output of a check.
+// The second return value is false if the output does not report a valid
+// weight.
+func ParseWeight(output string) (int, bool) {
+	m := weightRe.FindStringSubmatch(output)
+	if m == nil {
+		return 0, false
+	}
+	weight, err := strconv.Atoi(m[1])
+	if err != nil || weight < 1 || weight > MaxWeight {
+		return 0, false
+	}
+	return weight, true
+}
+
+// WeightNotifier is implemented by check notifiers that can override the
+// weights of a service with a value reported by one of its checks. A weight
+// of 0 means that the check no longer reports one.
+type WeightNotifier interface {
+	CheckNotifier
+	UpdateServiceWeight(serviceID structs.ServiceID, checkID structs.CheckID, weight int)
+}
+
+// WeightHandler is a CheckNotifier for checks with DynamicWeight enabled. It
+// forwards every update to the inner notifier, and feeds any weight reported
+// in the output back into the weights of the service the check belongs to.
+// Updates without a weight stop the check from overriding the weights.
+type WeightHandler struct {
+	inner     WeightNotifier
+	serviceID structs.ServiceID
+	logger    hclog.Logger
+}
+
+// NewWeightHandler returns a WeightHandler that updates the weights of the
+// given service.
+func NewWeightHandler(inner WeightNotifier, serviceID structs.ServiceID, logger hclog.Logger) *WeightHandler {
+	return &WeightHandler{
+		inner:     inner,
+		serviceID: serviceID,
+		logger:    logger,
+	}
+}
+
+func (w *WeightHandler) UpdateCheck(checkID structs.CheckID, status, output string) {
+	weight, ok := ParseWeight(output)
+	if ok {
+		w.logger.Trace("Check reported service weight",
+			"check", checkID.String(),
+			"service", w.serviceID.String(),
+			"weight", weight,
+		)
+	}
+	w.inner.UpdateServiceWeight(w.serviceID, checkID, weight)
+	w.inner.UpdateCheck(checkID, status, output)
+}
+
+func (w *WeightHandler) ServiceExists(serviceID structs.ServiceID) bool {
+	return w.inner.ServiceExists(serviceID)
+}
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package checks
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/mock"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/sdk/testutil"
+	"github.com/hashicorp/consul/sdk/testutil/retry"
+)
+
+func TestParseWeight(t *testing.T) {
+	cases := map[string]struct {
+		output string
+		weight int
+		ok     bool
+	}{
+		"empty":       {"", 0, false},
+		"no weight":   {"all good", 0, false},
+		"header line": {"load is high\nConsul-Weight: 3\n", 3, true},
+		"key value":   {"consul_weight=42", 42, true},
+		"json field":  {`HTTP GET http://x: 200 OK Output: {"status":"ok","consul-weight": 7}`, 7, true},
+		"json string": {`{"consul_weight":"12"}`, 12, true},
+		"zero":        {"Consul-Weight: 0", 0, false},
+		"too large":   {"Consul-Weight: 65536", 0, false},
+		"negative":    {"Consul-Weight: -1", 0, false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			weight, ok := ParseWeight(tc.output)
+			require.Equal(t, tc.ok, ok)
+			require.Equal(t, tc.weight, weight)
+		})
+	}
+}
+
+type mockWeightNotifier struct {
+	*mock.Notify
+
+	lock    sync.Mutex
+	weights map[structs.ServiceID]int
+}
+
+func (m *mockWeightNotifier) UpdateServiceWeight(serviceID structs.ServiceID, _ structs.CheckID, weight int) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.weights[serviceID] = weight
+}
+
+func (m *mockWeightNotifier) Weight(serviceID structs.ServiceID) int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.weights[serviceID]
+}
+
+func TestCheckHTTP_DynamicWeight(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(WeightHeader, "5")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	notif := &mockWeightNotifier{
+		Notify:  mock.NewNotify(),
+		weights: make(map[structs.ServiceID]int),
+	}
+	logger := testutil.Logger(t)
+	sid := structs.NewServiceID("web", nil)
+	cid := structs.NewCheckID("web-check", nil)
+
+	check := &CheckHTTP{
+		CheckID:       cid,
+		ServiceID:     sid,
+		HTTP:          server.URL,
+		OutputMaxSize: DefaultBufSize,
+		Interval:      10 * time.Millisecond,
+		Logger:        logger,
+		DynamicWeight: true,
+		StatusHandler: NewStatusHandler(NewWeightHandler(notif, sid, logger), logger, 0, 0, 0),
+	}
+	check.Start()
+	defer check.Stop()
+
+	retry.Run(t, func(r *retry.R) {
+		require.Contains(r, notif.Output(cid), "Consul-Weight: 5")
+		require.Equal(r, 5, notif.Weight(sid))
+	})
+}
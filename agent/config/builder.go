@@ -26,6 +26,7 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/go-sockaddr/template"
 	"github.com/hashicorp/memberlist"
+	miekgdns "github.com/miekg/dns"
 	"golang.org/x/time/rate"
 
 	hcpconfig "github.com/hashicorp/consul/agent/hcp/config"
@@ -405,6 +406,78 @@ func (b *builder) build() (rt RuntimeConfig, err error) {
 		}
 	}
 
+	var dnsZoneTransferCIDRs []*net.IPNet
+	var dnsZoneTransferKeys []DNSTSIGKey
+	if c.DNS.ZoneTransfer != nil {
+		dnsZoneTransferCIDRs = b.cidrsVal("dns_config.zone_transfer.allowed_cidrs", c.DNS.ZoneTransfer.AllowedCIDRs)
+		dnsZoneTransferKeys = b.dnsTSIGKeysVal("dns_config.zone_transfer.tsig_keys", c.DNS.ZoneTransfer.TSIGKeys)
+	}
+
+	var dnsUpdateKeys []DNSUpdateKey
+	dnsUpdateDeregisterAfter := time.Hour
+	if c.DNS.DynamicUpdates != nil {
+		dnsUpdateKeys = b.dnsUpdateKeysVal(c.DNS.DynamicUpdates.TSIGKeys)
+		if c.DNS.DynamicUpdates.DeregisterCriticalServiceAfter != nil {
+			dnsUpdateDeregisterAfter = b.durationVal("dns_config.dynamic_updates.deregister_critical_service_after", c.DNS.DynamicUpdates.DeregisterCriticalServiceAfter)
+		}
+	}
+
+	dnsViews := b.dnsViewsVal(c.DNS.Views)
+
+	var dnsResponseCacheEnabled bool
+	dnsResponseCacheSize := 10000
+	var dnsResponseCacheMinTTL time.Duration
+	dnsResponseCacheMaxTTL := time.Hour
+	dnsResponseCachePrefetchHits := 3
+	dnsResponseCachePrefetchPercent := 10
+	if rc := c.DNS.ResponseCache; rc != nil {
+		dnsResponseCacheEnabled = boolVal(rc.Enabled)
+		dnsResponseCacheSize = intValWithDefault(rc.Size, dnsResponseCacheSize)
+		if rc.MinTTL != nil {
+			dnsResponseCacheMinTTL = b.durationVal("dns_config.response_cache.min_ttl", rc.MinTTL)
+		}
+		if rc.MaxTTL != nil {
+			dnsResponseCacheMaxTTL = b.durationVal("dns_config.response_cache.max_ttl", rc.MaxTTL)
+		}
+		dnsResponseCachePrefetchHits = intValWithDefault(rc.PrefetchHits, dnsResponseCachePrefetchHits)
+		dnsResponseCachePrefetchPercent = intValWithDefault(rc.PrefetchPercent, dnsResponseCachePrefetchPercent)
+	}
+
+	dnsRecursorStrategy := b.dnsRecursorStrategyVal(stringVal(c.DNS.RecursorStrategy))
+	dnsRecursorTimeout := b.durationVal("recursor_timeout", c.DNS.RecursorTimeout)
+	dnsForwardingRules := b.dnsForwardingRulesVal(c.DNS.ForwardingRules, dnsRecursorStrategy, dnsRecursorTimeout)
+
+	var dnsProximityMode string
+	dnsProximityLimit := 3
+	if c.DNS.Proximity != nil {
+		dnsProximityMode = stringVal(c.DNS.Proximity.Mode)
+		dnsProximityLimit = intValWithDefault(c.DNS.Proximity.Limit, dnsProximityLimit)
+	}
+
+	var dnsQueryLogMode, dnsQueryLogDnstapSocket, dnsQueryLogDnstapFile string
+	var dnsQueryLogQueryTypes []string
+	dnsQueryLogSampleRate := 1.0
+	if c.DNS.QueryLog != nil {
+		dnsQueryLogMode = stringVal(c.DNS.QueryLog.Mode)
+		dnsQueryLogDnstapSocket = stringVal(c.DNS.QueryLog.DnstapSocket)
+		dnsQueryLogDnstapFile = stringVal(c.DNS.QueryLog.DnstapFile)
+		dnsQueryLogSampleRate = float64ValWithDefault(c.DNS.QueryLog.SampleRate, dnsQueryLogSampleRate)
+		dnsQueryLogQueryTypes = b.dnsQueryTypesVal("dns_config.query_log.query_types", c.DNS.QueryLog.QueryTypes)
+	}
+
+	var dnssecEnabled bool
+	dnssecSignatureValidity := 24 * time.Hour
+	dnssecKeyPublishDelay := time.Hour
+	if c.DNS.DNSSEC != nil {
+		dnssecEnabled = boolVal(c.DNS.DNSSEC.Enabled)
+		if c.DNS.DNSSEC.SignatureValidity != nil {
+			dnssecSignatureValidity = b.durationVal("dns_config.dnssec.signature_validity", c.DNS.DNSSEC.SignatureValidity)
+		}
+		if c.DNS.DNSSEC.KeyPublishDelay != nil {
+			dnssecKeyPublishDelay = b.durationVal("dns_config.dnssec.key_publish_delay", c.DNS.DNSSEC.KeyPublishDelay)
+		}
+	}
+
 	leaveOnTerm := !boolVal(c.ServerMode)
 	if c.LeaveOnTerm != nil {
 		leaveOnTerm = boolVal(c.LeaveOnTerm)
@@ -441,6 +514,8 @@ func (b *builder) build() (rt RuntimeConfig, err error) {
 
 	// determine port values and replace values <= 0 and > 65535 with -1
 	dnsPort := b.portVal("ports.dns", c.Ports.DNS)
+	dnsTLSPort := b.portVal("ports.dns_tls", c.Ports.DNSTLS)
+	dnsHTTPSPort := b.portVal("ports.dns_https", c.Ports.DNSHTTPS)
 	httpPort := b.portVal("ports.http", c.Ports.HTTP)
 	httpsPort := b.portVal("ports.https", c.Ports.HTTPS)
 	serverPort := b.portVal("ports.server", c.Ports.Server)
@@ -585,6 +660,8 @@ func (b *builder) build() (rt RuntimeConfig, err error) {
 		b.warn("client_addr is empty, client services (DNS, HTTP, HTTPS, GRPC) will not be listening for connections")
 	}
 	dnsAddrs := b.makeAddrs(b.expandAddrs("addresses.dns", c.Addresses.DNS), clientAddrs, dnsPort)
+	dnsTLSAddrs := b.makeAddrs(b.expandAddrs("addresses.dns_tls", c.Addresses.DNSTLS), clientAddrs, dnsTLSPort)
+	dnsHTTPSAddrs := b.makeAddrs(b.expandAddrs("addresses.dns_https", c.Addresses.DNSHTTPS), clientAddrs, dnsHTTPSPort)
 	httpAddrs := b.makeAddrs(b.expandAddrs("addresses.http", c.Addresses.HTTP), clientAddrs, httpPort)
 	httpsAddrs := b.makeAddrs(b.expandAddrs("addresses.https", c.Addresses.HTTPS), clientAddrs, httpsPort)
 	grpcAddrs := b.makeAddrs(b.expandAddrs("addresses.grpc", c.Addresses.GRPC), clientAddrs, grpcPort)
@@ -597,23 +674,9 @@ func (b *builder) build() (rt RuntimeConfig, err error) {
 	}
 
 	// expand dns recursors
-	uniq := map[string]bool{}
-	dnsRecursors := []string{}
-	for _, r := range c.DNSRecursors {
-		x, err := template.Parse(r)
-		if err != nil {
-			return RuntimeConfig{}, fmt.Errorf("Invalid DNS recursor template %q: %s", r, err)
-		}
-		for _, addr := range strings.Fields(x) {
-			if strings.HasPrefix(addr, "unix://") {
-				return RuntimeConfig{}, fmt.Errorf("DNS Recursors cannot be unix sockets: %s", addr)
-			}
-			if uniq[addr] {
-				continue
-			}
-			uniq[addr] = true
-			dnsRecursors = append(dnsRecursors, addr)
-		}
+	dnsRecursors, err := expandDNSRecursors(c.DNSRecursors)
+	if err != nil {
+		return RuntimeConfig{}, err
 	}
 
 	datacenter := strings.ToLower(stringVal(c.Datacenter))
@@ -713,6 +776,13 @@ func (b *builder) build() (rt RuntimeConfig, err error) {
 	if connectMeshGatewayWANFederationEnabled && !connectEnabled {
 		return RuntimeConfig{}, fmt.Errorf("'connect.enable_mesh_gateway_wan_federation=true' requires 'connect.enabled=true'")
 	}
+	var connectWorkloadAPISocketPath string
+	if c.Connect.WorkloadAPI != nil {
+		connectWorkloadAPISocketPath = stringVal(c.Connect.WorkloadAPI.SocketPath)
+	}
+	if connectWorkloadAPISocketPath != "" && !connectEnabled {
+		return RuntimeConfig{}, fmt.Errorf("'connect.workload_api.socket_path' requires 'connect.enabled=true'")
+	}
 	if connectCAConfig != nil {
 		// nolint: staticcheck // CA config should be changed to use HookTranslateKeys
 		lib.TranslateKeys(connectCAConfig, map[string]string{
@@ -906,9 +976,16 @@ func (b *builder) build() (rt RuntimeConfig, err error) {
 		DNSNodeTTL:            b.durationVal("dns_config.node_ttl", c.DNS.NodeTTL),
 		DNSOnlyPassing:        boolVal(c.DNS.OnlyPassing),
 		DNSPort:               dnsPort,
-		DNSRecursorStrategy:   b.dnsRecursorStrategyVal(stringVal(c.DNS.RecursorStrategy)),
-		DNSRecursorTimeout:    b.durationVal("recursor_timeout", c.DNS.RecursorTimeout),
+		DNSTLSAddrs:           dnsTLSAddrs,
+		DNSTLSPort:            dnsTLSPort,
+		DNSHTTPSAddrs:         dnsHTTPSAddrs,
+		DNSHTTPSPort:          dnsHTTPSPort,
+		DNSRecursorStrategy:   dnsRecursorStrategy,
+		DNSRecursorTimeout:    dnsRecursorTimeout,
 		DNSRecursors:          dnsRecursors,
+		DNSForwardingRules:    dnsForwardingRules,
+		DNSProximityMode:      dnsProximityMode,
+		DNSProximityLimit:     dnsProximityLimit,
 		DNSServiceTTL:         dnsServiceTTL,
 		DNSSOA:                soa,
 		DNSUDPAnswerLimit:     intVal(c.DNS.UDPAnswerLimit),
@@ -916,6 +993,31 @@ func (b *builder) build() (rt RuntimeConfig, err error) {
 		DNSUseCache:           boolVal(c.DNS.UseCache),
 		DNSCacheMaxAge:        b.durationVal("dns_config.cache_max_age", c.DNS.CacheMaxAge),
 
+		DNSZoneTransferAllowedCIDRs: dnsZoneTransferCIDRs,
+		DNSZoneTransferTSIGKeys:     dnsZoneTransferKeys,
+
+		DNSUpdateKeys:                           dnsUpdateKeys,
+		DNSUpdateDeregisterCriticalServiceAfter: dnsUpdateDeregisterAfter,
+
+		DNSViews: dnsViews,
+
+		DNSResponseCacheEnabled:         dnsResponseCacheEnabled,
+		DNSResponseCacheSize:            dnsResponseCacheSize,
+		DNSResponseCacheMinTTL:          dnsResponseCacheMinTTL,
+		DNSResponseCacheMaxTTL:          dnsResponseCacheMaxTTL,
+		DNSResponseCachePrefetchHits:    dnsResponseCachePrefetchHits,
+		DNSResponseCachePrefetchPercent: dnsResponseCachePrefetchPercent,
+
+		DNSQueryLogMode:         dnsQueryLogMode,
+		DNSQueryLogDnstapSocket: dnsQueryLogDnstapSocket,
+		DNSQueryLogDnstapFile:   dnsQueryLogDnstapFile,
+		DNSQueryLogSampleRate:   dnsQueryLogSampleRate,
+		DNSQueryLogQueryTypes:   dnsQueryLogQueryTypes,
+
+		DNSSECEnabled:           dnssecEnabled,
+		DNSSECSignatureValidity: dnssecSignatureValidity,
+		DNSSECKeyPublishDelay:   dnssecKeyPublishDelay,
+
 		// HTTP
 		HTTPPort:            httpPort,
 		HTTPSPort:           httpsPort,
@@ -976,6 +1078,8 @@ func (b *builder) build() (rt RuntimeConfig, err error) {
 		AutoReloadConfig:                       boolVal(c.AutoReloadConfig),
 		CheckUpdateInterval:                    b.durationVal("check_update_interval", c.CheckUpdateInterval),
 		CheckOutputMaxSize:                     intValWithDefault(c.CheckOutputMaxSize, 4096),
+		CheckConcurrency:                       checkConcurrencyVal(c.Limits.CheckConcurrency),
+		CheckJitterPercent:                     intVal(c.CheckJitterPercent),
 		Checks:                                 checks,
 		ClientAddrs:                            clientAddrs,
 		ConfigEntryBootstrap:                   configEntries,
@@ -992,6 +1096,7 @@ func (b *builder) build() (rt RuntimeConfig, err error) {
 		ConnectSidecarMinPort:                  sidecarMinPort,
 		ConnectSidecarMaxPort:                  sidecarMaxPort,
 		ConnectTestCALeafRootChangeSpread:      b.durationVal("connect.test_ca_leaf_root_change_spread", c.Connect.TestCALeafRootChangeSpread),
+		ConnectWorkloadAPISocketPath:           connectWorkloadAPISocketPath,
 		ExposeMinPort:                          exposeMinPort,
 		ExposeMaxPort:                          exposeMaxPort,
 		DataDir:                                dataDir,
@@ -1300,11 +1405,29 @@ func (b *builder) validate(rt RuntimeConfig) error {
 			return fmt.Errorf("DNS address cannot be a unix socket")
 		}
 	}
+	for _, a := range rt.DNSTLSAddrs {
+		if _, ok := a.(*net.UnixAddr); ok {
+			return fmt.Errorf("DNS over TLS address cannot be a unix socket")
+		}
+	}
 	for _, a := range rt.DNSRecursors {
 		if ipaddr.IsAny(a) {
 			return fmt.Errorf("DNS recursor address cannot be 0.0.0.0, :: or [::]")
 		}
 	}
+	for _, rule := range rt.DNSForwardingRules {
+		for _, a := range rule.Recursors {
+			if ipaddr.IsAny(a) {
+				return fmt.Errorf("DNS recursor address cannot be 0.0.0.0, :: or [::]")
+			}
+		}
+		domain := strings.TrimPrefix(rule.Domain, "*.")
+		for _, d := range []string{rt.DNSDomain, rt.DNSAltDomain} {
+			if d != "" && miekgdns.IsSubDomain(miekgdns.Fqdn(strings.ToLower(d)), domain) {
+				return fmt.Errorf("DNS forwarding rule for %q cannot be within the Consul domain %q", rule.Domain, d)
+			}
+		}
+	}
 	if !isValidAltDomain(rt.DNSAltDomain, rt.Datacenter) {
 		return fmt.Errorf("alt_domain cannot start with {service,connect,node,query,addr,%s}", rt.Datacenter)
 	}
@@ -1326,6 +1449,23 @@ func (b *builder) validate(rt RuntimeConfig) error {
 	if rt.CheckOutputMaxSize < 1 {
 		return fmt.Errorf("check_output_max_size must be positive, to discard check output use the discard_check_output flag")
 	}
+	if rt.CheckJitterPercent < 0 || rt.CheckJitterPercent > 50 {
+		return fmt.Errorf("check_jitter_percent must be between 0 and 50")
+	}
+	for name, limit := range map[string]int{
+		"script":     rt.CheckConcurrency.Script,
+		"http":       rt.CheckConcurrency.HTTP,
+		"tcp":        rt.CheckConcurrency.TCP,
+		"udp":        rt.CheckConcurrency.UDP,
+		"grpc":       rt.CheckConcurrency.GRPC,
+		"h2ping":     rt.CheckConcurrency.H2PING,
+		"docker":     rt.CheckConcurrency.Docker,
+		"os_service": rt.CheckConcurrency.OSService,
+	} {
+		if limit < 0 {
+			return fmt.Errorf("limits.check_concurrency.%s cannot be negative", name)
+		}
+	}
 	if rt.AEInterval <= 0 {
 		return fmt.Errorf("ae_interval cannot be %s. Must be positive", rt.AEInterval)
 	}
@@ -1348,6 +1488,66 @@ func (b *builder) validate(rt RuntimeConfig) error {
 	if rt.DNSARecordLimit < 0 {
 		return fmt.Errorf("dns_config.a_record_limit cannot be %d. Must be greater than or equal to zero", rt.DNSARecordLimit)
 	}
+	switch rt.DNSProximityMode {
+	case "", "sort", "restrict":
+	default:
+		return fmt.Errorf("dns_config.proximity.mode must be one of 'sort' or 'restrict', was %q", rt.DNSProximityMode)
+	}
+	if rt.DNSProximityLimit < 1 {
+		return fmt.Errorf("dns_config.proximity.limit must be at least 1, was %d", rt.DNSProximityLimit)
+	}
+	switch rt.DNSQueryLogMode {
+	case "", "log":
+	case "dnstap":
+		if (rt.DNSQueryLogDnstapSocket == "") == (rt.DNSQueryLogDnstapFile == "") {
+			return fmt.Errorf("dns_config.query_log requires exactly one of dnstap_socket or dnstap_file in the 'dnstap' mode")
+		}
+	default:
+		return fmt.Errorf("dns_config.query_log.mode must be one of 'dnstap' or 'log', was %q", rt.DNSQueryLogMode)
+	}
+	if rt.DNSQueryLogSampleRate <= 0 || rt.DNSQueryLogSampleRate > 1 {
+		return fmt.Errorf("dns_config.query_log.sample_rate must be greater than 0 and at most 1, was %v", rt.DNSQueryLogSampleRate)
+	}
+	if rt.DNSResponseCacheSize < 1 {
+		return fmt.Errorf("dns_config.response_cache.size must be at least 1, was %d", rt.DNSResponseCacheSize)
+	}
+	if rt.DNSResponseCacheMinTTL < 0 || rt.DNSResponseCacheMaxTTL < rt.DNSResponseCacheMinTTL {
+		return fmt.Errorf("dns_config.response_cache.max_ttl must be at least min_ttl, and min_ttl at least 0")
+	}
+	if rt.DNSResponseCachePrefetchHits < 0 {
+		return fmt.Errorf("dns_config.response_cache.prefetch_hits cannot be %d. Must be greater than or equal to zero", rt.DNSResponseCachePrefetchHits)
+	}
+	if rt.DNSResponseCachePrefetchPercent < 1 || rt.DNSResponseCachePrefetchPercent > 99 {
+		return fmt.Errorf("dns_config.response_cache.prefetch_percent must be between 1 and 99, was %d", rt.DNSResponseCachePrefetchPercent)
+	}
+	if rt.DNSSECEnabled && rt.DNSSECSignatureValidity < time.Hour {
+		return fmt.Errorf("dns_config.dnssec.signature_validity must be at least 1h, was %s", rt.DNSSECSignatureValidity)
+	}
+	// Agents refresh their DNSSEC keys every minute, and resolvers cache the
+	// DNSKEY RRset for the negative TTL of the SOA record.
+	if minDelay := time.Duration(rt.DNSSOA.Minttl)*time.Second + time.Minute; rt.DNSSECEnabled && rt.DNSSECKeyPublishDelay < minDelay {
+		return fmt.Errorf("dns_config.dnssec.key_publish_delay must be at least dns_config.soa.min_ttl plus 1m (%s), was %s", minDelay, rt.DNSSECKeyPublishDelay)
+	}
+	seenKeys := make(map[string]DNSTSIGKey)
+	for _, k := range rt.DNSZoneTransferTSIGKeys {
+		seenKeys[k.Name] = k
+	}
+	for i, k := range rt.DNSUpdateKeys {
+		// The keys share the TSIG secrets of the DNS server.
+		if other, ok := seenKeys[k.Name]; ok && other != k.DNSTSIGKey {
+			return fmt.Errorf("dns_config.dynamic_updates.tsig_keys[%d]: key %q is already defined with a different algorithm or secret", i, k.Name)
+		}
+		seenKeys[k.Name] = k.DNSTSIGKey
+		if rt.ACLsEnabled && k.Token == "" {
+			return fmt.Errorf("dns_config.dynamic_updates.tsig_keys[%d]: key %q requires a token when ACLs are enabled", i, k.Name)
+		}
+	}
+	if len(rt.DNSUpdateKeys) > 0 && rt.DNSUpdateDeregisterCriticalServiceAfter < time.Minute {
+		return fmt.Errorf("dns_config.dynamic_updates.deregister_critical_service_after must be at least 1m, was %s", rt.DNSUpdateDeregisterCriticalServiceAfter)
+	}
+	if len(rt.DNSZoneTransferTSIGKeys) > 0 && len(rt.DNSZoneTransferAllowedCIDRs) == 0 {
+		return fmt.Errorf("dns_config.zone_transfer.tsig_keys requires dns_config.zone_transfer.allowed_cidrs to be set")
+	}
 	if err := structs.ValidateNodeMetadata(rt.NodeMeta, false); err != nil {
 		return fmt.Errorf("node_meta invalid: %v", err)
 	}
@@ -1420,6 +1620,12 @@ func (b *builder) validate(rt RuntimeConfig) error {
 	if err := addrsUnique(inuse, "HTTPS", rt.HTTPSAddrs); err != nil {
 		return err
 	}
+	if err := addrsUnique(inuse, "DNS over TLS", rt.DNSTLSAddrs); err != nil {
+		return err
+	}
+	if err := addrsUnique(inuse, "DNS over HTTPS", rt.DNSHTTPSAddrs); err != nil {
+		return err
+	}
 	if err := addrUnique(inuse, "RPC Advertise", rt.RPCAdvertiseAddr); err != nil {
 		return err
 	}
@@ -1620,11 +1826,15 @@ func (b *builder) checkVal(v *CheckDefinition) *structs.CheckDefinition {
 		TLSSkipVerify:                  boolVal(v.TLSSkipVerify),
 		AliasNode:                      stringVal(v.AliasNode),
 		AliasService:                   stringVal(v.AliasService),
+		AliasDatacenter:                stringVal(v.AliasDatacenter),
+		AliasPeer:                      stringVal(v.AliasPeer),
 		Timeout:                        b.durationVal(fmt.Sprintf("check[%s].timeout", id), v.Timeout),
 		TTL:                            b.durationVal(fmt.Sprintf("check[%s].ttl", id), v.TTL),
 		SuccessBeforePassing:           intVal(v.SuccessBeforePassing),
 		FailuresBeforeCritical:         intVal(v.FailuresBeforeCritical),
 		FailuresBeforeWarning:          intValWithDefault(v.FailuresBeforeWarning, intVal(v.FailuresBeforeCritical)),
+		DynamicWeight:                  boolVal(v.DynamicWeight),
+		DependsOn:                      types.CheckID(stringVal(v.DependsOn)),
 		H2PING:                         stringVal(v.H2PING),
 		H2PingUseTLS:                   H2PingUseTLSVal,
 		OSService:                      stringVal(v.OSService),
@@ -1634,6 +1844,19 @@ func (b *builder) checkVal(v *CheckDefinition) *structs.CheckDefinition {
 	}
 }
 
+func checkConcurrencyVal(v CheckConcurrency) checks.ConcurrencyLimits {
+	return checks.ConcurrencyLimits{
+		Script:    intVal(v.Script),
+		HTTP:      intVal(v.HTTP),
+		TCP:       intVal(v.TCP),
+		UDP:       intVal(v.UDP),
+		GRPC:      intVal(v.GRPC),
+		H2PING:    intVal(v.H2PING),
+		Docker:    intVal(v.Docker),
+		OSService: intVal(v.OSService),
+	}
+}
+
 func (b *builder) svcTaggedAddresses(v map[string]ServiceAddress) map[string]structs.ServiceAddress {
 	if len(v) <= 0 {
 		return nil
@@ -1810,6 +2033,98 @@ func (b *builder) dnsRecursorStrategyVal(v string) dns.RecursorStrategy {
 	return out
 }
 
+// expandDNSRecursors expands the go-sockaddr templates of recursor addresses
+// and removes duplicates.
+func expandDNSRecursors(v []string) ([]string, error) {
+	uniq := map[string]bool{}
+	recursors := []string{}
+	for _, r := range v {
+		x, err := template.Parse(r)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid DNS recursor template %q: %s", r, err)
+		}
+		for _, addr := range strings.Fields(x) {
+			if strings.HasPrefix(addr, "unix://") {
+				return nil, fmt.Errorf("DNS Recursors cannot be unix sockets: %s", addr)
+			}
+			if uniq[addr] {
+				continue
+			}
+			uniq[addr] = true
+			recursors = append(recursors, addr)
+		}
+	}
+	return recursors, nil
+}
+
+// dnsForwardingRulesVal builds the forwarding rules. Rules without their own
+// strategy or timeout use the ones of the default recursors.
+func (b *builder) dnsForwardingRulesVal(v []RawDNSForwardingRule, strategy dns.RecursorStrategy, timeout time.Duration) []DNSForwardingRule {
+	var rules []DNSForwardingRule
+	seen := make(map[string]bool)
+	for i, r := range v {
+		name := fmt.Sprintf("dns_config.forwarding_rules[%d]", i)
+		rule := DNSForwardingRule{
+			Domain:           miekgdns.Fqdn(strings.ToLower(stringVal(r.Domain))),
+			RecursorStrategy: strategy,
+			RecursorTimeout:  timeout,
+		}
+		if rule.Domain == "." {
+			b.err = multierror.Append(b.err, fmt.Errorf("%s: domain is required, use recursors to forward all other queries", name))
+			continue
+		}
+		if _, ok := miekgdns.IsDomainName(strings.TrimPrefix(rule.Domain, "*.")); !ok {
+			b.err = multierror.Append(b.err, fmt.Errorf("%s: invalid domain %q", name, stringVal(r.Domain)))
+			continue
+		}
+		if seen[rule.Domain] {
+			b.err = multierror.Append(b.err, fmt.Errorf("%s: duplicate rule for domain %q", name, rule.Domain))
+			continue
+		}
+		seen[rule.Domain] = true
+
+		recursors, err := expandDNSRecursors(r.Recursors)
+		if err != nil {
+			b.err = multierror.Append(b.err, fmt.Errorf("%s: %w", name, err))
+			continue
+		}
+		if len(recursors) == 0 {
+			b.err = multierror.Append(b.err, fmt.Errorf("%s: recursors are required", name))
+			continue
+		}
+		rule.Recursors = recursors
+
+		if r.RecursorStrategy != nil {
+			switch s := dns.RecursorStrategy(*r.RecursorStrategy); s {
+			case dns.RecursorStrategyRandom, dns.RecursorStrategySequential:
+				rule.RecursorStrategy = s
+			default:
+				b.err = multierror.Append(b.err, fmt.Errorf("%s.recursor_strategy: invalid strategy: %q", name, *r.RecursorStrategy))
+			}
+		}
+		if r.RecursorTimeout != nil {
+			rule.RecursorTimeout = b.durationVal(name+".recursor_timeout", r.RecursorTimeout)
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+// dnsQueryTypesVal normalizes a list of DNS query type names, such as "srv",
+// to their upper case form.
+func (b *builder) dnsQueryTypesVal(name string, v []string) []string {
+	var types []string
+	for i, t := range v {
+		t = strings.ToUpper(t)
+		if _, ok := miekgdns.StringToType[t]; !ok {
+			b.err = multierror.Append(b.err, fmt.Errorf("%s[%d]: invalid query type %q", name, i, v[i]))
+			continue
+		}
+		types = append(types, t)
+	}
+	return types
+}
+
 func (b *builder) requestsLimitsModeVal(v string) consulrate.Mode {
 	var out consulrate.Mode
 
@@ -1910,6 +2225,91 @@ func (b *builder) uiConfigVal(v RawUIConfig) UIConfig {
 	}
 }
 
+// dnsTSIGAlgorithms are the TSIG algorithms accepted for zone transfers and
+// dynamic updates.
+var dnsTSIGAlgorithms = []string{"hmac-sha1", "hmac-sha224", "hmac-sha256", "hmac-sha384", "hmac-sha512"}
+
+func (b *builder) dnsTSIGKeysVal(path string, v []RawDNSTSIGKey) []DNSTSIGKey {
+	var keys []DNSTSIGKey
+	for i, k := range v {
+		name := fmt.Sprintf("%s[%d]", path, i)
+		key := DNSTSIGKey{
+			Name:      strings.ToLower(stringVal(k.Name)),
+			Algorithm: strings.TrimSuffix(strings.ToLower(stringValWithDefault(k.Algorithm, "hmac-sha256")), "."),
+			Secret:    stringVal(k.Secret),
+		}
+		if key.Name == "" {
+			b.err = multierror.Append(b.err, fmt.Errorf("%s: name is required", name))
+			continue
+		}
+		if !strings.HasSuffix(key.Name, ".") {
+			key.Name += "."
+		}
+		if !stringslice.Contains(dnsTSIGAlgorithms, key.Algorithm) {
+			b.err = multierror.Append(b.err, fmt.Errorf("%s: algorithm must be one of %s", name, strings.Join(dnsTSIGAlgorithms, ", ")))
+		}
+		if _, err := base64.StdEncoding.DecodeString(key.Secret); err != nil || key.Secret == "" {
+			b.err = multierror.Append(b.err, fmt.Errorf("%s: secret must be a base64 encoded string", name))
+		}
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// dnsUpdateKeysVal builds the TSIG keys of dynamic updates along with their
+// tokens.
+func (b *builder) dnsUpdateKeysVal(v []RawDNSUpdateKey) []DNSUpdateKey {
+	raw := make([]RawDNSTSIGKey, len(v))
+	for i, k := range v {
+		raw[i] = RawDNSTSIGKey{Name: k.Name, Algorithm: k.Algorithm, Secret: k.Secret}
+	}
+	tsigKeys := b.dnsTSIGKeysVal("dns_config.dynamic_updates.tsig_keys", raw)
+	if len(tsigKeys) != len(v) {
+		// Keys without a name were skipped and reported.
+		return nil
+	}
+	keys := make([]DNSUpdateKey, len(v))
+	for i, k := range tsigKeys {
+		keys[i] = DNSUpdateKey{DNSTSIGKey: k, Token: stringVal(v[i].Token)}
+	}
+	return keys
+}
+
+// dnsViewsVal builds the DNS views. A view must match clients by network or
+// client certificate and have a token.
+func (b *builder) dnsViewsVal(v []RawDNSView) []DNSView {
+	var views []DNSView
+	seen := make(map[string]bool)
+	for i, r := range v {
+		name := fmt.Sprintf("dns_config.views[%d]", i)
+		view := DNSView{
+			Name:            stringVal(r.Name),
+			SourceCIDRs:     b.cidrsVal(name+".source_cidrs", r.SourceCIDRs),
+			ClientCertNames: r.ClientCertNames,
+			Token:           stringVal(r.Token),
+			Partition:       stringVal(r.Partition),
+			Namespace:       stringVal(r.Namespace),
+		}
+		if view.Name == "" {
+			b.err = multierror.Append(b.err, fmt.Errorf("%s: name is required", name))
+			continue
+		}
+		if seen[view.Name] {
+			b.err = multierror.Append(b.err, fmt.Errorf("%s: duplicate view %q", name, view.Name))
+			continue
+		}
+		seen[view.Name] = true
+		if len(view.SourceCIDRs) == 0 && len(view.ClientCertNames) == 0 {
+			b.err = multierror.Append(b.err, fmt.Errorf("%s: at least one of source_cidrs or client_cert_names is required", name))
+		}
+		if view.Token == "" {
+			b.err = multierror.Append(b.err, fmt.Errorf("%s: token is required", name))
+		}
+		views = append(views, view)
+	}
+	return views
+}
+
 func (b *builder) uiMetricsProxyVal(v RawUIMetricsProxy) UIMetricsProxy {
 	var hdrs []UIMetricsProxyAddHeader
 
@@ -45,6 +45,14 @@ func validateEnterpriseConfigKeys(config *Config) []error {
 		add("dns_config.prefer_namespace")
 		config.DNS.PreferNamespace = nil
 	}
+	for i, v := range config.DNS.Views {
+		if stringVal(v.Partition) != "" {
+			add(fmt.Sprintf("dns_config.views[%d].partition", i))
+		}
+		if stringVal(v.Namespace) != "" {
+			add(fmt.Sprintf("dns_config.views[%d].namespace", i))
+		}
+	}
 	if config.ACL.MSPDisableBootstrap != nil {
 		add("acl.msp_disable_bootstrap")
 		config.ACL.MSPDisableBootstrap = nil
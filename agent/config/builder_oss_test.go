@@ -73,6 +73,15 @@ func TestValidateEnterpriseConfigKeys(t *testing.T) {
 				require.Nil(t, c.DNS.PreferNamespace)
 			},
 		},
+		"dns_config.views partition and namespace": {
+			config: Config{
+				DNS: DNS{Views: []RawDNSView{
+					{Name: &stringVal},
+					{Name: &stringVal, Partition: &stringVal, Namespace: &stringVal},
+				}},
+			},
+			badKeys: []string{"dns_config.views[1].partition", "dns_config.views[1].namespace"},
+		},
 		"acl.msp_disable_bootstrap": {
 			config: Config{
 				ACL: ACL{MSPDisableBootstrap: &boolVal},
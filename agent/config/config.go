@@ -151,6 +151,7 @@ type Config struct {
 	Cache                            Cache               `mapstructure:"cache" json:"-"`
 	Check                            *CheckDefinition    `mapstructure:"check" json:"-"` // needs to be a pointer to avoid partial merges
 	CheckOutputMaxSize               *int                `mapstructure:"check_output_max_size" json:"check_output_max_size,omitempty"`
+	CheckJitterPercent               *int                `mapstructure:"check_jitter_percent" json:"check_jitter_percent,omitempty"`
 	CheckUpdateInterval              *string             `mapstructure:"check_update_interval" json:"check_update_interval,omitempty"`
 	Checks                           []CheckDefinition   `mapstructure:"checks" json:"-"`
 	ClientAddr                       *string             `mapstructure:"client_addr" json:"client_addr,omitempty"`
@@ -346,11 +347,13 @@ type Consul struct {
 }
 
 type Addresses struct {
-	DNS     *string `mapstructure:"dns"`
-	HTTP    *string `mapstructure:"http"`
-	HTTPS   *string `mapstructure:"https"`
-	GRPC    *string `mapstructure:"grpc"`
-	GRPCTLS *string `mapstructure:"grpc_tls"`
+	DNS      *string `mapstructure:"dns"`
+	HTTP     *string `mapstructure:"http"`
+	HTTPS    *string `mapstructure:"https"`
+	GRPC     *string `mapstructure:"grpc"`
+	GRPCTLS  *string `mapstructure:"grpc_tls"`
+	DNSTLS   *string `mapstructure:"dns_tls"`
+	DNSHTTPS *string `mapstructure:"dns_https"`
 }
 
 type AdvertiseAddrsConfig struct {
@@ -431,6 +434,8 @@ type CheckDefinition struct {
 	TLSSkipVerify                  *bool               `mapstructure:"tls_skip_verify" alias:"tlsskipverify"`
 	AliasNode                      *string             `mapstructure:"alias_node"`
 	AliasService                   *string             `mapstructure:"alias_service"`
+	AliasDatacenter                *string             `mapstructure:"alias_datacenter"`
+	AliasPeer                      *string             `mapstructure:"alias_peer"`
 	Timeout                        *string             `mapstructure:"timeout"`
 	TTL                            *string             `mapstructure:"ttl"`
 	H2PING                         *string             `mapstructure:"h2ping"`
@@ -439,6 +444,8 @@ type CheckDefinition struct {
 	SuccessBeforePassing           *int                `mapstructure:"success_before_passing"`
 	FailuresBeforeWarning          *int                `mapstructure:"failures_before_warning"`
 	FailuresBeforeCritical         *int                `mapstructure:"failures_before_critical"`
+	DynamicWeight                  *bool               `mapstructure:"dynamic_weight"`
+	DependsOn                      *string             `mapstructure:"depends_on"`
 	DeregisterCriticalServiceAfter *string             `mapstructure:"deregister_critical_service_after" alias:"deregistercriticalserviceafter"`
 
 	EnterpriseMeta `mapstructure:",squash"`
@@ -625,6 +632,7 @@ type Connect struct {
 	CAProvider                      *string                `mapstructure:"ca_provider" json:"ca_provider,omitempty"`
 	CAConfig                        map[string]interface{} `mapstructure:"ca_config" json:"ca_config,omitempty"`
 	MeshGatewayWANFederationEnabled *bool                  `mapstructure:"enable_mesh_gateway_wan_federation" json:"enable_mesh_gateway_wan_federation,omitempty"`
+	WorkloadAPI                     *ConnectWorkloadAPI    `mapstructure:"workload_api" json:"workload_api,omitempty"`
 
 	// TestCALeafRootChangeSpread controls how long after a CA roots change before new leaf certs will be generated.
 	// This is only tuned in tests, generally set to 1ns to make tests deterministic with when to expect updated leaf
@@ -632,6 +640,11 @@ type Connect struct {
 	TestCALeafRootChangeSpread *string `mapstructure:"test_ca_leaf_root_change_spread" json:"test_ca_leaf_root_change_spread,omitempty"`
 }
 
+// ConnectWorkloadAPI is the configuration of the SPIFFE Workload API
+type ConnectWorkloadAPI struct {
+	SocketPath *string `mapstructure:"socket_path" json:"socket_path,omitempty"`
+}
+
 // SOA is the configuration of SOA for DNS
 type SOA struct {
 	Refresh *uint32 `mapstructure:"refresh"`
@@ -640,22 +653,111 @@ type SOA struct {
 	Minttl  *uint32 `mapstructure:"min_ttl"`
 }
 
+// DNSZoneTransfer is the configuration of AXFR/IXFR zone transfers for DNS
+type DNSZoneTransfer struct {
+	AllowedCIDRs []string        `mapstructure:"allowed_cidrs"`
+	TSIGKeys     []RawDNSTSIGKey `mapstructure:"tsig_keys"`
+}
+
+type RawDNSTSIGKey struct {
+	Name      *string `mapstructure:"name"`
+	Algorithm *string `mapstructure:"algorithm"`
+	Secret    *string `mapstructure:"secret"`
+}
+
+// DNSDynamicUpdates is the configuration of RFC 2136 dynamic updates of
+// services through DNS
+type DNSDynamicUpdates struct {
+	TSIGKeys                       []RawDNSUpdateKey `mapstructure:"tsig_keys"`
+	DeregisterCriticalServiceAfter *string           `mapstructure:"deregister_critical_service_after"`
+}
+
+type RawDNSUpdateKey struct {
+	Name      *string `mapstructure:"name"`
+	Algorithm *string `mapstructure:"algorithm"`
+	Secret    *string `mapstructure:"secret"`
+	Token     *string `mapstructure:"token"`
+}
+
+// RawDNSForwardingRule forwards queries for a domain to its own recursors
+// RawDNSView answers the clients of some networks or DNS over TLS client
+// certificates with its own token.
+type RawDNSView struct {
+	Name            *string  `mapstructure:"name"`
+	SourceCIDRs     []string `mapstructure:"source_cidrs"`
+	ClientCertNames []string `mapstructure:"client_cert_names"`
+	Token           *string  `mapstructure:"token"`
+
+	// Enterprise Only
+	Partition *string `mapstructure:"partition"`
+	// Enterprise Only
+	Namespace *string `mapstructure:"namespace"`
+}
+
+type RawDNSForwardingRule struct {
+	Domain           *string  `mapstructure:"domain"`
+	Recursors        []string `mapstructure:"recursors"`
+	RecursorStrategy *string  `mapstructure:"recursor_strategy"`
+	RecursorTimeout  *string  `mapstructure:"recursor_timeout"`
+}
+
+// DNSProximity is the configuration of sorting DNS answers by proximity
+type DNSProximity struct {
+	Mode  *string `mapstructure:"mode"`
+	Limit *int    `mapstructure:"limit"`
+}
+
+// DNSResponseCache is the configuration of caching the DNS responses of the
+// agent and its recursors.
+type DNSResponseCache struct {
+	Enabled         *bool   `mapstructure:"enabled"`
+	Size            *int    `mapstructure:"size"`
+	MinTTL          *string `mapstructure:"min_ttl"`
+	MaxTTL          *string `mapstructure:"max_ttl"`
+	PrefetchHits    *int    `mapstructure:"prefetch_hits"`
+	PrefetchPercent *int    `mapstructure:"prefetch_percent"`
+}
+
+// DNSQueryLog is the configuration of logging DNS queries and responses
+type DNSQueryLog struct {
+	Mode         *string  `mapstructure:"mode"`
+	DnstapSocket *string  `mapstructure:"dnstap_socket"`
+	DnstapFile   *string  `mapstructure:"dnstap_file"`
+	SampleRate   *float64 `mapstructure:"sample_rate"`
+	QueryTypes   []string `mapstructure:"query_types"`
+}
+
+// DNSSEC is the configuration of DNSSEC signing of DNS answers
+type DNSSEC struct {
+	Enabled           *bool   `mapstructure:"enabled"`
+	SignatureValidity *string `mapstructure:"signature_validity"`
+	KeyPublishDelay   *string `mapstructure:"key_publish_delay"`
+}
+
 type DNS struct {
-	AllowStale         *bool             `mapstructure:"allow_stale"`
-	ARecordLimit       *int              `mapstructure:"a_record_limit"`
-	DisableCompression *bool             `mapstructure:"disable_compression"`
-	EnableTruncate     *bool             `mapstructure:"enable_truncate"`
-	MaxStale           *string           `mapstructure:"max_stale"`
-	NodeTTL            *string           `mapstructure:"node_ttl"`
-	OnlyPassing        *bool             `mapstructure:"only_passing"`
-	RecursorStrategy   *string           `mapstructure:"recursor_strategy"`
-	RecursorTimeout    *string           `mapstructure:"recursor_timeout"`
-	ServiceTTL         map[string]string `mapstructure:"service_ttl"`
-	UDPAnswerLimit     *int              `mapstructure:"udp_answer_limit"`
-	NodeMetaTXT        *bool             `mapstructure:"enable_additional_node_meta_txt"`
-	SOA                *SOA              `mapstructure:"soa"`
-	UseCache           *bool             `mapstructure:"use_cache"`
-	CacheMaxAge        *string           `mapstructure:"cache_max_age"`
+	AllowStale         *bool                  `mapstructure:"allow_stale"`
+	ARecordLimit       *int                   `mapstructure:"a_record_limit"`
+	DisableCompression *bool                  `mapstructure:"disable_compression"`
+	EnableTruncate     *bool                  `mapstructure:"enable_truncate"`
+	MaxStale           *string                `mapstructure:"max_stale"`
+	NodeTTL            *string                `mapstructure:"node_ttl"`
+	OnlyPassing        *bool                  `mapstructure:"only_passing"`
+	RecursorStrategy   *string                `mapstructure:"recursor_strategy"`
+	RecursorTimeout    *string                `mapstructure:"recursor_timeout"`
+	ServiceTTL         map[string]string      `mapstructure:"service_ttl"`
+	UDPAnswerLimit     *int                   `mapstructure:"udp_answer_limit"`
+	NodeMetaTXT        *bool                  `mapstructure:"enable_additional_node_meta_txt"`
+	SOA                *SOA                   `mapstructure:"soa"`
+	UseCache           *bool                  `mapstructure:"use_cache"`
+	CacheMaxAge        *string                `mapstructure:"cache_max_age"`
+	ZoneTransfer       *DNSZoneTransfer       `mapstructure:"zone_transfer"`
+	DNSSEC             *DNSSEC                `mapstructure:"dnssec"`
+	ForwardingRules    []RawDNSForwardingRule `mapstructure:"forwarding_rules"`
+	Proximity          *DNSProximity          `mapstructure:"proximity"`
+	QueryLog           *DNSQueryLog           `mapstructure:"query_log"`
+	DynamicUpdates     *DNSDynamicUpdates     `mapstructure:"dynamic_updates"`
+	Views              []RawDNSView           `mapstructure:"views"`
+	ResponseCache      *DNSResponseCache      `mapstructure:"response_cache"`
 
 	// Enterprise Only
 	PreferNamespace *bool `mapstructure:"prefer_namespace"`
@@ -710,6 +812,8 @@ type Ports struct {
 	Server         *int `mapstructure:"server" json:"server,omitempty"`
 	GRPC           *int `mapstructure:"grpc" json:"grpc,omitempty"`
 	GRPCTLS        *int `mapstructure:"grpc_tls" json:"grpc_tls,omitempty"`
+	DNSTLS         *int `mapstructure:"dns_tls" json:"dns_tls,omitempty"`
+	DNSHTTPS       *int `mapstructure:"dns_https" json:"dns_https,omitempty"`
 	ProxyMinPort   *int `mapstructure:"proxy_min_port" json:"proxy_min_port,omitempty"`
 	ProxyMaxPort   *int `mapstructure:"proxy_max_port" json:"proxy_max_port,omitempty"`
 	SidecarMinPort *int `mapstructure:"sidecar_min_port" json:"sidecar_min_port,omitempty"`
@@ -731,16 +835,28 @@ type RequestLimits struct {
 }
 
 type Limits struct {
-	HTTPMaxConnsPerClient *int          `mapstructure:"http_max_conns_per_client"`
-	HTTPSHandshakeTimeout *string       `mapstructure:"https_handshake_timeout"`
-	RequestLimits         RequestLimits `mapstructure:"request_limits"`
-	RPCClientTimeout      *string       `mapstructure:"rpc_client_timeout"`
-	RPCHandshakeTimeout   *string       `mapstructure:"rpc_handshake_timeout"`
-	RPCMaxBurst           *int          `mapstructure:"rpc_max_burst"`
-	RPCMaxConnsPerClient  *int          `mapstructure:"rpc_max_conns_per_client"`
-	RPCRate               *float64      `mapstructure:"rpc_rate"`
-	KVMaxValueSize        *uint64       `mapstructure:"kv_max_value_size"`
-	TxnMaxReqLen          *uint64       `mapstructure:"txn_max_req_len"`
+	CheckConcurrency      CheckConcurrency `mapstructure:"check_concurrency"`
+	HTTPMaxConnsPerClient *int             `mapstructure:"http_max_conns_per_client"`
+	HTTPSHandshakeTimeout *string          `mapstructure:"https_handshake_timeout"`
+	RequestLimits         RequestLimits    `mapstructure:"request_limits"`
+	RPCClientTimeout      *string          `mapstructure:"rpc_client_timeout"`
+	RPCHandshakeTimeout   *string          `mapstructure:"rpc_handshake_timeout"`
+	RPCMaxBurst           *int             `mapstructure:"rpc_max_burst"`
+	RPCMaxConnsPerClient  *int             `mapstructure:"rpc_max_conns_per_client"`
+	RPCRate               *float64         `mapstructure:"rpc_rate"`
+	KVMaxValueSize        *uint64          `mapstructure:"kv_max_value_size"`
+	TxnMaxReqLen          *uint64          `mapstructure:"txn_max_req_len"`
+}
+
+type CheckConcurrency struct {
+	Script    *int `mapstructure:"script"`
+	HTTP      *int `mapstructure:"http"`
+	TCP       *int `mapstructure:"tcp"`
+	UDP       *int `mapstructure:"udp"`
+	GRPC      *int `mapstructure:"grpc"`
+	H2PING    *int `mapstructure:"h2ping"`
+	Docker    *int `mapstructure:"docker"`
+	OSService *int `mapstructure:"os_service"`
 }
 
 type Segment struct {
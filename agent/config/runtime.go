@@ -14,6 +14,7 @@ import (
 	"golang.org/x/time/rate"
 
 	"github.com/hashicorp/consul/agent/cache"
+	"github.com/hashicorp/consul/agent/checks"
 	"github.com/hashicorp/consul/agent/consul"
 	consulrate "github.com/hashicorp/consul/agent/consul/rate"
 	"github.com/hashicorp/consul/agent/dns"
@@ -291,6 +292,94 @@ type RuntimeConfig struct {
 	// flag: -recursor string [-recursor string]
 	DNSRecursors []string
 
+	// DNSForwardingRules forward queries for particular domains to their
+	// own recursors instead of DNSRecursors. The rule with the longest
+	// matching domain is used.
+	//
+	// hcl: dns_config { forwarding_rules = [{ domain = string recursors = []string recursor_strategy = "(random|sequential)" recursor_timeout = "duration" }] }
+	DNSForwardingRules []DNSForwardingRule
+
+	// DNSProximityMode controls whether service lookups are ordered by the
+	// network distance between the instances and the client, which is
+	// identified by its EDNS Client Subnet or source address. "sort" orders
+	// the answers, "restrict" only returns the DNSProximityLimit nearest
+	// instances. Proximity is not used when empty.
+	//
+	// hcl: dns_config { proximity { mode = "(sort|restrict)" } }
+	DNSProximityMode string
+
+	// DNSProximityLimit is the number of instances returned in the "restrict"
+	// proximity mode. Defaults to 3.
+	//
+	// hcl: dns_config { proximity { limit = int } }
+	DNSProximityLimit int
+
+	// DNSQueryLogMode enables logging of DNS queries and their responses.
+	// "dnstap" writes them in the dnstap format to DNSQueryLogDnstapSocket or
+	// DNSQueryLogDnstapFile, "log" writes a line per query to the agent log.
+	// Queries are not logged when empty.
+	//
+	// hcl: dns_config { query_log { mode = "(dnstap|log)" } }
+	DNSQueryLogMode string
+
+	// DNSQueryLogDnstapSocket is the path of the Unix socket of a dnstap
+	// collector.
+	//
+	// hcl: dns_config { query_log { dnstap_socket = string } }
+	DNSQueryLogDnstapSocket string
+
+	// DNSQueryLogDnstapFile is the path of a file dnstap frames are written
+	// to. The file is truncated when the agent starts.
+	//
+	// hcl: dns_config { query_log { dnstap_file = string } }
+	DNSQueryLogDnstapFile string
+
+	// DNSQueryLogSampleRate is the fraction of queries that are logged.
+	// Defaults to 1.
+	//
+	// hcl: dns_config { query_log { sample_rate = float64 } }
+	DNSQueryLogSampleRate float64
+
+	// DNSQueryLogQueryTypes limits logging to queries of these types, such
+	// as "A" or "SRV". Queries of all types are logged when empty.
+	//
+	// hcl: dns_config { query_log { query_types = []string } }
+	DNSQueryLogQueryTypes []string
+
+	// DNSResponseCacheEnabled caches the DNS responses of the agent and its
+	// recursors for their TTL. Negative responses are cached for the TTL of
+	// their SOA record, as described in RFC 2308.
+	//
+	// hcl: dns_config { response_cache { enabled = (true|false) } }
+	DNSResponseCacheEnabled bool
+
+	// DNSResponseCacheSize is the maximum number of responses in the cache.
+	// The least recently used responses are evicted first. Defaults to 10000.
+	//
+	// hcl: dns_config { response_cache { size = int } }
+	DNSResponseCacheSize int
+
+	// DNSResponseCacheMinTTL and DNSResponseCacheMaxTTL bound how long a
+	// response is cached for, regardless of the TTL of its records. They
+	// default to 0 and 1h, so responses with a TTL of 0 are not cached.
+	//
+	// hcl: dns_config { response_cache { min_ttl = "duration" max_ttl = "duration" } }
+	DNSResponseCacheMinTTL time.Duration
+	DNSResponseCacheMaxTTL time.Duration
+
+	// DNSResponseCachePrefetchHits is the number of times a response must be
+	// served from the cache to be refreshed in the background before it
+	// expires. Prefetching is disabled when 0. Defaults to 3.
+	//
+	// hcl: dns_config { response_cache { prefetch_hits = int } }
+	DNSResponseCachePrefetchHits int
+
+	// DNSResponseCachePrefetchPercent is the percentage of the TTL of a
+	// response left when it is prefetched. Defaults to 10.
+	//
+	// hcl: dns_config { response_cache { prefetch_percent = int } }
+	DNSResponseCachePrefetchPercent int
+
 	// DNSUseCache whether or not to use cache for dns queries
 	//
 	// hcl: dns_config { use_cache = (true|false) }
@@ -301,6 +390,64 @@ type RuntimeConfig struct {
 	// hcl: dns_config { cache_max_age = "duration" }
 	DNSCacheMaxAge time.Duration
 
+	// DNSZoneTransferAllowedCIDRs is the list of networks allowed to request
+	// AXFR and IXFR zone transfers of the Consul domain. Zone transfers are
+	// disabled when the list is empty.
+	//
+	// hcl: dns_config { zone_transfer { allowed_cidrs = []string } }
+	DNSZoneTransferAllowedCIDRs []*net.IPNet
+
+	// DNSZoneTransferTSIGKeys is the list of TSIG keys accepted for zone
+	// transfers. When set, zone transfer requests must be signed with one
+	// of these keys.
+	//
+	// hcl: dns_config { zone_transfer { tsig_keys = [{ name = string algorithm = string secret = string }] } }
+	DNSZoneTransferTSIGKeys []DNSTSIGKey
+
+	// DNSUpdateKeys are the TSIG keys RFC 2136 UPDATE messages for the service
+	// domain are accepted with. Services added through an update are
+	// registered with the token of the key they were signed with. Dynamic
+	// updates are disabled when the list is empty.
+	//
+	// hcl: dns_config { dynamic_updates { tsig_keys = [{ name = string algorithm = string secret = string token = string }] } }
+	DNSUpdateKeys []DNSUpdateKey
+
+	// DNSUpdateDeregisterCriticalServiceAfter is how long a service added
+	// through an update is kept after its TTL check became critical, because
+	// it was not refreshed by another update. Defaults to 1h.
+	//
+	// hcl: dns_config { dynamic_updates { deregister_critical_service_after = "duration" } }
+	DNSUpdateDeregisterCriticalServiceAfter time.Duration
+
+	// DNSViews answer the queries of clients in their networks, or presenting
+	// one of their client certificate names over DNS over TLS, with their own
+	// ACL token and default partition and namespace instead of the agent's
+	// default token. The first matching view is used.
+	//
+	// hcl: dns_config { views = [{ name = string source_cidrs = []string client_cert_names = []string token = string partition = string namespace = string }] }
+	DNSViews []DNSView
+
+	// DNSSECEnabled enables DNSSEC signing of answers for the Consul domain
+	// and alt domain. The keys are shared by all agents and kept by the
+	// servers of the primary datacenter.
+	//
+	// hcl: dns_config { dnssec { enabled = (true|false) } }
+	DNSSECEnabled bool
+
+	// DNSSECSignatureValidity is how long RRSIG signatures are valid for.
+	// Defaults to 24h.
+	//
+	// hcl: dns_config { dnssec { signature_validity = "duration" } }
+	DNSSECSignatureValidity time.Duration
+
+	// DNSSECKeyPublishDelay is how long a new DNSSEC key is published in the
+	// DNSKEY RRset before answers are signed with it. Must exceed the TTL of
+	// the DNSKEY RRset plus the time agents take to fetch the new key.
+	// Defaults to 1h.
+	//
+	// hcl: dns_config { dnssec { key_publish_delay = "duration" } }
+	DNSSECKeyPublishDelay time.Duration
+
 	// HTTPUseCache whether or not to use cache for http queries. Defaults
 	// to true.
 	//
@@ -421,6 +568,21 @@ type RuntimeConfig struct {
 	// flag: -check_output_max_size int
 	CheckOutputMaxSize int
 
+	// CheckConcurrency is the maximum number of health checks of each type
+	// that the agent executes at the same time. A check that is due while
+	// the limit for its type is reached waits for a running check of the
+	// same type to finish. Zero means unlimited.
+	//
+	// hcl: limits { check_concurrency { script = int http = int ... } }
+	CheckConcurrency checks.ConcurrencyLimits
+
+	// CheckJitterPercent randomly shifts the interval between two runs of
+	// a check by up to this percentage of the interval, so that checks with
+	// the same interval do not keep firing together.
+	//
+	// hcl: check_jitter_percent = int
+	CheckJitterPercent int
+
 	// Checks contains the provided check definitions.
 	//
 	// hcl: checks = [
@@ -533,6 +695,13 @@ type RuntimeConfig struct {
 	// deterministic again.
 	ConnectTestCALeafRootChangeSpread time.Duration
 
+	// ConnectWorkloadAPISocketPath is the path of the Unix socket the agent
+	// serves the SPIFFE Workload API on. The Workload API is disabled when
+	// empty.
+	//
+	// hcl: connect { workload_api { socket_path = string } }
+	ConnectWorkloadAPISocketPath string
+
 	// DNSAddrs contains the list of TCP and UDP addresses the DNS server will
 	// bind to. If the DNS endpoint is disabled (ports.dns <= 0) the list is
 	// empty.
@@ -555,6 +724,45 @@ type RuntimeConfig struct {
 	// flags: -dns-port int
 	DNSPort int
 
+	// DNSTLSAddrs contains the list of TCP addresses the DNS over TLS
+	// (RFC 7858) server will bind to. If the endpoint is disabled
+	// (ports.dns_tls <= 0) the list is empty.
+	//
+	// The ip addresses are taken from 'addresses.dns_tls' which should contain
+	// a space separated list of ip addresses and/or go-sockaddr templates.
+	//
+	// If 'addresses.dns_tls' was not provided the 'client_addr' addresses are
+	// used.
+	//
+	// hcl: client_addr = string addresses { dns_tls = string } ports { dns_tls = int }
+	DNSTLSAddrs []net.Addr
+
+	// DNSTLSPort is the port the DNS over TLS server listens on. The default
+	// is -1. Setting this to a value <= 0 disables the endpoint.
+	//
+	// hcl: ports { dns_tls = int }
+	DNSTLSPort int
+
+	// DNSHTTPSAddrs contains the list of TCP addresses and UNIX sockets the
+	// DNS over HTTPS (RFC 8484) server will bind to. If the endpoint is
+	// disabled (ports.dns_https <= 0) the list is empty.
+	//
+	// The ip addresses are taken from 'addresses.dns_https' which should
+	// contain a space separated list of ip addresses, UNIX socket paths and/or
+	// go-sockaddr templates.
+	//
+	// If 'addresses.dns_https' was not provided the 'client_addr' addresses
+	// are used.
+	//
+	// hcl: client_addr = string addresses { dns_https = string } ports { dns_https = int }
+	DNSHTTPSAddrs []net.Addr
+
+	// DNSHTTPSPort is the port the DNS over HTTPS server listens on. The
+	// default is -1. Setting this to a value <= 0 disables the endpoint.
+	//
+	// hcl: ports { dns_https = int }
+	DNSHTTPSPort int
+
 	// DNSSOA is the settings applied for DNS SOA
 	// hcl: soa {}
 	DNSSOA RuntimeSOAConfig
@@ -1538,6 +1746,38 @@ type UIMetricsProxyAddHeader struct {
 	Value string
 }
 
+type DNSForwardingRule struct {
+	// Domain is the fully qualified domain the rule applies to. A domain
+	// starting with "*." only matches names below it.
+	Domain           string
+	Recursors        []string
+	RecursorStrategy dns.RecursorStrategy
+	RecursorTimeout  time.Duration
+}
+
+type DNSTSIGKey struct {
+	Name      string
+	Algorithm string
+	Secret    string
+}
+
+// DNSUpdateKey is a TSIG key DNS updates are accepted with, and the ACL
+// token updates signed with it are authorized by.
+type DNSUpdateKey struct {
+	DNSTSIGKey
+	Token string
+}
+
+// DNSView is a set of DNS clients answered with their own ACL token.
+type DNSView struct {
+	Name            string
+	SourceCIDRs     []*net.IPNet
+	ClientCertNames []string
+	Token           string
+	Partition       string
+	Namespace       string
+}
+
 func (c *RuntimeConfig) apiAddresses(maxPerType int) (unixAddrs, httpAddrs, httpsAddrs []string) {
 	if len(c.HTTPSAddrs) > 0 {
 		for i, addr := range c.HTTPSAddrs {
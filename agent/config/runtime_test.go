@@ -1172,6 +1172,43 @@ func TestLoad_IntegrationWithFlags(t *testing.T) {
 			rt.DataDir = dataDir
 		},
 	})
+	run(t, testCase{
+		desc: "dns over tls and https addresses and ports",
+		args: []string{`-data-dir=` + dataDir},
+		json: []string{`{
+					"client_addr": "0.0.0.0",
+					"addresses": { "dns_tls": "1.1.1.1" },
+					"ports":{ "dns_tls": 853, "dns_https": 8443 }
+				}`},
+		hcl: []string{`
+					client_addr = "0.0.0.0"
+					addresses = { dns_tls = "1.1.1.1" }
+					ports { dns_tls = 853 dns_https = 8443 }
+				`},
+		expected: func(rt *RuntimeConfig) {
+			rt.ClientAddrs = []*net.IPAddr{ipAddr("0.0.0.0")}
+			rt.DNSAddrs = []net.Addr{tcpAddr("0.0.0.0:8600"), udpAddr("0.0.0.0:8600")}
+			rt.HTTPAddrs = []net.Addr{tcpAddr("0.0.0.0:8500")}
+			rt.DNSTLSPort = 853
+			rt.DNSTLSAddrs = []net.Addr{tcpAddr("1.1.1.1:853")}
+			rt.DNSHTTPSPort = 8443
+			rt.DNSHTTPSAddrs = []net.Addr{tcpAddr("0.0.0.0:8443")}
+			rt.DataDir = dataDir
+		},
+	})
+	run(t, testCase{
+		desc: "dns over tls address cannot be a unix socket",
+		args: []string{`-data-dir=` + dataDir},
+		json: []string{`{
+					"addresses": { "dns_tls": "unix:///var/run/consul-dns.sock" },
+					"ports":{ "dns_tls": 853 }
+				}`},
+		hcl: []string{`
+					addresses = { dns_tls = "unix:///var/run/consul-dns.sock" }
+					ports { dns_tls = 853 }
+				`},
+		expectedErr: "DNS over TLS address cannot be a unix socket",
+	})
 	run(t, testCase{
 		desc: "client template and ports",
 		args: []string{`-data-dir=` + dataDir},
@@ -1864,6 +1901,37 @@ func TestLoad_IntegrationWithFlags(t *testing.T) {
 		hcl:         []string{`bind_addr = "unix:///foo"`},
 		expectedErr: "bind_addr cannot be a unix socket",
 	})
+	run(t, testCase{
+		desc: "check concurrency and jitter",
+		args: []string{`-data-dir=` + dataDir},
+		json: []string{`{
+			"check_jitter_percent": 10,
+			"limits": { "check_concurrency": { "script": 4, "http": 32 } }
+		}`},
+		hcl: []string{`
+			check_jitter_percent = 10
+			limits { check_concurrency { script = 4 http = 32 } }
+		`},
+		expected: func(rt *RuntimeConfig) {
+			rt.DataDir = dataDir
+			rt.CheckJitterPercent = 10
+			rt.CheckConcurrency = checks.ConcurrencyLimits{Script: 4, HTTP: 32}
+		},
+	})
+	run(t, testCase{
+		desc:        "check_jitter_percent out of range",
+		args:        []string{`-data-dir=` + dataDir},
+		json:        []string{`{ "check_jitter_percent": 60 }`},
+		hcl:         []string{`check_jitter_percent = 60`},
+		expectedErr: "check_jitter_percent must be between 0 and 50",
+	})
+	run(t, testCase{
+		desc:        "check_concurrency negative",
+		args:        []string{`-data-dir=` + dataDir},
+		json:        []string{`{ "limits": { "check_concurrency": { "tcp": -1 } } }`},
+		hcl:         []string{`limits { check_concurrency { tcp = -1 } }`},
+		expectedErr: "limits.check_concurrency.tcp cannot be negative",
+	})
 	run(t, testCase{
 		desc: "bootstrap without server",
 		args: []string{
@@ -2084,6 +2152,278 @@ func TestLoad_IntegrationWithFlags(t *testing.T) {
 		hcl:         []string{`dns_config = { a_record_limit = -1 }`},
 		expectedErr: "dns_config.a_record_limit cannot be -1. Must be greater than or equal to zero",
 	})
+	run(t, testCase{
+		desc: "dns_config.zone_transfer",
+		args: []string{`-data-dir=` + dataDir},
+		json: []string{`{
+			"dns_config": {
+				"zone_transfer": {
+					"allowed_cidrs": ["10.0.0.0/8"],
+					"tsig_keys": [{ "name": "Transfer", "secret": "c2VjcmV0" }]
+				}
+			}
+		}`},
+		hcl: []string{`
+			dns_config {
+				zone_transfer {
+					allowed_cidrs = ["10.0.0.0/8"]
+					tsig_keys = [{ name = "Transfer" secret = "c2VjcmV0" }]
+				}
+			}
+		`},
+		expected: func(rt *RuntimeConfig) {
+			rt.DataDir = dataDir
+			rt.DNSZoneTransferAllowedCIDRs = []*net.IPNet{parseCIDR(t, "10.0.0.0/8")}
+			rt.DNSZoneTransferTSIGKeys = []DNSTSIGKey{{Name: "transfer.", Algorithm: "hmac-sha256", Secret: "c2VjcmV0"}}
+		},
+	})
+	run(t, testCase{
+		desc: "dns_config.dynamic_updates",
+		args: []string{`-data-dir=` + dataDir},
+		json: []string{`{ "dns_config": { "dynamic_updates": { "tsig_keys": [{ "name": "appliances", "secret": "c2VjcmV0", "token": "abc" }] } } }`},
+		hcl:  []string{`dns_config { dynamic_updates { tsig_keys = [{ name = "appliances" secret = "c2VjcmV0" token = "abc" }] } }`},
+		expected: func(rt *RuntimeConfig) {
+			rt.DataDir = dataDir
+			rt.DNSUpdateKeys = []DNSUpdateKey{{
+				DNSTSIGKey: DNSTSIGKey{Name: "appliances.", Algorithm: "hmac-sha256", Secret: "c2VjcmV0"},
+				Token:      "abc",
+			}}
+			rt.DNSUpdateDeregisterCriticalServiceAfter = time.Hour
+		},
+	})
+	run(t, testCase{
+		desc: "dns_config.views",
+		args: []string{`-data-dir=` + dataDir},
+		json: []string{`{ "dns_config": { "views": [{ "name": "dmz", "source_cidrs": ["10.1.0.0/16"], "token": "abc" }] } }`},
+		hcl:  []string{`dns_config { views = [{ name = "dmz" source_cidrs = ["10.1.0.0/16"] token = "abc" }] }`},
+		expected: func(rt *RuntimeConfig) {
+			rt.DataDir = dataDir
+			rt.DNSViews = []DNSView{{
+				Name:        "dmz",
+				SourceCIDRs: []*net.IPNet{parseCIDR(t, "10.1.0.0/16")},
+				Token:       "abc",
+			}}
+		},
+	})
+	run(t, testCase{
+		desc:        "dns_config.views invalid",
+		args:        []string{`-data-dir=` + dataDir},
+		json:        []string{`{ "dns_config": { "views": [{ "name": "dmz" }, { "name": "dmz", "source_cidrs": ["10.1.0.0/16"], "token": "abc" }] } }`},
+		hcl:         []string{`dns_config { views = [{ name = "dmz" }, { name = "dmz" source_cidrs = ["10.1.0.0/16"] token = "abc" }] }`},
+		expectedErr: `dns_config.views[0]: at least one of source_cidrs or client_cert_names is required`,
+	})
+	run(t, testCase{
+		desc: "dns_config.response_cache",
+		args: []string{`-data-dir=` + dataDir},
+		json: []string{`{ "dns_config": { "response_cache": { "enabled": true, "prefetch_hits": 0 } } }`},
+		hcl:  []string{`dns_config { response_cache { enabled = true prefetch_hits = 0 } }`},
+		expected: func(rt *RuntimeConfig) {
+			rt.DataDir = dataDir
+			rt.DNSResponseCacheEnabled = true
+			rt.DNSResponseCachePrefetchHits = 0
+		},
+	})
+	run(t, testCase{
+		desc:        "dns_config.response_cache invalid prefetch_percent",
+		args:        []string{`-data-dir=` + dataDir},
+		json:        []string{`{ "dns_config": { "response_cache": { "enabled": true, "prefetch_percent": 100 } } }`},
+		hcl:         []string{`dns_config { response_cache { enabled = true prefetch_percent = 100 } }`},
+		expectedErr: "dns_config.response_cache.prefetch_percent must be between 1 and 99, was 100",
+	})
+	run(t, testCase{
+		desc:        "dns_config.response_cache max_ttl below min_ttl",
+		args:        []string{`-data-dir=` + dataDir},
+		json:        []string{`{ "dns_config": { "response_cache": { "min_ttl": "10m", "max_ttl": "1m" } } }`},
+		hcl:         []string{`dns_config { response_cache { min_ttl = "10m" max_ttl = "1m" } }`},
+		expectedErr: "dns_config.response_cache.max_ttl must be at least min_ttl, and min_ttl at least 0",
+	})
+	run(t, testCase{
+		desc:        "dns_config.dynamic_updates conflicting key",
+		args:        []string{`-data-dir=` + dataDir},
+		json:        []string{`{ "dns_config": { "zone_transfer": { "allowed_cidrs": ["10.0.0.0/8"], "tsig_keys": [{ "name": "shared", "secret": "c2VjcmV0" }] }, "dynamic_updates": { "tsig_keys": [{ "name": "shared", "secret": "b3RoZXI=" }] } } }`},
+		hcl:         []string{`dns_config { zone_transfer { allowed_cidrs = ["10.0.0.0/8"] tsig_keys = [{ name = "shared" secret = "c2VjcmV0" }] } dynamic_updates { tsig_keys = [{ name = "shared" secret = "b3RoZXI=" }] } }`},
+		expectedErr: `dns_config.dynamic_updates.tsig_keys[0]: key "shared." is already defined with a different algorithm or secret`,
+	})
+	run(t, testCase{
+		desc:        "dns_config.dynamic_updates key without token",
+		args:        []string{`-data-dir=` + dataDir},
+		json:        []string{`{ "acl": { "enabled": true }, "dns_config": { "dynamic_updates": { "tsig_keys": [{ "name": "appliances", "secret": "c2VjcmV0" }] } } }`},
+		hcl:         []string{`acl { enabled = true } dns_config { dynamic_updates { tsig_keys = [{ name = "appliances" secret = "c2VjcmV0" }] } }`},
+		expectedErr: `dns_config.dynamic_updates.tsig_keys[0]: key "appliances." requires a token when ACLs are enabled`,
+	})
+	run(t, testCase{
+		desc:        "dns_config.dynamic_updates deregister too soon",
+		args:        []string{`-data-dir=` + dataDir},
+		json:        []string{`{ "dns_config": { "dynamic_updates": { "tsig_keys": [{ "name": "appliances", "secret": "c2VjcmV0" }], "deregister_critical_service_after": "30s" } } }`},
+		hcl:         []string{`dns_config { dynamic_updates { tsig_keys = [{ name = "appliances" secret = "c2VjcmV0" }] deregister_critical_service_after = "30s" } }`},
+		expectedErr: "dns_config.dynamic_updates.deregister_critical_service_after must be at least 1m, was 30s",
+	})
+	run(t, testCase{
+		desc:        "dns_config.zone_transfer invalid tsig key",
+		args:        []string{`-data-dir=` + dataDir},
+		json:        []string{`{ "dns_config": { "zone_transfer": { "allowed_cidrs": ["10.0.0.0/8"], "tsig_keys": [{ "name": "transfer", "algorithm": "hmac-md5", "secret": "c2VjcmV0" }] } } }`},
+		hcl:         []string{`dns_config { zone_transfer { allowed_cidrs = ["10.0.0.0/8"] tsig_keys = [{ name = "transfer" algorithm = "hmac-md5" secret = "c2VjcmV0" }] } }`},
+		expectedErr: "dns_config.zone_transfer.tsig_keys[0]: algorithm must be one of hmac-sha1, hmac-sha224, hmac-sha256, hmac-sha384, hmac-sha512",
+	})
+	run(t, testCase{
+		desc:        "dns_config.zone_transfer tsig keys without allowed cidrs",
+		args:        []string{`-data-dir=` + dataDir},
+		json:        []string{`{ "dns_config": { "zone_transfer": { "tsig_keys": [{ "name": "transfer", "secret": "c2VjcmV0" }] } } }`},
+		hcl:         []string{`dns_config { zone_transfer { tsig_keys = [{ name = "transfer" secret = "c2VjcmV0" }] } }`},
+		expectedErr: "dns_config.zone_transfer.tsig_keys requires dns_config.zone_transfer.allowed_cidrs to be set",
+	})
+	run(t, testCase{
+		desc: "dns_config.forwarding_rules",
+		args: []string{`-data-dir=` + dataDir},
+		json: []string{`{
+			"recursors": ["8.8.8.8"],
+			"dns_config": {
+				"recursor_strategy": "random",
+				"forwarding_rules": [
+					{ "domain": "corp.example.com", "recursors": ["10.0.0.1", "10.0.0.2", "10.0.0.1"] },
+					{ "domain": "*.internal.", "recursors": ["10.0.1.1"], "recursor_strategy": "sequential", "recursor_timeout": "5s" }
+				]
+			}
+		}`},
+		hcl: []string{`
+			recursors = ["8.8.8.8"]
+			dns_config {
+				recursor_strategy = "random"
+				forwarding_rules = [
+					{ domain = "corp.example.com" recursors = ["10.0.0.1", "10.0.0.2", "10.0.0.1"] },
+					{ domain = "*.internal." recursors = ["10.0.1.1"] recursor_strategy = "sequential" recursor_timeout = "5s" }
+				]
+			}
+		`},
+		expected: func(rt *RuntimeConfig) {
+			rt.DataDir = dataDir
+			rt.DNSRecursors = []string{"8.8.8.8"}
+			rt.DNSRecursorStrategy = "random"
+			rt.DNSForwardingRules = []DNSForwardingRule{
+				{Domain: "corp.example.com.", Recursors: []string{"10.0.0.1", "10.0.0.2"}, RecursorStrategy: "random", RecursorTimeout: 2 * time.Second},
+				{Domain: "*.internal.", Recursors: []string{"10.0.1.1"}, RecursorStrategy: "sequential", RecursorTimeout: 5 * time.Second},
+			}
+		},
+	})
+	run(t, testCase{
+		desc:        "dns_config.forwarding_rules without recursors",
+		args:        []string{`-data-dir=` + dataDir},
+		json:        []string{`{ "dns_config": { "forwarding_rules": [{ "domain": "corp.example.com" }] } }`},
+		hcl:         []string{`dns_config { forwarding_rules = [{ domain = "corp.example.com" }] }`},
+		expectedErr: "dns_config.forwarding_rules[0]: recursors are required",
+	})
+	run(t, testCase{
+		desc:        "dns_config.forwarding_rules invalid strategy",
+		args:        []string{`-data-dir=` + dataDir},
+		json:        []string{`{ "dns_config": { "forwarding_rules": [{ "domain": "corp.example.com", "recursors": ["10.0.0.1"], "recursor_strategy": "fastest" }] } }`},
+		hcl:         []string{`dns_config { forwarding_rules = [{ domain = "corp.example.com" recursors = ["10.0.0.1"] recursor_strategy = "fastest" }] }`},
+		expectedErr: `dns_config.forwarding_rules[0].recursor_strategy: invalid strategy: "fastest"`,
+	})
+	run(t, testCase{
+		desc:        "dns_config.forwarding_rules duplicate domain",
+		args:        []string{`-data-dir=` + dataDir},
+		json:        []string{`{ "dns_config": { "forwarding_rules": [{ "domain": "corp.example.com", "recursors": ["10.0.0.1"] }, { "domain": "CORP.example.com.", "recursors": ["10.0.0.2"] }] } }`},
+		hcl:         []string{`dns_config { forwarding_rules = [{ domain = "corp.example.com" recursors = ["10.0.0.1"] }, { domain = "CORP.example.com." recursors = ["10.0.0.2"] }] }`},
+		expectedErr: `dns_config.forwarding_rules[1]: duplicate rule for domain "corp.example.com."`,
+	})
+	run(t, testCase{
+		desc:        "dns_config.forwarding_rules within consul domain",
+		args:        []string{`-data-dir=` + dataDir},
+		json:        []string{`{ "dns_config": { "forwarding_rules": [{ "domain": "*.service.consul", "recursors": ["10.0.0.1"] }] } }`},
+		hcl:         []string{`dns_config { forwarding_rules = [{ domain = "*.service.consul" recursors = ["10.0.0.1"] }] }`},
+		expectedErr: `DNS forwarding rule for "*.service.consul." cannot be within the Consul domain "consul."`,
+	})
+	run(t, testCase{
+		desc: "dns_config.proximity",
+		args: []string{`-data-dir=` + dataDir},
+		json: []string{`{ "dns_config": { "proximity": { "mode": "sort" } } }`},
+		hcl:  []string{`dns_config { proximity { mode = "sort" } }`},
+		expected: func(rt *RuntimeConfig) {
+			rt.DataDir = dataDir
+			rt.DNSProximityMode = "sort"
+			rt.DNSProximityLimit = 3
+		},
+	})
+	run(t, testCase{
+		desc:        "dns_config.proximity invalid mode",
+		args:        []string{`-data-dir=` + dataDir},
+		json:        []string{`{ "dns_config": { "proximity": { "mode": "nearest" } } }`},
+		hcl:         []string{`dns_config { proximity { mode = "nearest" } }`},
+		expectedErr: `dns_config.proximity.mode must be one of 'sort' or 'restrict', was "nearest"`,
+	})
+	run(t, testCase{
+		desc:        "dns_config.proximity invalid limit",
+		args:        []string{`-data-dir=` + dataDir},
+		json:        []string{`{ "dns_config": { "proximity": { "mode": "restrict", "limit": 0 } } }`},
+		hcl:         []string{`dns_config { proximity { mode = "restrict" limit = 0 } }`},
+		expectedErr: "dns_config.proximity.limit must be at least 1, was 0",
+	})
+	run(t, testCase{
+		desc: "dns_config.dnssec",
+		args: []string{`-data-dir=` + dataDir},
+		json: []string{`{ "dns_config": { "dnssec": { "enabled": true } } }`},
+		hcl:  []string{`dns_config { dnssec { enabled = true } }`},
+		expected: func(rt *RuntimeConfig) {
+			rt.DataDir = dataDir
+			rt.DNSSECEnabled = true
+			rt.DNSSECSignatureValidity = 24 * time.Hour
+			rt.DNSSECKeyPublishDelay = time.Hour
+		},
+	})
+	run(t, testCase{
+		desc:        "dns_config.dnssec.signature_validity too short",
+		args:        []string{`-data-dir=` + dataDir},
+		json:        []string{`{ "dns_config": { "dnssec": { "enabled": true, "signature_validity": "30m" } } }`},
+		hcl:         []string{`dns_config { dnssec { enabled = true signature_validity = "30m" } }`},
+		expectedErr: "dns_config.dnssec.signature_validity must be at least 1h, was 30m0s",
+	})
+	run(t, testCase{
+		desc:        "dns_config.dnssec.key_publish_delay shorter than the negative TTL",
+		args:        []string{`-data-dir=` + dataDir},
+		json:        []string{`{ "dns_config": { "dnssec": { "enabled": true, "key_publish_delay": "10m" }, "soa": { "min_ttl": 3600 } } }`},
+		hcl:         []string{`dns_config { dnssec { enabled = true key_publish_delay = "10m" } soa { min_ttl = 3600 } }`},
+		expectedErr: "dns_config.dnssec.key_publish_delay must be at least dns_config.soa.min_ttl plus 1m (1h1m0s), was 10m0s",
+	})
+	run(t, testCase{
+		desc: "dns_config.query_log",
+		args: []string{`-data-dir=` + dataDir},
+		json: []string{`{ "dns_config": { "query_log": { "mode": "log", "query_types": ["a", "SRV"] } } }`},
+		hcl:  []string{`dns_config { query_log { mode = "log" query_types = ["a", "SRV"] } }`},
+		expected: func(rt *RuntimeConfig) {
+			rt.DataDir = dataDir
+			rt.DNSQueryLogMode = "log"
+			rt.DNSQueryLogSampleRate = 1
+			rt.DNSQueryLogQueryTypes = []string{"A", "SRV"}
+		},
+	})
+	run(t, testCase{
+		desc:        "dns_config.query_log invalid mode",
+		args:        []string{`-data-dir=` + dataDir},
+		json:        []string{`{ "dns_config": { "query_log": { "mode": "syslog" } } }`},
+		hcl:         []string{`dns_config { query_log { mode = "syslog" } }`},
+		expectedErr: `dns_config.query_log.mode must be one of 'dnstap' or 'log', was "syslog"`,
+	})
+	run(t, testCase{
+		desc:        "dns_config.query_log dnstap without destination",
+		args:        []string{`-data-dir=` + dataDir},
+		json:        []string{`{ "dns_config": { "query_log": { "mode": "dnstap" } } }`},
+		hcl:         []string{`dns_config { query_log { mode = "dnstap" } }`},
+		expectedErr: "dns_config.query_log requires exactly one of dnstap_socket or dnstap_file in the 'dnstap' mode",
+	})
+	run(t, testCase{
+		desc:        "dns_config.query_log invalid sample rate",
+		args:        []string{`-data-dir=` + dataDir},
+		json:        []string{`{ "dns_config": { "query_log": { "mode": "log", "sample_rate": 1.5 } } }`},
+		hcl:         []string{`dns_config { query_log { mode = "log" sample_rate = 1.5 } }`},
+		expectedErr: "dns_config.query_log.sample_rate must be greater than 0 and at most 1, was 1.5",
+	})
+	run(t, testCase{
+		desc:        "dns_config.query_log invalid query type",
+		args:        []string{`-data-dir=` + dataDir},
+		json:        []string{`{ "dns_config": { "query_log": { "mode": "log", "query_types": ["A", "QUERY"] } } }`},
+		hcl:         []string{`dns_config { query_log { mode = "log" query_types = ["A", "QUERY"] } }`},
+		expectedErr: `dns_config.query_log.query_types[1]: invalid query type "QUERY"`,
+	})
 	run(t, testCase{
 		desc: "performance.raft_multiplier < 0",
 		args: []string{
@@ -3612,6 +3952,48 @@ func TestLoad_IntegrationWithFlags(t *testing.T) {
 			`},
 		expectedErr: "'connect.enable_mesh_gateway_wan_federation=true' requires 'connect.enabled=true'",
 	})
+	run(t, testCase{
+		desc: "connect.workload_api",
+		args: []string{
+			`-data-dir=` + dataDir,
+		},
+		json: []string{`{
+			  "connect": {
+				"enabled": true,
+				"workload_api": { "socket_path": "/run/consul/workload.sock" }
+			  }
+			}`},
+		hcl: []string{`
+			  connect {
+			    enabled = true
+			    workload_api { socket_path = "/run/consul/workload.sock" }
+			  }
+			`},
+		expected: func(rt *RuntimeConfig) {
+			rt.DataDir = dataDir
+			rt.ConnectEnabled = true
+			rt.ConnectWorkloadAPISocketPath = "/run/consul/workload.sock"
+		},
+	})
+	run(t, testCase{
+		desc: "connect.workload_api requires connect.enabled",
+		args: []string{
+			`-data-dir=` + dataDir,
+		},
+		json: []string{`{
+			  "connect": {
+				"enabled": false,
+				"workload_api": { "socket_path": "/run/consul/workload.sock" }
+			  }
+			}`},
+		hcl: []string{`
+			  connect {
+			    enabled = false
+			    workload_api { socket_path = "/run/consul/workload.sock" }
+			  }
+			`},
+		expectedErr: "'connect.workload_api.socket_path' requires 'connect.enabled=true'",
+	})
 	run(t, testCase{
 		desc: "connect.enable_mesh_gateway_wan_federation cannot use -join-wan",
 		args: []string{
@@ -6304,6 +6686,7 @@ func TestLoad_FullConfig(t *testing.T) {
 			"CSRMaxConcurrent":    float64(2),
 		},
 		ConnectMeshGatewayWANFederationEnabled: false,
+		ConnectWorkloadAPISocketPath:           "/var/run/Ut8Ahd3g/workload.sock",
 		Cloud: hcpconfig.CloudConfig{
 			ResourceID:   "N43DsscE",
 			ClientID:     "6WvsDZCP",
@@ -6312,21 +6695,57 @@ func TestLoad_FullConfig(t *testing.T) {
 			AuthURL:      "332nCdR2",
 			ScadaAddress: "aoeusth232",
 		},
-		DNSAddrs:                         []net.Addr{tcpAddr("93.95.95.81:7001"), udpAddr("93.95.95.81:7001")},
-		DNSARecordLimit:                  29907,
-		DNSAllowStale:                    true,
-		DNSDisableCompression:            true,
-		DNSDomain:                        "7W1xXSqd",
-		DNSAltDomain:                     "1789hsd",
-		DNSEnableTruncate:                true,
-		DNSMaxStale:                      29685 * time.Second,
-		DNSNodeTTL:                       7084 * time.Second,
-		DNSOnlyPassing:                   true,
-		DNSPort:                          7001,
-		DNSRecursorStrategy:              "sequential",
-		DNSRecursorTimeout:               4427 * time.Second,
-		DNSRecursors:                     []string{"63.38.39.58", "92.49.18.18"},
-		DNSSOA:                           RuntimeSOAConfig{Refresh: 3600, Retry: 600, Expire: 86400, Minttl: 0},
+		DNSAddrs:              []net.Addr{tcpAddr("93.95.95.81:7001"), udpAddr("93.95.95.81:7001")},
+		DNSARecordLimit:       29907,
+		DNSAllowStale:         true,
+		DNSDisableCompression: true,
+		DNSDomain:             "7W1xXSqd",
+		DNSAltDomain:          "1789hsd",
+		DNSEnableTruncate:     true,
+		DNSMaxStale:           29685 * time.Second,
+		DNSNodeTTL:            7084 * time.Second,
+		DNSOnlyPassing:        true,
+		DNSPort:               7001,
+		DNSTLSPort:            5853,
+		DNSTLSAddrs:           []net.Addr{tcpAddr("41.18.73.12:5853")},
+		DNSHTTPSPort:          5443,
+		DNSHTTPSAddrs:         []net.Addr{tcpAddr("58.26.37.14:5443")},
+		DNSRecursorStrategy:   "sequential",
+		DNSRecursorTimeout:    4427 * time.Second,
+		DNSRecursors:          []string{"63.38.39.58", "92.49.18.18"},
+		DNSForwardingRules: []DNSForwardingRule{{
+			Domain:           "corp.example.com.",
+			Recursors:        []string{"10.43.12.7", "10.43.12.8"},
+			RecursorStrategy: "random",
+			RecursorTimeout:  3917 * time.Second,
+		}},
+		DNSSOA:                  RuntimeSOAConfig{Refresh: 3600, Retry: 600, Expire: 86400, Minttl: 0},
+		DNSSECEnabled:           true,
+		DNSSECSignatureValidity: 36 * time.Hour,
+		DNSSECKeyPublishDelay:   90 * time.Minute,
+		DNSProximityMode:        "restrict",
+		DNSProximityLimit:       7,
+		DNSQueryLogMode:         "dnstap",
+		DNSQueryLogDnstapSocket: "/var/run/a9M2jcRq/dnstap.sock",
+		DNSQueryLogSampleRate:   0.25,
+		DNSQueryLogQueryTypes:   []string{"SRV", "AAAA"},
+		DNSUpdateKeys: []DNSUpdateKey{{
+			DNSTSIGKey: DNSTSIGKey{Name: "appliances.", Algorithm: "hmac-sha512", Secret: "bW9QcTJ2aFg="},
+			Token:      "d0a5b6c1-7f1e-4a0b-9a3c-5e0f9c2d8b41",
+		}},
+		DNSUpdateDeregisterCriticalServiceAfter: 41 * time.Minute,
+		DNSViews: []DNSView{{
+			Name:            "dmz",
+			SourceCIDRs:     []*net.IPNet{cidr("198.18.0.0/16")},
+			ClientCertNames: []string{"dmz.example.com"},
+			Token:           "6a3f0c7e-2b1d-4e58-9c4a-0d7e5b2f8a13",
+		}},
+		DNSResponseCacheEnabled:          true,
+		DNSResponseCacheSize:             2817,
+		DNSResponseCacheMinTTL:           4 * time.Second,
+		DNSResponseCacheMaxTTL:           17 * time.Minute,
+		DNSResponseCachePrefetchHits:     6,
+		DNSResponseCachePrefetchPercent:  23,
 		DNSServiceTTL:                    map[string]time.Duration{"*": 32030 * time.Second},
 		DNSUDPAnswerLimit:                29909,
 		DNSNodeMetaTXT:                   true,
@@ -48,6 +48,8 @@ addresses = {
     https = "95.17.17.19"
     grpc = "32.31.61.91"
     grpc_tls = "23.14.88.19"
+    dns_tls = "41.18.73.12"
+    dns_https = "58.26.37.14"
 }
 advertise_addr = "17.99.29.16"
 advertise_addr_wan = "78.63.37.19"
@@ -228,6 +230,9 @@ connect {
     }
     enable_mesh_gateway_wan_federation = false
     enabled = true
+    workload_api {
+        socket_path = "/var/run/Ut8Ahd3g/workload.sock"
+    }
 }
 gossip_lan {
     gossip_nodes    = 6
@@ -274,6 +279,56 @@ dns_config {
     use_cache = true
     cache_max_age = "5m"
     prefer_namespace = true
+    dnssec {
+        enabled = true
+        signature_validity = "36h"
+        key_publish_delay = "90m"
+    }
+    forwarding_rules = [
+        {
+            domain = "Corp.Example.com"
+            recursors = ["10.43.12.7", "10.43.12.8"]
+            recursor_strategy = "random"
+            recursor_timeout = "3917s"
+        }
+    ]
+    proximity {
+        mode = "restrict"
+        limit = 7
+    }
+    query_log {
+        mode = "dnstap"
+        dnstap_socket = "/var/run/a9M2jcRq/dnstap.sock"
+        sample_rate = 0.25
+        query_types = ["srv", "AAAA"]
+    }
+    dynamic_updates {
+        tsig_keys = [
+            {
+                name = "Appliances"
+                algorithm = "hmac-sha512"
+                secret = "bW9QcTJ2aFg="
+                token = "d0a5b6c1-7f1e-4a0b-9a3c-5e0f9c2d8b41"
+            }
+        ]
+        deregister_critical_service_after = "41m"
+    }
+    views = [
+        {
+            name = "dmz"
+            source_cidrs = ["198.18.0.0/16"]
+            client_cert_names = ["dmz.example.com"]
+            token = "6a3f0c7e-2b1d-4e58-9c4a-0d7e5b2f8a13"
+        }
+    ]
+    response_cache {
+        enabled = true
+        size = 2817
+        min_ttl = "4s"
+        max_ttl = "17m"
+        prefetch_hits = 6
+        prefetch_percent = 23
+    }
 }
 enable_acl_replication = true
 enable_agent_tls_for_checks = true
@@ -341,6 +396,8 @@ ports {
     server = 3757
     grpc = 4881
     grpc_tls = 5201
+    dns_tls = 5853
+    dns_https = 5443
     proxy_min_port = 2000
     proxy_max_port = 3000
     sidecar_min_port = 8888
@@ -158,6 +158,9 @@ func MergeServiceConfig(defaults *structs.ServiceConfigResponse, service *struct
 		ns.Proxy.MutualTLSMode = defaults.MutualTLSMode
 	}
 
+	// Tracing can only be configured with config entries.
+	ns.Proxy.Tracing = defaults.Tracing
+
 	// remoteUpstreams contains synthetic Upstreams generated from central config (service-defaults.UpstreamConfigs).
 	remoteUpstreams := make(map[structs.PeeredServiceName]structs.Upstream)
 
@@ -52,6 +52,7 @@ func ComputeResolvedServiceConfig(
 		thisReply.Expose = proxyConf.Expose
 		thisReply.EnvoyExtensions = proxyConf.EnvoyExtensions
 		thisReply.AccessLogs = proxyConf.AccessLogs
+		thisReply.Tracing = proxyConf.Tracing
 
 		// Only MeshGateway and Protocol should affect upstreams.
 		// MeshGateway is strange. It's marshaled into UpstreamConfigs via the arbitrary map, but it
@@ -125,6 +126,11 @@ func ComputeResolvedServiceConfig(
 			thisReply.MutualTLSMode = serviceConf.MutualTLSMode
 		}
 
+		// Service defaults' tracing replaces the proxy defaults' tracing as a whole.
+		if serviceConf.Tracing != nil {
+			thisReply.Tracing = serviceConf.Tracing
+		}
+
 		thisReply.Meta = serviceConf.Meta
 		// Service defaults' envoy extensions are appended to the proxy defaults extensions so that proxy defaults
 		// extensions are applied first.
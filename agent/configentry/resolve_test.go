@@ -538,6 +538,65 @@ func Test_ComputeResolvedServiceConfig(t *testing.T) {
 				MutualTLSMode: structs.MutualTLSModePermissive,
 			},
 		},
+		{
+			name: "service-defaults inherits tracing from proxy-defaults",
+			args: args{
+				scReq: &structs.ServiceConfigRequest{
+					Name: "sid",
+				},
+				entries: &ResolvedServiceConfigSet{
+					ProxyDefaults: map[string]*structs.ProxyConfigEntry{
+						acl.DefaultEnterpriseMeta().PartitionOrDefault(): {
+							Tracing: &structs.TracingConfig{
+								Provider:    structs.ZipkinTracingProvider,
+								ServiceName: "zipkin",
+							},
+						},
+					},
+					ServiceDefaults: map[structs.ServiceID]*structs.ServiceConfigEntry{
+						sid: {},
+					},
+				},
+			},
+			want: &structs.ServiceConfigResponse{
+				Tracing: &structs.TracingConfig{
+					Provider:    structs.ZipkinTracingProvider,
+					ServiceName: "zipkin",
+				},
+			},
+		},
+		{
+			name: "service-defaults overrides tracing in proxy-defaults",
+			args: args{
+				scReq: &structs.ServiceConfigRequest{
+					Name: "sid",
+				},
+				entries: &ResolvedServiceConfigSet{
+					ProxyDefaults: map[string]*structs.ProxyConfigEntry{
+						acl.DefaultEnterpriseMeta().PartitionOrDefault(): {
+							Tracing: &structs.TracingConfig{
+								Provider:    structs.ZipkinTracingProvider,
+								ServiceName: "zipkin",
+							},
+						},
+					},
+					ServiceDefaults: map[structs.ServiceID]*structs.ServiceConfigEntry{
+						sid: {
+							Tracing: &structs.TracingConfig{
+								Provider:    structs.OpenTelemetryTracingProvider,
+								ServiceName: "otel-collector",
+							},
+						},
+					},
+				},
+			},
+			want: &structs.ServiceConfigResponse{
+				Tracing: &structs.TracingConfig{
+					Provider:    structs.OpenTelemetryTracingProvider,
+					ServiceName: "otel-collector",
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
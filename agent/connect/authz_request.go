@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// AuthorizeIntentionRequest evaluates the L7 permissions of an intention
+// against a simulated HTTP request in the same way as the RBAC filters
+// generated for Envoy. Permissions are evaluated in order and the first one
+// to match decides the outcome. If no permission matches, the request falls
+// through to the default intention policy.
+//
+// The returned index is that of the matching permission, or -1 if none of
+// them matched.
+func AuthorizeIntentionRequest(
+	ixn *structs.Intention,
+	req *structs.IntentionCheckHTTPRequest,
+	defaultAllow bool,
+) (bool, int, error) {
+	for i, perm := range ixn.Permissions {
+		match, err := IntentionPermissionMatch(perm, req)
+		if err != nil {
+			return false, -1, fmt.Errorf("permission %d: %w", i, err)
+		}
+		if match {
+			return perm.Action == structs.IntentionActionAllow, i, nil
+		}
+	}
+	return defaultAllow, -1, nil
+}
+
+// IntentionPermissionMatch determines whether the simulated HTTP request
+// satisfies all of the HTTP criteria of the given permission.
+func IntentionPermissionMatch(perm *structs.IntentionPermission, req *structs.IntentionCheckHTTPRequest) (bool, error) {
+	if perm.HTTP == nil {
+		return true, nil
+	}
+	if req == nil {
+		req = &structs.IntentionCheckHTTPRequest{}
+	}
+
+	// The RBAC filter matches against the path without the query string or
+	// fragment.
+	path := req.Path
+	if idx := strings.IndexAny(path, "?#"); idx >= 0 {
+		path = path[:idx]
+	}
+
+	switch {
+	case perm.HTTP.PathExact != "":
+		if path != perm.HTTP.PathExact {
+			return false, nil
+		}
+	case perm.HTTP.PathPrefix != "":
+		if !strings.HasPrefix(path, perm.HTTP.PathPrefix) {
+			return false, nil
+		}
+	case perm.HTTP.PathRegex != "":
+		re, err := CompileFullRegex(perm.HTTP.PathRegex)
+		if err != nil {
+			return false, err
+		}
+		if !re.MatchString(path) {
+			return false, nil
+		}
+	}
+
+	headers := make(map[string]string, len(req.Header)+2)
+	for name, value := range req.Header {
+		headers[strings.ToLower(name)] = value
+	}
+	if _, ok := headers[":method"]; !ok && req.Method != "" {
+		headers[":method"] = req.Method
+	}
+	if _, ok := headers[":path"]; !ok && req.Path != "" {
+		headers[":path"] = req.Path
+	}
+
+	for _, hdr := range perm.HTTP.Header {
+		matcher := HTTPHeaderMatcher{
+			Exact:   hdr.Exact,
+			Prefix:  hdr.Prefix,
+			Suffix:  hdr.Suffix,
+			Present: hdr.Present,
+			Invert:  hdr.Invert,
+		}
+		if hdr.Regex != "" {
+			re, err := CompileFullRegex(hdr.Regex)
+			if err != nil {
+				return false, err
+			}
+			matcher.Regex = re
+		}
+		value, present := headers[strings.ToLower(hdr.Name)]
+		if !matcher.Match(value, present) {
+			return false, nil
+		}
+	}
+
+	if len(perm.HTTP.Methods) > 0 {
+		re, err := CompileFullRegex(strings.Join(perm.HTTP.Methods, "|"))
+		if err != nil {
+			return false, err
+		}
+		if !re.MatchString(headers[":method"]) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
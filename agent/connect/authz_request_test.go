@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+func TestAuthorizeIntentionRequest(t *testing.T) {
+	ixn := &structs.Intention{
+		SourceName:      "web",
+		DestinationName: "api",
+		Permissions: []*structs.IntentionPermission{
+			{
+				Action: structs.IntentionActionDeny,
+				HTTP: &structs.IntentionHTTPPermission{
+					PathPrefix: "/admin",
+				},
+			},
+			{
+				Action: structs.IntentionActionAllow,
+				HTTP: &structs.IntentionHTTPPermission{
+					PathRegex: "/v[0-9]+/.*",
+					Methods:   []string{"GET", "HEAD"},
+				},
+			},
+			{
+				Action: structs.IntentionActionAllow,
+				HTTP: &structs.IntentionHTTPPermission{
+					PathExact: "/ops",
+					Header: []structs.IntentionHTTPHeaderPermission{
+						{Name: "X-Role", Exact: "ops"},
+						{Name: "X-Debug", Present: true, Invert: true},
+					},
+				},
+			},
+			{
+				Action: structs.IntentionActionAllow,
+				HTTP: &structs.IntentionHTTPPermission{
+					PathPrefix: "/token",
+				},
+				JWT: &structs.IntentionJWTRequirement{
+					Providers: []*structs.IntentionJWTProvider{
+						{
+							Name: "okta",
+							VerifyClaims: []*structs.IntentionJWTClaimVerification{
+								{Path: []string{"perms", "role"}, Value: "admin"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	cases := map[string]struct {
+		req          *structs.IntentionCheckHTTPRequest
+		defaultAllow bool
+		allowed      bool
+		permIdx      int
+	}{
+		"path prefix deny takes precedence": {
+			req:     &structs.IntentionCheckHTTPRequest{Method: "GET", Path: "/admin/v1/users"},
+			allowed: false,
+			permIdx: 0,
+		},
+		"path regex and method allow": {
+			req:     &structs.IntentionCheckHTTPRequest{Method: "GET", Path: "/v2/users?limit=10"},
+			allowed: true,
+			permIdx: 1,
+		},
+		"path regex must match fully": {
+			req:     &structs.IntentionCheckHTTPRequest{Method: "GET", Path: "/api/v2/users"},
+			allowed: false,
+			permIdx: -1,
+		},
+		"method mismatch falls through to default allow": {
+			req:          &structs.IntentionCheckHTTPRequest{Method: "POST", Path: "/v2/users"},
+			defaultAllow: true,
+			allowed:      true,
+			permIdx:      -1,
+		},
+		"headers are case insensitive": {
+			req: &structs.IntentionCheckHTTPRequest{
+				Path:   "/ops",
+				Header: map[string]string{"x-role": "ops"},
+			},
+			allowed: true,
+			permIdx: 2,
+		},
+		"inverted presence check": {
+			req: &structs.IntentionCheckHTTPRequest{
+				Path:   "/ops",
+				Header: map[string]string{"X-Role": "ops", "X-Debug": "1"},
+			},
+			allowed: false,
+			permIdx: -1,
+		},
+		// The RBAC filters do not enforce JWT requirements either.
+		"jwt requirement is not evaluated": {
+			req:     &structs.IntentionCheckHTTPRequest{Path: "/token/refresh"},
+			allowed: true,
+			permIdx: 3,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			allowed, permIdx, err := AuthorizeIntentionRequest(ixn, tc.req, tc.defaultAllow)
+			require.NoError(t, err)
+			require.Equal(t, tc.allowed, allowed)
+			require.Equal(t, tc.permIdx, permIdx)
+		})
+	}
+}
+
+func TestAuthorizeIntentionRequest_InvalidRegex(t *testing.T) {
+	ixn := &structs.Intention{
+		Permissions: []*structs.IntentionPermission{
+			{
+				Action: structs.IntentionActionAllow,
+				HTTP: &structs.IntentionHTTPPermission{
+					PathRegex: "/v[0-9",
+				},
+			},
+		},
+	}
+
+	_, _, err := AuthorizeIntentionRequest(ixn, &structs.IntentionCheckHTTPRequest{Path: "/v1"}, false)
+	require.ErrorContains(t, err, "permission 0: invalid regex")
+}
@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// CompileFullRegex compiles a pattern that must match the whole value, which
+// is how Envoy evaluates safe regex matchers.
+func CompileFullRegex(pattern string) (*regexp.Regexp, error) {
+	re, err := regexp.Compile("^(?:" + pattern + ")$")
+	if err != nil {
+		return nil, fmt.Errorf("invalid regex %q: %w", pattern, err)
+	}
+	return re, nil
+}
+
+// HTTPHeaderMatcher matches a request header in the same way as the Envoy
+// header matchers generated for service-router routes and intention
+// permissions. The first criterion that is set decides the match.
+type HTTPHeaderMatcher struct {
+	Exact   string
+	Regex   *regexp.Regexp
+	Prefix  string
+	Suffix  string
+	Present bool
+	Invert  bool
+}
+
+// Match reports whether a header with the given value matches. A missing
+// header never matches, except for an inverted presence check. Matchers
+// without any criteria are left out of the Envoy configuration, so they
+// match every request.
+func (m HTTPHeaderMatcher) Match(value string, present bool) bool {
+	if m.Exact == "" && m.Regex == nil && m.Prefix == "" && m.Suffix == "" && !m.Present {
+		return true
+	}
+	if !present {
+		return m.Present && m.Invert
+	}
+
+	var match bool
+	switch {
+	case m.Exact != "":
+		match = value == m.Exact
+	case m.Regex != nil:
+		match = m.Regex.MatchString(value)
+	case m.Prefix != "":
+		match = strings.HasPrefix(value, m.Prefix)
+	case m.Suffix != "":
+		match = strings.HasSuffix(value, m.Suffix)
+	default:
+		match = true
+	}
+	return match != m.Invert
+}
+
+// DefaultAsEmpty returns an empty string for the default namespace or
+// partition, since they can only be set explicitly in Consul Enterprise.
+func DefaultAsEmpty(name string) string {
+	if name == "default" {
+		return ""
+	}
+	return name
+}
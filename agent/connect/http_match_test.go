@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPHeaderMatcher_Match(t *testing.T) {
+	re, err := CompileFullRegex("v[0-9]+")
+	require.NoError(t, err)
+
+	cases := map[string]struct {
+		matcher HTTPHeaderMatcher
+		value   string
+		present bool
+		want    bool
+	}{
+		"exact":                     {HTTPHeaderMatcher{Exact: "a"}, "a", true, true},
+		"exact mismatch":            {HTTPHeaderMatcher{Exact: "a"}, "ab", true, false},
+		"regex matches whole value": {HTTPHeaderMatcher{Regex: re}, "v12", true, true},
+		"regex partial match":       {HTTPHeaderMatcher{Regex: re}, "xv12", true, false},
+		"prefix":                    {HTTPHeaderMatcher{Prefix: "a"}, "ab", true, true},
+		"suffix":                    {HTTPHeaderMatcher{Suffix: "b"}, "ab", true, true},
+		"present":                   {HTTPHeaderMatcher{Present: true}, "", true, true},
+		"inverted":                  {HTTPHeaderMatcher{Exact: "a", Invert: true}, "b", true, true},
+		"missing":                   {HTTPHeaderMatcher{Exact: "a", Invert: true}, "", false, false},
+		"missing inverted present":  {HTTPHeaderMatcher{Present: true, Invert: true}, "", false, true},
+		"no criteria":               {HTTPHeaderMatcher{Invert: true}, "", false, true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.want, tc.matcher.Match(tc.value, tc.present))
+		})
+	}
+
+	_, err = CompileFullRegex("(")
+	require.Error(t, err)
+}
@@ -22,7 +22,8 @@ import (
 // a separate agent method here because we need to re-use this both in our own
 // HTTP API authz endpoint and in the gRPX xDS/ext_authz API for envoy.
 //
-// NOTE: This treats any L7 intentions as DENY.
+// NOTE: This treats any L7 intentions as DENY unless the request describes an
+// HTTP request to evaluate their permissions against.
 //
 // The ACL token and the auth request are provided and the auth decision (true
 // means authorized) and reason string are returned.
@@ -114,9 +115,13 @@ func (a *Agent) ConnectAuthorize(token string,
 		return returnErr(fmt.Errorf("Internal error loading matches"))
 	}
 
-	// Figure out which source matches this request.
+	// Figure out which source matches this request. Audited intentions are
+	// not enforced, so they are skipped.
 	var ixnMatch *structs.Intention
 	for _, ixn := range reply.Matches[0] {
+		if ixn.IsAudit() {
+			continue
+		}
 		// We match on the intention source because the uriService is the source of the connection to authorize.
 		if _, ok := connect.AuthorizeIntentionTarget(
 			uriService.Service, uriService.Namespace, uriService.Partition, "", ixn, structs.IntentionMatchSource); ok {
@@ -133,9 +138,24 @@ func (a *Agent) ConnectAuthorize(token string,
 			return auth, reason, &meta, nil
 		}
 
-		// This is an L7 intention, so DENY.
-		reason = fmt.Sprintf("Matched L7 intention: %s", ixnMatch.String())
-		return false, reason, &meta, nil
+		if req.HTTP == nil {
+			// This is an L7 intention, so DENY.
+			reason = fmt.Sprintf("Matched L7 intention: %s", ixnMatch.String())
+			return false, reason, &meta, nil
+		}
+
+		defaultAllow := authz.IntentionDefaultAllow(nil) == acl.Allow
+		auth, permIdx, err := connect.AuthorizeIntentionRequest(ixnMatch, req.HTTP, defaultAllow)
+		if err != nil {
+			return returnErr(err)
+		}
+		if permIdx < 0 {
+			reason = fmt.Sprintf("Matched L7 intention: %s, but no permission matched the request; "+
+				"default behavior configured by ACLs", ixnMatch.String())
+		} else {
+			reason = fmt.Sprintf("Matched L7 intention: %s, permission %d", ixnMatch.String(), permIdx)
+		}
+		return auth, reason, &meta, nil
 	}
 
 	reason = "Default behavior configured by ACLs"
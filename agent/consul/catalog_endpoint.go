@@ -71,6 +71,10 @@ var CatalogSummaries = []prometheus.SummaryDefinition{
 		Name: []string{"catalog", "register"},
 		Help: "Measures the time it takes to complete a catalog register operation.",
 	},
+	{
+		Name: []string{"catalog", "maintenance_window", "apply"},
+		Help: "Measures the time it takes to complete a catalog maintenance window update.",
+	},
 }
 
 // Catalog endpoint is used to manipulate the service catalog
@@ -1118,3 +1122,117 @@ func (c *Catalog) VirtualIPForService(args *structs.ServiceSpecificRequest, repl
 	*reply, err = state.VirtualIPForService(psn)
 	return err
 }
+
+// MaintenanceWindowApply creates, updates or deletes a scheduled maintenance
+// window. Modifying a window requires write access to the node it applies to.
+func (c *Catalog) MaintenanceWindowApply(args *structs.MaintenanceWindowRequest, reply *string) error {
+	if done, err := c.srv.ForwardRPC("Catalog.MaintenanceWindowApply", args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"catalog", "maintenance_window", "apply"}, time.Now())
+
+	if args.Window == nil {
+		return fmt.Errorf("Must provide maintenance window")
+	}
+
+	authz, err := c.srv.ResolveTokenAndDefaultMeta(args.Token, &args.Window.EnterpriseMeta, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := c.srv.validateEnterpriseRequest(&args.Window.EnterpriseMeta, true); err != nil {
+		return err
+	}
+
+	state := c.srv.fsm.State()
+
+	switch args.Op {
+	case structs.MaintenanceWindowUpsert:
+		if err := args.Window.Validate(); err != nil {
+			return err
+		}
+		if args.Window.ID == "" {
+			if args.Window.ID, err = uuid.GenerateUUID(); err != nil {
+				return err
+			}
+		} else {
+			_, existing, err := state.MaintenanceWindowGet(nil, args.Window.ID)
+			if err != nil {
+				return fmt.Errorf("Maintenance window lookup failed: %v", err)
+			}
+			if existing != nil && !strings.EqualFold(existing.Node, args.Window.Node) {
+				if err := vetMaintenanceWindowWithACL(authz, existing); err != nil {
+					return err
+				}
+			}
+		}
+	case structs.MaintenanceWindowDelete:
+		if args.Window.ID == "" {
+			return fmt.Errorf("Must provide maintenance window ID")
+		}
+		_, existing, err := state.MaintenanceWindowGet(nil, args.Window.ID)
+		if err != nil {
+			return fmt.Errorf("Maintenance window lookup failed: %v", err)
+		}
+		if existing == nil {
+			*reply = args.Window.ID
+			return nil
+		}
+		args.Window = existing
+	default:
+		return fmt.Errorf("Invalid maintenance window operation: %v", args.Op)
+	}
+
+	if err := vetMaintenanceWindowWithACL(authz, args.Window); err != nil {
+		return err
+	}
+
+	resp, err := c.srv.raftApply(structs.MaintenanceWindowRequestType, args)
+	if err != nil {
+		return err
+	}
+	if respErr, ok := resp.(error); ok {
+		return respErr
+	}
+
+	*reply = args.Window.ID
+	return nil
+}
+
+// vetMaintenanceWindowWithACL checks that the token is allowed to place the
+// window's node into maintenance.
+func vetMaintenanceWindowWithACL(authz resolver.Result, window *structs.MaintenanceWindow) error {
+	var authzContext acl.AuthorizerContext
+	window.FillAuthzContext(&authzContext)
+	return authz.ToAllowAuthorizer().NodeWriteAllowed(window.Node, &authzContext)
+}
+
+// MaintenanceWindowList returns the scheduled maintenance windows for a node,
+// or for all nodes if no node is given.
+func (c *Catalog) MaintenanceWindowList(args *structs.NodeSpecificRequest, reply *structs.IndexedMaintenanceWindows) error {
+	if done, err := c.srv.ForwardRPC("Catalog.MaintenanceWindowList", args, reply); done {
+		return err
+	}
+
+	_, err := c.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := c.srv.validateEnterpriseRequest(&args.EnterpriseMeta, false); err != nil {
+		return err
+	}
+
+	return c.srv.blockingQuery(
+		&args.QueryOptions,
+		&reply.QueryMeta,
+		func(ws memdb.WatchSet, state *state.Store) error {
+			index, windows, err := state.MaintenanceWindowList(ws, args.Node)
+			if err != nil {
+				return err
+			}
+			reply.Index, reply.Windows = index, windows
+
+			return c.srv.filterACL(args.Token, reply)
+		})
+}
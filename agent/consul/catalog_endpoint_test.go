@@ -4217,3 +4217,90 @@ func TestCatalog_VirtualIPForService_ACLDeny(t *testing.T) {
 	require.Contains(t, err.Error(), acl.ErrPermissionDenied.Error())
 	require.Equal(t, "", out2)
 }
+
+func TestCatalog_MaintenanceWindow_ACL(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	dir1, s1 := testServerWithConfig(t, func(c *Config) {
+		c.PrimaryDatacenter = "dc1"
+		c.ACLsEnabled = true
+		c.ACLInitialManagementToken = "root"
+		c.ACLResolverSettings.ACLDefaultPolicy = "deny"
+	})
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+
+	id := createToken(t, codec, `
+node "node1" {
+	policy = "write"
+}
+`)
+
+	start := time.Date(2023, 6, 4, 2, 0, 0, 0, time.UTC)
+	apply := func(token string, window *structs.MaintenanceWindow) (string, error) {
+		req := structs.MaintenanceWindowRequest{
+			Datacenter:   "dc1",
+			Op:           structs.MaintenanceWindowUpsert,
+			Window:       window,
+			WriteRequest: structs.WriteRequest{Token: token},
+		}
+		var out string
+		err := msgpackrpc.CallWithCodec(codec, "Catalog.MaintenanceWindowApply", &req, &out)
+		return out, err
+	}
+
+	// Invalid windows are rejected before being applied.
+	_, err := apply(id, &structs.MaintenanceWindow{Node: "node1", Start: start})
+	require.EqualError(t, err, "Duration must be > 0")
+
+	// A token without node write cannot schedule maintenance.
+	_, err = apply("", &structs.MaintenanceWindow{Node: "node1", Start: start, Duration: time.Hour})
+	require.True(t, acl.IsErrPermissionDenied(err), "unexpected error: %v", err)
+	_, err = apply(id, &structs.MaintenanceWindow{Node: "node2", Start: start, Duration: time.Hour})
+	require.True(t, acl.IsErrPermissionDenied(err), "unexpected error: %v", err)
+
+	// An ID is generated for new windows.
+	windowID, err := apply(id, &structs.MaintenanceWindow{Node: "node1", Start: start, Duration: time.Hour})
+	require.NoError(t, err)
+	require.NotEmpty(t, windowID)
+	_, err = apply("root", &structs.MaintenanceWindow{Node: "node2", Start: start, Duration: time.Hour})
+	require.NoError(t, err)
+
+	// An existing window cannot be moved to a node the token cannot write.
+	_, err = apply(id, &structs.MaintenanceWindow{ID: windowID, Node: "node2", Start: start, Duration: time.Hour})
+	require.True(t, acl.IsErrPermissionDenied(err), "unexpected error: %v", err)
+
+	// Listing is filtered by node read.
+	list := func(token string) structs.IndexedMaintenanceWindows {
+		req := structs.NodeSpecificRequest{
+			Datacenter:   "dc1",
+			QueryOptions: structs.QueryOptions{Token: token},
+		}
+		var out structs.IndexedMaintenanceWindows
+		require.NoError(t, msgpackrpc.CallWithCodec(codec, "Catalog.MaintenanceWindowList", &req, &out))
+		return out
+	}
+	out := list(id)
+	require.Len(t, out.Windows, 1)
+	require.Equal(t, windowID, out.Windows[0].ID)
+	require.True(t, out.QueryMeta.ResultsFilteredByACLs)
+	require.Len(t, list("root").Windows, 2)
+
+	// Delete the window.
+	req := structs.MaintenanceWindowRequest{
+		Datacenter:   "dc1",
+		Op:           structs.MaintenanceWindowDelete,
+		Window:       &structs.MaintenanceWindow{ID: windowID},
+		WriteRequest: structs.WriteRequest{Token: id},
+	}
+	var deleted string
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Catalog.MaintenanceWindowApply", &req, &deleted))
+	require.Empty(t, list(id).Windows)
+}
@@ -437,6 +437,14 @@ type Config struct {
 	// PeeringEnabled enables cluster peering.
 	PeeringEnabled bool
 
+	// DNSSECEnabled makes the leader of the primary datacenter generate the
+	// DNSSEC keyring if it does not exist yet.
+	DNSSECEnabled bool
+
+	// DNSSECKeyPublishDelay is how long a new DNSSEC key is published before
+	// the leader makes it the active key.
+	DNSSECKeyPublishDelay time.Duration
+
 	PeeringTestAllowPeerRegistrations bool
 
 	Locality *structs.Locality
@@ -491,16 +499,17 @@ func DefaultConfig() *Config {
 	}
 
 	conf := &Config{
-		Build:             version.Version,
-		Datacenter:        DefaultDC,
-		NodeName:          hostname,
-		RPCAddr:           DefaultRPCAddr,
-		RaftConfig:        raft.DefaultConfig(),
-		SerfLANConfig:     libserf.DefaultConfig(),
-		SerfWANConfig:     libserf.DefaultConfig(),
-		SerfFloodInterval: 60 * time.Second,
-		ReconcileInterval: 60 * time.Second,
-		ProtocolVersion:   ProtocolVersion2Compatible,
+		Build:                 version.Version,
+		Datacenter:            DefaultDC,
+		NodeName:              hostname,
+		RPCAddr:               DefaultRPCAddr,
+		RaftConfig:            raft.DefaultConfig(),
+		SerfLANConfig:         libserf.DefaultConfig(),
+		SerfWANConfig:         libserf.DefaultConfig(),
+		SerfFloodInterval:     60 * time.Second,
+		ReconcileInterval:     60 * time.Second,
+		DNSSECKeyPublishDelay: time.Hour,
+		ProtocolVersion:       ProtocolVersion2Compatible,
 		ACLResolverSettings: ACLResolverSettings{
 			ACLsEnabled:      false,
 			Datacenter:       DefaultDC,
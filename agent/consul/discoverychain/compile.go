@@ -315,6 +315,10 @@ func (c *compiler) compile() (*structs.CompiledDiscoveryChain, error) {
 		}
 	}
 
+	if err := c.validateFaultInjection(); err != nil {
+		return nil, err
+	}
+
 	var customizationHash string
 	if !c.customizedBy.IsZero() {
 		var customization struct {
@@ -472,6 +476,10 @@ func (c *compiler) flattenAdjacentSplitterNodes() error {
 						Definition: newDef,
 						Weight:     structs.NormalizeServiceSplitWeight(effectiveWeight),
 						NextNode:   innerSplit.NextNode,
+						MirrorNode: innerSplit.MirrorNode,
+					}
+					if newDiscoverySplit.MirrorNode == "" {
+						newDiscoverySplit.MirrorNode = split.MirrorNode
 					}
 
 					fixedSplits = append(fixedSplits, newDiscoverySplit)
@@ -531,10 +539,16 @@ func (c *compiler) removeUnusedNodes() error {
 		case structs.DiscoveryGraphNodeTypeRouter:
 			for _, route := range node.Routes {
 				todo[route.NextNode] = struct{}{}
+				if route.MirrorNode != "" {
+					todo[route.MirrorNode] = struct{}{}
+				}
 			}
 		case structs.DiscoveryGraphNodeTypeSplitter:
 			for _, split := range node.Splits {
 				todo[split.NextNode] = struct{}{}
+				if split.MirrorNode != "" {
+					todo[split.MirrorNode] = struct{}{}
+				}
 			}
 		case structs.DiscoveryGraphNodeTypeResolver:
 			// nothing special
@@ -669,6 +683,14 @@ func (c *compiler) assembleChain() error {
 			return err
 		}
 		compiledRoute.NextNode = node.MapKey()
+
+		if dest.Mirror != nil {
+			mirrorNode, err := c.getMirrorNode(dest.Mirror, c.serviceName, router.NamespaceOrDefault(), router.PartitionOrDefault())
+			if err != nil {
+				return err
+			}
+			compiledRoute.MirrorNode = mirrorNode.MapKey()
+		}
 	}
 
 	// If we have a router, we'll add a catch-all route at the end to send
@@ -695,6 +717,47 @@ func (c *compiler) assembleChain() error {
 	return nil
 }
 
+// getMirrorNode returns the resolver node of the service that requests are
+// mirrored to. Mirrored requests are never routed or split further.
+func (c *compiler) getMirrorNode(mirror *structs.MirrorPolicy, service, namespace, partition string) (*structs.DiscoveryGraphNode, error) {
+	opts := structs.DiscoveryTargetOpts{
+		Service:       defaultIfEmpty(mirror.Service, service),
+		ServiceSubset: mirror.ServiceSubset,
+		Namespace:     mirror.Namespace,
+		Peer:          mirror.Peer,
+	}
+	if mirror.Peer == "" {
+		opts.Namespace = defaultIfEmpty(mirror.Namespace, namespace)
+		opts.Partition = defaultIfEmpty(mirror.Partition, partition)
+	}
+	return c.getResolverNode(c.newTarget(opts), false)
+}
+
+// validateFaultInjection ensures that the faults injected by the routes of the
+// chain can be expressed in the protocol of the chain.
+func (c *compiler) validateFaultInjection() error {
+	for _, node := range c.nodes {
+		if node.Type != structs.DiscoveryGraphNodeTypeRouter {
+			continue
+		}
+		for _, route := range node.Routes {
+			dest := route.Definition.Destination
+			if dest == nil || dest.FaultInjection == nil || dest.FaultInjection.Abort == nil {
+				continue
+			}
+			if dest.FaultInjection.Abort.GRPCStatus != 0 && c.protocol != "grpc" {
+				return &structs.ConfigEntryGraphError{
+					Message: fmt.Sprintf(
+						"discovery chain %q uses a protocol %q that does not permit aborting requests with a gRPC status",
+						c.serviceName, c.protocol,
+					),
+				}
+			}
+		}
+	}
+	return nil
+}
+
 func newDefaultServiceRoute(serviceName, namespace, partition string) *structs.ServiceRoute {
 	return &structs.ServiceRoute{
 		Match: &structs.ServiceRouteMatch{
@@ -843,6 +906,14 @@ func (c *compiler) getSplitterNode(sid structs.ServiceID) (*structs.DiscoveryGra
 		}
 		splitNode.Splits = append(splitNode.Splits, compiledSplit)
 
+		if split.Mirror != nil {
+			mirrorNode, err := c.getMirrorNode(split.Mirror, sid.ID, sid.NamespaceOrDefault(), sid.PartitionOrDefault())
+			if err != nil {
+				return nil, err
+			}
+			compiledSplit.MirrorNode = mirrorNode.MapKey()
+		}
+
 		svc := defaultIfEmpty(split.Service, sid.ID)
 		splitID := structs.ServiceID{
 			ID:             svc,
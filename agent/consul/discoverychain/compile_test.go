@@ -41,6 +41,8 @@ func TestCompile(t *testing.T) {
 		"subset split":                                     testcase_SubsetSplit(),
 		"service split":                                    testcase_ServiceSplit(),
 		"split bypasses next splitter":                     testcase_SplitBypassesSplit(),
+		"route mirrors to subset":                          testcase_RouteMirrorsToSubset(),
+		"split mirrors to service":                         testcase_SplitMirrorsToService(),
 		"service redirect":                                 testcase_ServiceRedirect(),
 		"service and subset redirect":                      testcase_ServiceAndSubsetRedirect(),
 		"datacenter redirect":                              testcase_DatacenterRedirect(),
@@ -75,6 +77,7 @@ func TestCompile(t *testing.T) {
 		"router requires valid protocol":          testcase_RouterRequiresValidProtocol(),
 		"split to unsplittable protocol":          testcase_SplitToUnsplittableProtocol(),
 		"route to unroutable protocol":            testcase_RouteToUnroutableProtocol(),
+		"route aborts with grpc status over http": testcase_RouteAbortsWithGRPCStatusOverHTTP(),
 		"failover crosses protocols":              testcase_FailoverCrossesProtocols(),
 		"redirect crosses protocols":              testcase_RedirectCrossesProtocols(),
 		"redirect to missing subset":              testcase_RedirectToMissingSubset(),
@@ -977,6 +980,161 @@ func testcase_SplitBypassesSplit() compileTestCase {
 	return compileTestCase{entries: entries, expect: expect}
 }
 
+func testcase_RouteMirrorsToSubset() compileTestCase {
+	entries := newEntries()
+	setServiceProtocol(entries, "main", "http")
+
+	entries.AddRouters(
+		&structs.ServiceRouterConfigEntry{
+			Kind: structs.ServiceRouter,
+			Name: "main",
+			Routes: []structs.ServiceRoute{
+				newSimpleRoute("main", func(r *structs.ServiceRoute) {
+					r.Destination.Mirror = &structs.MirrorPolicy{
+						ServiceSubset: "v2",
+						Percentage:    25,
+					}
+				}),
+			},
+		},
+	)
+	entries.AddResolvers(
+		&structs.ServiceResolverConfigEntry{
+			Kind: structs.ServiceResolver,
+			Name: "main",
+			Subsets: map[string]structs.ServiceResolverSubset{
+				"v2": {
+					Filter: "Service.Meta.version == 2",
+				},
+			},
+		},
+	)
+
+	router := entries.GetRouter(structs.NewServiceID("main", nil))
+
+	expect := &structs.CompiledDiscoveryChain{
+		Protocol:  "http",
+		StartNode: "router:main.default.default",
+		Nodes: map[string]*structs.DiscoveryGraphNode{
+			"router:main.default.default": {
+				Type: structs.DiscoveryGraphNodeTypeRouter,
+				Name: "main.default.default",
+				Routes: []*structs.DiscoveryRoute{
+					{
+						Definition: &router.Routes[0],
+						NextNode:   "resolver:main.default.default.dc1",
+						MirrorNode: "resolver:v2.main.default.default.dc1",
+					},
+					{
+						Definition: newDefaultServiceRoute("main", "default", "default"),
+						NextNode:   "resolver:main.default.default.dc1",
+					},
+				},
+			},
+			"resolver:main.default.default.dc1": {
+				Type: structs.DiscoveryGraphNodeTypeResolver,
+				Name: "main.default.default.dc1",
+				Resolver: &structs.DiscoveryResolver{
+					ConnectTimeout: 5 * time.Second,
+					Target:         "main.default.default.dc1",
+				},
+			},
+			"resolver:v2.main.default.default.dc1": {
+				Type: structs.DiscoveryGraphNodeTypeResolver,
+				Name: "v2.main.default.default.dc1",
+				Resolver: &structs.DiscoveryResolver{
+					ConnectTimeout: 5 * time.Second,
+					Target:         "v2.main.default.default.dc1",
+				},
+			},
+		},
+		Targets: map[string]*structs.DiscoveryTarget{
+			"main.default.default.dc1": newTarget(structs.DiscoveryTargetOpts{Service: "main"}, nil),
+			"v2.main.default.default.dc1": newTarget(structs.DiscoveryTargetOpts{
+				Service:       "main",
+				ServiceSubset: "v2",
+			}, func(t *structs.DiscoveryTarget) {
+				t.Subset = structs.ServiceResolverSubset{
+					Filter: "Service.Meta.version == 2",
+				}
+			}),
+		},
+	}
+
+	return compileTestCase{entries: entries, expect: expect}
+}
+
+func testcase_SplitMirrorsToService() compileTestCase {
+	entries := newEntries()
+	setServiceProtocol(entries, "main", "http")
+	setServiceProtocol(entries, "shadow", "http")
+
+	entries.AddSplitters(
+		&structs.ServiceSplitterConfigEntry{
+			Kind: structs.ServiceSplitter,
+			Name: "main",
+			Splits: []structs.ServiceSplit{
+				{
+					Weight: 100,
+					Mirror: &structs.MirrorPolicy{
+						Service:    "shadow",
+						Percentage: 10,
+					},
+				},
+			},
+		},
+	)
+
+	expect := &structs.CompiledDiscoveryChain{
+		Protocol:  "http",
+		StartNode: "splitter:main.default.default",
+		Nodes: map[string]*structs.DiscoveryGraphNode{
+			"splitter:main.default.default": {
+				Type: structs.DiscoveryGraphNodeTypeSplitter,
+				Name: "main.default.default",
+				Splits: []*structs.DiscoverySplit{
+					{
+						Definition: &structs.ServiceSplit{
+							Weight: 100,
+							Mirror: &structs.MirrorPolicy{
+								Service:    "shadow",
+								Percentage: 10,
+							},
+						},
+						Weight:     100,
+						NextNode:   "resolver:main.default.default.dc1",
+						MirrorNode: "resolver:shadow.default.default.dc1",
+					},
+				},
+			},
+			"resolver:main.default.default.dc1": {
+				Type: structs.DiscoveryGraphNodeTypeResolver,
+				Name: "main.default.default.dc1",
+				Resolver: &structs.DiscoveryResolver{
+					Default:        true,
+					ConnectTimeout: 5 * time.Second,
+					Target:         "main.default.default.dc1",
+				},
+			},
+			"resolver:shadow.default.default.dc1": {
+				Type: structs.DiscoveryGraphNodeTypeResolver,
+				Name: "shadow.default.default.dc1",
+				Resolver: &structs.DiscoveryResolver{
+					Default:        true,
+					ConnectTimeout: 5 * time.Second,
+					Target:         "shadow.default.default.dc1",
+				},
+			},
+		},
+		Targets: map[string]*structs.DiscoveryTarget{
+			"main.default.default.dc1":   newTarget(structs.DiscoveryTargetOpts{Service: "main"}, nil),
+			"shadow.default.default.dc1": newTarget(structs.DiscoveryTargetOpts{Service: "shadow"}, nil),
+		},
+	}
+
+	return compileTestCase{entries: entries, expect: expect}
+}
+
 func testcase_ServiceRedirect() compileTestCase {
 	entries := newEntries()
 	entries.AddResolvers(
@@ -2617,6 +2775,40 @@ func testcase_RouterRequiresValidProtocol() compileTestCase {
 	}
 }
 
+func testcase_RouteAbortsWithGRPCStatusOverHTTP() compileTestCase {
+	entries := newEntries()
+	setServiceProtocol(entries, "main", "http")
+
+	entries.AddRouters(
+		&structs.ServiceRouterConfigEntry{
+			Kind: structs.ServiceRouter,
+			Name: "main",
+			Routes: []structs.ServiceRoute{
+				{
+					Match: &structs.ServiceRouteMatch{
+						HTTP: &structs.ServiceRouteHTTPMatch{
+							PathPrefix: "/chaos",
+						},
+					},
+					Destination: &structs.ServiceRouteDestination{
+						FaultInjection: &structs.ServiceRouteFaultInjection{
+							Abort: &structs.ServiceRouteFaultAbort{
+								GRPCStatus: 14,
+								Percentage: 100,
+							},
+						},
+					},
+				},
+			},
+		},
+	)
+	return compileTestCase{
+		entries:        entries,
+		expectErr:      "does not permit aborting requests with a gRPC status",
+		expectGraphErr: true,
+	}
+}
+
 func testcase_SplitToUnsplittableProtocol() compileTestCase {
 	entries := newEntries()
 	setServiceProtocol(entries, "main", "tcp")
@@ -147,6 +147,7 @@ func init() {
 	registerCommand(structs.PeeringSecretsWriteType, (*FSM).applyPeeringSecretsWrite)
 	registerCommand(structs.ResourceOperationType, (*FSM).applyResourceOperation)
 	registerCommand(structs.UpdateVirtualIPRequestType, (*FSM).applyManualVirtualIPs)
+	registerCommand(structs.MaintenanceWindowRequestType, (*FSM).applyMaintenanceWindowOperation)
 }
 
 func (c *FSM) applyRegister(buf []byte, index uint64) interface{} {
@@ -704,6 +705,29 @@ func (c *FSM) applySystemMetadataOperation(buf []byte, index uint64) interface{}
 	}
 }
 
+func (c *FSM) applyMaintenanceWindowOperation(buf []byte, index uint64) interface{} {
+	var req structs.MaintenanceWindowRequest
+	if err := structs.Decode(buf, &req); err != nil {
+		panic(fmt.Errorf("failed to decode request: %v", err))
+	}
+
+	switch req.Op {
+	case structs.MaintenanceWindowUpsert:
+		defer metrics.MeasureSinceWithLabels([]string{"fsm", "maintenance_window"}, time.Now(),
+			[]metrics.Label{{Name: "op", Value: "upsert"}})
+		if err := c.state.MaintenanceWindowSet(index, req.Window); err != nil {
+			return err
+		}
+		return true
+	case structs.MaintenanceWindowDelete:
+		defer metrics.MeasureSinceWithLabels([]string{"fsm", "maintenance_window"}, time.Now(),
+			[]metrics.Label{{Name: "op", Value: "delete"}})
+		return c.state.MaintenanceWindowDelete(index, req.Window.ID)
+	default:
+		return fmt.Errorf("invalid maintenance window operation type: %v", req.Op)
+	}
+}
+
 func (c *FSM) applyPeeringWrite(buf []byte, index uint64) interface{} {
 	var req pbpeering.PeeringWriteRequest
 	if err := structs.DecodeProto(buf, &req); err != nil {
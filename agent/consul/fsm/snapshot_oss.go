@@ -45,6 +45,7 @@ func init() {
 	registerRestorer(structs.PeeringWriteType, restorePeering)
 	registerRestorer(structs.PeeringTrustBundleWriteType, restorePeeringTrustBundle)
 	registerRestorer(structs.PeeringSecretsWriteType, restorePeeringSecrets)
+	registerRestorer(structs.MaintenanceWindowRequestType, restoreMaintenanceWindow)
 }
 
 func persistOSS(s *snapshot, sink raft.SnapshotSink, encoder *codec.Encoder) error {
@@ -93,6 +94,9 @@ func persistOSS(s *snapshot, sink raft.SnapshotSink, encoder *codec.Encoder) err
 	if err := s.persistSystemMetadata(sink, encoder); err != nil {
 		return err
 	}
+	if err := s.persistMaintenanceWindows(sink, encoder); err != nil {
+		return err
+	}
 	if err := s.persistIndex(sink, encoder); err != nil {
 		return err
 	}
@@ -507,6 +511,23 @@ func (s *snapshot) persistSystemMetadata(sink raft.SnapshotSink, encoder *codec.
 	return nil
 }
 
+func (s *snapshot) persistMaintenanceWindows(sink raft.SnapshotSink, encoder *codec.Encoder) error {
+	windows, err := s.state.MaintenanceWindows()
+	if err != nil {
+		return err
+	}
+
+	for _, window := range windows {
+		if _, err := sink.Write([]byte{byte(structs.MaintenanceWindowRequestType)}); err != nil {
+			return err
+		}
+		if err := encoder.Encode(window); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *snapshot) persistIndex(sink raft.SnapshotSink, encoder *codec.Encoder) error {
 	// Get all the indexes
 	iter, err := s.state.Indexes()
@@ -838,6 +859,14 @@ func restoreSystemMetadata(header *SnapshotHeader, restore *state.Restore, decod
 	return restore.SystemMetadataEntry(&req)
 }
 
+func restoreMaintenanceWindow(header *SnapshotHeader, restore *state.Restore, decoder *codec.Decoder) error {
+	var req structs.MaintenanceWindow
+	if err := decoder.Decode(&req); err != nil {
+		return err
+	}
+	return restore.MaintenanceWindow(&req)
+}
+
 func restoreServiceVirtualIP(header *SnapshotHeader, restore *state.Restore, decoder *codec.Decoder) error {
 	// state.ServiceVirtualIP was changed in a breaking way in 1.13.0 (2e4cb6f77d2be36b02e9be0b289b24e5b0afb794).
 	// We attempt to reconcile the older type by decoding to a map then decoding that map into
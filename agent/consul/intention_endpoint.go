@@ -16,6 +16,7 @@ import (
 	hashstructure_v2 "github.com/mitchellh/hashstructure/v2"
 
 	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/connect"
 	"github.com/hashicorp/consul/agent/consul/state"
 	"github.com/hashicorp/consul/agent/structs"
 	"github.com/hashicorp/consul/lib"
@@ -736,7 +737,8 @@ func (s *Intention) Check(args *structs.IntentionQueryRequest, reply *structs.In
 
 	// Perform the ACL check. For Check we only require ServiceRead and
 	// NOT IntentionRead because the Check API only returns pass/fail and
-	// returns no other information about the intentions used. We could check
+	// returns no other information about the intentions used unless the
+	// token may also read them. We could check
 	// both the source and dest side but only checking dest also has the nice
 	// benefit of only returning a passing status if the token would be able
 	// to discover the dest service and connect to it.
@@ -794,9 +796,65 @@ func (s *Intention) Check(args *structs.IntentionQueryRequest, reply *structs.In
 	}
 	reply.Allowed = decision.Allowed
 
+	// If an HTTP request was given, evaluate the L7 permissions of the
+	// matching intention against it.
+	ixn := state.IntentionDecisionMatch(opts)
+	permIdx := -1
+	if ixn != nil && len(ixn.Permissions) > 0 && query.HTTP != nil {
+		reply.Allowed, permIdx, err = connect.AuthorizeIntentionRequest(ixn, query.HTTP, decision.DefaultAllow)
+		if err != nil {
+			return fmt.Errorf("failed to evaluate the permissions of intention %s: %v", ixn.String(), err)
+		}
+	}
+
+	// The explanation reveals which intention was used, so it is only
+	// included if the token may read it.
+	var canRead bool
+	if ixn != nil {
+		canRead = ixn.CanRead(authz)
+	} else {
+		var authzContext acl.AuthorizerContext
+		query.FillAuthzContext(&authzContext)
+		canRead = authz.IntentionRead(query.DestinationName, &authzContext) == acl.Allow
+	}
+	if canRead {
+		reply.Explanation = explainIntentionCheck(ixn, permIdx, query.HTTP != nil, decision.DefaultAllow)
+	}
+
 	return nil
 }
 
+// explainIntentionCheck summarizes how an intention check was decided.
+func explainIntentionCheck(ixn *structs.Intention, permIdx int, isHTTP, defaultAllow bool) *structs.IntentionCheckExplanation {
+	defaultAction := structs.IntentionActionDeny
+	if defaultAllow {
+		defaultAction = structs.IntentionActionAllow
+	}
+
+	exp := &structs.IntentionCheckExplanation{
+		Intention:       ixn,
+		PermissionIndex: permIdx,
+		DefaultAllow:    defaultAllow,
+	}
+	switch {
+	case ixn == nil:
+		exp.Reason = fmt.Sprintf("No intention matched, so the default intention policy %q applied.", defaultAction)
+	case len(ixn.Permissions) == 0:
+		exp.Reason = fmt.Sprintf("Intention %s matched with action %q.", ixn.String(), ixn.Action)
+	case !isHTTP:
+		exp.Reason = fmt.Sprintf("Intention %s matched but has L7 permissions, "+
+			"which deny connections unless an HTTP request is evaluated.", ixn.String())
+	case permIdx >= 0:
+		exp.Permission = ixn.Permissions[permIdx]
+		exp.Reason = fmt.Sprintf("Intention %s matched and the request matched permission %d with action %q.",
+			ixn.String(), permIdx, exp.Permission.Action)
+	default:
+		exp.Reason = fmt.Sprintf("Intention %s matched but the request matched none of its permissions, "+
+			"so the default intention policy %q applied.", ixn.String(), defaultAction)
+	}
+	return exp
+}
+
 func (s *Intention) validateEnterpriseIntention(ixn *structs.Intention) error {
 	if err := s.srv.validateEnterpriseIntentionPartition(ixn.SourcePartition); err != nil {
 		return fmt.Errorf("Invalid source partition %q: %v", ixn.SourcePartition, err)
@@ -2180,6 +2180,115 @@ func TestIntentionCheck_match(t *testing.T) {
 	}
 }
 
+func TestIntentionCheck_L7(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	_, srv, codec := testACLServerWithConfig(t, nil, false)
+	waitForLeaderEstablishment(t, srv)
+
+	token, err := upsertTestTokenWithPolicyRules(codec, TestDefaultInitialManagementToken, "dc1", `service "api" { policy = "read" intentions = "deny" }`)
+	require.NoError(t, err)
+
+	for _, entry := range []structs.ConfigEntry{
+		&structs.ServiceConfigEntry{
+			Kind:     structs.ServiceDefaults,
+			Name:     "api",
+			Protocol: "http",
+		},
+		&structs.ServiceIntentionsConfigEntry{
+			Kind: structs.ServiceIntentions,
+			Name: "api",
+			Sources: []*structs.SourceIntention{
+				{
+					Name: "web",
+					Permissions: []*structs.IntentionPermission{
+						{
+							Action: structs.IntentionActionDeny,
+							HTTP: &structs.IntentionHTTPPermission{
+								PathPrefix: "/admin",
+							},
+						},
+						{
+							Action: structs.IntentionActionAllow,
+							HTTP: &structs.IntentionHTTPPermission{
+								Methods: []string{"GET"},
+							},
+						},
+					},
+				},
+			},
+		},
+	} {
+		req := structs.ConfigEntryRequest{
+			Datacenter:   "dc1",
+			Entry:        entry,
+			WriteRequest: structs.WriteRequest{Token: TestDefaultInitialManagementToken},
+		}
+		var out bool
+		require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConfigEntry.Apply", &req, &out))
+	}
+
+	check := func(t *testing.T, token string, httpReq *structs.IntentionCheckHTTPRequest) structs.IntentionQueryCheckResponse {
+		req := &structs.IntentionQueryRequest{
+			Datacenter: "dc1",
+			Check: &structs.IntentionQueryCheck{
+				SourceNS:        "default",
+				SourceName:      "web",
+				DestinationNS:   "default",
+				DestinationName: "api",
+				SourceType:      structs.IntentionSourceConsul,
+				HTTP:            httpReq,
+			},
+			QueryOptions: structs.QueryOptions{Token: token},
+		}
+		var resp structs.IntentionQueryCheckResponse
+		require.NoError(t, msgpackrpc.CallWithCodec(codec, "Intention.Check", req, &resp))
+		return resp
+	}
+
+	t.Run("without an HTTP request", func(t *testing.T) {
+		resp := check(t, TestDefaultInitialManagementToken, nil)
+		require.False(t, resp.Allowed)
+		require.NotNil(t, resp.Explanation)
+		require.Nil(t, resp.Explanation.Permission)
+		require.Contains(t, resp.Explanation.Reason, "has L7 permissions")
+	})
+
+	t.Run("denied by permission", func(t *testing.T) {
+		resp := check(t, TestDefaultInitialManagementToken, &structs.IntentionCheckHTTPRequest{Method: "GET", Path: "/admin/users"})
+		require.False(t, resp.Allowed)
+		require.NotNil(t, resp.Explanation)
+		require.Equal(t, "web", resp.Explanation.Intention.SourceName)
+		require.Equal(t, 0, resp.Explanation.PermissionIndex)
+		require.Equal(t, structs.IntentionActionDeny, resp.Explanation.Permission.Action)
+	})
+
+	t.Run("allowed by permission", func(t *testing.T) {
+		resp := check(t, TestDefaultInitialManagementToken, &structs.IntentionCheckHTTPRequest{Method: "GET", Path: "/users"})
+		require.True(t, resp.Allowed)
+		require.NotNil(t, resp.Explanation)
+		require.Equal(t, 1, resp.Explanation.PermissionIndex)
+	})
+
+	t.Run("no permission matched", func(t *testing.T) {
+		resp := check(t, TestDefaultInitialManagementToken, &structs.IntentionCheckHTTPRequest{Method: "POST", Path: "/users"})
+		require.False(t, resp.Allowed)
+		require.NotNil(t, resp.Explanation)
+		require.Nil(t, resp.Explanation.Permission)
+		require.Contains(t, resp.Explanation.Reason, "matched none of its permissions")
+	})
+
+	t.Run("explanation requires intention read", func(t *testing.T) {
+		resp := check(t, token.SecretID, &structs.IntentionCheckHTTPRequest{Method: "GET", Path: "/users"})
+		require.True(t, resp.Allowed)
+		require.Nil(t, resp.Explanation)
+	})
+}
+
 func TestEqualStringMaps(t *testing.T) {
 	m1 := map[string]string{
 		"foo": "a",
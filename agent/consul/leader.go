@@ -60,6 +60,10 @@ var (
 	// caRootPruneInterval is how often we check for stale CARoots to remove.
 	caRootPruneInterval = time.Hour
 
+	// dnssecKeyActivationInterval is how often we check whether the pending
+	// DNSSEC key is due to become the active key.
+	dnssecKeyActivationInterval = time.Minute
+
 	// minCentralizedConfigVersion is the minimum Consul version in which centralized
 	// config is supported
 	minCentralizedConfigVersion = version.Must(version.NewVersion("1.5.0"))
@@ -335,6 +339,15 @@ func (s *Server) establishLeadership(ctx context.Context) error {
 		return err
 	}
 
+	// The DNSSEC keyring is shared by all datacenters, so only the primary
+	// datacenter generates keys.
+	if s.config.DNSSECEnabled && s.InPrimaryDatacenter() {
+		if err := s.initializeDNSSECKeyring(); err != nil {
+			return err
+		}
+		s.leaderRoutineManager.Start(ctx, dnssecKeyActivationRoutineName, s.runDNSSECKeyActivation)
+	}
+
 	s.setConsistentReadReady()
 
 	if s.config.LogStoreConfig.Verification.Enabled {
@@ -378,6 +391,8 @@ func (s *Server) revokeLeadership() {
 
 	s.stopACLTokenReaping()
 
+	s.leaderRoutineManager.Stop(dnssecKeyActivationRoutineName)
+
 	s.resetConsistentReadReady()
 
 	s.autopilot.DisableReconciliation()
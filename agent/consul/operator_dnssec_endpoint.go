@@ -0,0 +1,363 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package consul
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-memdb"
+	"github.com/miekg/dns"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/consul/state"
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// DNSSECKeyringGet returns the DNSSEC keys without their private keys. The
+// keyring is kept in the primary datacenter so that every datacenter signs the
+// shared domain with the same keys.
+func (op *Operator) DNSSECKeyringGet(args *structs.DCSpecificRequest, reply *structs.IndexedDNSSECKeys) error {
+	args.Datacenter = op.srv.config.PrimaryDatacenter
+	if done, err := op.srv.ForwardRPC("Operator.DNSSECKeyringGet", args, reply); done {
+		return err
+	}
+
+	// This action requires keyring read access.
+	authz, err := op.srv.ACLResolver.ResolveToken(args.Token)
+	if err != nil {
+		return err
+	}
+	if err := op.srv.validateEnterpriseToken(authz.Identity()); err != nil {
+		return err
+	}
+	if err := authz.ToAllowAuthorizer().KeyringReadAllowed(nil); err != nil {
+		return err
+	}
+
+	return op.srv.blockingQuery(
+		&args.QueryOptions,
+		&reply.QueryMeta,
+		func(ws memdb.WatchSet, state *state.Store) error {
+			index, keyring, err := readDNSSECKeyring(ws, state)
+			if err != nil {
+				return err
+			}
+			keys := make([]*structs.DNSSECKey, 0, len(keyring.Keys))
+			for _, key := range keyring.Keys {
+				k := *key
+				k.PrivateKey = ""
+				keys = append(keys, &k)
+			}
+			reply.Index, reply.Keys = index, keys
+			return nil
+		})
+}
+
+// DNSSECSigningKeys returns the DNSSEC keys including their private keys, for
+// the agent of args.Node to sign answers with. Besides keyring read access it
+// requires write access to the node, which the agent token has and tokens
+// merely managing the keyring do not.
+func (op *Operator) DNSSECSigningKeys(args *structs.NodeSpecificRequest, reply *structs.IndexedDNSSECKeys) error {
+	args.Datacenter = op.srv.config.PrimaryDatacenter
+	if done, err := op.srv.ForwardRPC("Operator.DNSSECSigningKeys", args, reply); done {
+		return err
+	}
+
+	var authzContext acl.AuthorizerContext
+	authz, err := op.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext)
+	if err != nil {
+		return err
+	}
+	if err := op.srv.validateEnterpriseRequest(&args.EnterpriseMeta, false); err != nil {
+		return err
+	}
+	if err := authz.ToAllowAuthorizer().KeyringReadAllowed(nil); err != nil {
+		return err
+	}
+	if err := authz.ToAllowAuthorizer().NodeWriteAllowed(args.Node, &authzContext); err != nil {
+		return err
+	}
+
+	return op.srv.blockingQuery(
+		&args.QueryOptions,
+		&reply.QueryMeta,
+		func(ws memdb.WatchSet, state *state.Store) error {
+			index, keyring, err := readDNSSECKeyring(ws, state)
+			if err != nil {
+				return err
+			}
+			reply.Index, reply.Keys = index, keyring.Keys
+			return nil
+		})
+}
+
+// DNSSECKeyringRotate generates a new DNSSEC key. The key is published
+// straight away and becomes the active key once it has been published for
+// DNSSECKeyPublishDelay. The previously active key stays published until it
+// is removed.
+func (op *Operator) DNSSECKeyringRotate(args *structs.DNSSECKeyRequest, reply *structs.DNSSECKey) error {
+	args.Datacenter = op.srv.config.PrimaryDatacenter
+	if done, err := op.srv.ForwardRPC("Operator.DNSSECKeyringRotate", args, reply); done {
+		return err
+	}
+
+	// This action requires keyring write access.
+	authz, err := op.srv.ACLResolver.ResolveToken(args.Token)
+	if err != nil {
+		return err
+	}
+	if err := op.srv.validateEnterpriseToken(authz.Identity()); err != nil {
+		return err
+	}
+	if err := authz.ToAllowAuthorizer().KeyringWriteAllowed(nil); err != nil {
+		return err
+	}
+
+	key, err := op.srv.rotateDNSSECKey()
+	if err != nil {
+		return err
+	}
+	op.logger.Info("published DNSSEC key", "key_tag", key.KeyTag, "activate_time", key.ActivateTime)
+
+	*reply = *key
+	reply.PrivateKey = ""
+	return nil
+}
+
+// DNSSECKeyringRemove removes an inactive DNSSEC key from the keyring.
+// Removing a key pending activation cancels the rotation.
+func (op *Operator) DNSSECKeyringRemove(args *structs.DNSSECKeyRequest, reply *struct{}) error {
+	args.Datacenter = op.srv.config.PrimaryDatacenter
+	if done, err := op.srv.ForwardRPC("Operator.DNSSECKeyringRemove", args, reply); done {
+		return err
+	}
+
+	// This action requires keyring write access.
+	authz, err := op.srv.ACLResolver.ResolveToken(args.Token)
+	if err != nil {
+		return err
+	}
+	if err := op.srv.validateEnterpriseToken(authz.Identity()); err != nil {
+		return err
+	}
+	if err := authz.ToAllowAuthorizer().KeyringWriteAllowed(nil); err != nil {
+		return err
+	}
+
+	if err := op.srv.removeDNSSECKey(args.KeyTag); err != nil {
+		return err
+	}
+	op.logger.Info("removed DNSSEC key", "key_tag", args.KeyTag)
+	return nil
+}
+
+// removeDNSSECKey removes the inactive key with the given key tag from the
+// keyring.
+func (s *Server) removeDNSSECKey(keyTag uint16) error {
+	s.dnssecKeyringLock.Lock()
+	defer s.dnssecKeyringLock.Unlock()
+
+	_, keyring, err := readDNSSECKeyring(nil, s.fsm.State())
+	if err != nil {
+		return err
+	}
+
+	var keys []*structs.DNSSECKey
+	for _, key := range keyring.Keys {
+		if key.KeyTag != keyTag {
+			keys = append(keys, key)
+			continue
+		}
+		if key.Active {
+			return fmt.Errorf("DNSSEC key %d is the active key and cannot be removed", key.KeyTag)
+		}
+	}
+	if len(keys) == len(keyring.Keys) {
+		return fmt.Errorf("DNSSEC key %d not found", keyTag)
+	}
+
+	return s.setDNSSECKeyring(&structs.DNSSECKeyring{Keys: keys})
+}
+
+// initializeDNSSECKeyring generates the first DNSSEC key when DNSSEC signing
+// is enabled and no keys exist yet.
+func (s *Server) initializeDNSSECKeyring() error {
+	s.dnssecKeyringLock.Lock()
+	defer s.dnssecKeyringLock.Unlock()
+
+	_, keyring, err := readDNSSECKeyring(nil, s.fsm.State())
+	if err != nil {
+		return err
+	}
+	if len(keyring.Keys) > 0 {
+		return nil
+	}
+
+	key, err := s.rotateDNSSECKeyLocked()
+	if err != nil {
+		return fmt.Errorf("failed to generate DNSSEC key: %w", err)
+	}
+	s.logger.Info("generated DNSSEC key", "key_tag", key.KeyTag)
+	return nil
+}
+
+// rotateDNSSECKey adds a new key to the keyring. The first key is active
+// straight away, later keys are pending activation until they have been
+// published for DNSSECKeyPublishDelay.
+func (s *Server) rotateDNSSECKey() (*structs.DNSSECKey, error) {
+	s.dnssecKeyringLock.Lock()
+	defer s.dnssecKeyringLock.Unlock()
+
+	return s.rotateDNSSECKeyLocked()
+}
+
+// rotateDNSSECKeyLocked is rotateDNSSECKey for callers already holding
+// dnssecKeyringLock.
+func (s *Server) rotateDNSSECKeyLocked() (*structs.DNSSECKey, error) {
+	_, keyring, err := readDNSSECKeyring(nil, s.fsm.State())
+	if err != nil {
+		return nil, err
+	}
+	if pending := keyring.PendingKey(); pending != nil {
+		return nil, fmt.Errorf("DNSSEC key %d is already pending activation", pending.KeyTag)
+	}
+
+	var key *structs.DNSSECKey
+	for key == nil {
+		key, err = generateDNSSECKey()
+		if err != nil {
+			return nil, err
+		}
+		for _, k := range keyring.Keys {
+			// Key tags identify keys in signatures, so they have to be
+			// unique within the keyring.
+			if k.KeyTag == key.KeyTag {
+				key = nil
+				break
+			}
+		}
+	}
+
+	if keyring.ActiveKey() == nil {
+		key.Active = true
+	} else {
+		key.ActivateTime = key.CreateTime.Add(s.config.DNSSECKeyPublishDelay)
+	}
+	keyring.Keys = append(keyring.Keys, key)
+
+	if err := s.setDNSSECKeyring(keyring); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// runDNSSECKeyActivation activates the pending DNSSEC key once it is due.
+func (s *Server) runDNSSECKeyActivation(ctx context.Context) error {
+	ticker := time.NewTicker(dnssecKeyActivationInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := s.activateDNSSECKey(time.Now()); err != nil {
+				s.logger.Error("error activating DNSSEC key", "error", err)
+			}
+		}
+	}
+}
+
+// activateDNSSECKey makes the pending key the active key if its activation
+// time has passed. The previously active key stays published, since the
+// parent zone may still have a DS record for it.
+func (s *Server) activateDNSSECKey(now time.Time) error {
+	s.dnssecKeyringLock.Lock()
+	defer s.dnssecKeyringLock.Unlock()
+
+	_, keyring, err := readDNSSECKeyring(nil, s.fsm.State())
+	if err != nil {
+		return err
+	}
+	pending := keyring.PendingKey()
+	if pending == nil || now.Before(pending.ActivateTime) {
+		return nil
+	}
+
+	for _, k := range keyring.Keys {
+		k.Active = false
+	}
+	pending.Active = true
+	pending.ActivateTime = time.Time{}
+
+	if err := s.setDNSSECKeyring(keyring); err != nil {
+		return err
+	}
+	s.logger.Info("activated DNSSEC key", "key_tag", pending.KeyTag)
+	return nil
+}
+
+// setDNSSECKeyring writes the keyring to the system metadata. Callers must
+// hold dnssecKeyringLock and have read the keyring under it.
+//
+// The private keys are stored unencrypted, so they are readable by anyone
+// with access to the Raft log, the data directory or a snapshot of the
+// primary datacenter.
+func (s *Server) setDNSSECKeyring(keyring *structs.DNSSECKeyring) error {
+	buf, err := json.Marshal(keyring)
+	if err != nil {
+		return fmt.Errorf("failed to encode DNSSEC keyring: %w", err)
+	}
+	return s.SetSystemMetadataKey(structs.SystemMetadataDNSSECKeyring, string(buf))
+}
+
+// readDNSSECKeyring reads the DNSSEC keyring from the system metadata. An empty
+// keyring is returned when no keys have been generated yet.
+func readDNSSECKeyring(ws memdb.WatchSet, state *state.Store) (uint64, *structs.DNSSECKeyring, error) {
+	index, entry, err := state.SystemMetadataGet(ws, structs.SystemMetadataDNSSECKeyring)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	keyring := &structs.DNSSECKeyring{}
+	if entry == nil || entry.Value == "" {
+		return index, keyring, nil
+	}
+	if err := json.Unmarshal([]byte(entry.Value), keyring); err != nil {
+		return 0, nil, fmt.Errorf("failed to decode DNSSEC keyring: %w", err)
+	}
+	return index, keyring, nil
+}
+
+// generateDNSSECKey generates an ECDSA P-256 key. It is used as a combined
+// signing key, so the SEP flag is set and a DS record can be published for
+// it in the parent zone.
+func generateDNSSECKey() (*structs.DNSSECKey, error) {
+	k := &dns.DNSKEY{
+		Hdr: dns.RR_Header{
+			Name:   ".",
+			Rrtype: dns.TypeDNSKEY,
+			Class:  dns.ClassINET,
+		},
+		Flags:     dns.ZONE | dns.SEP,
+		Protocol:  3,
+		Algorithm: dns.ECDSAP256SHA256,
+	}
+	priv, err := k.Generate(256)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate DNSSEC key: %w", err)
+	}
+
+	return &structs.DNSSECKey{
+		KeyTag:     k.KeyTag(),
+		Algorithm:  k.Algorithm,
+		Flags:      k.Flags,
+		PublicKey:  k.PublicKey,
+		PrivateKey: k.PrivateKeyString(priv),
+		CreateTime: time.Now().UTC(),
+	}, nil
+}
@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package consul
+
+import (
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	msgpackrpc "github.com/hashicorp/consul-net-rpc/net-rpc-msgpackrpc"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/sdk/testutil/retry"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+func TestOperator_DNSSECKeyring(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	dir1, s1 := testServerWithConfig(t, func(c *Config) {
+		c.DNSSECEnabled = true
+	})
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+
+	// The leader generates the first key.
+	var reply structs.IndexedDNSSECKeys
+	retry.Run(t, func(r *retry.R) {
+		arg := structs.DCSpecificRequest{Datacenter: "dc1"}
+		require.NoError(r, msgpackrpc.CallWithCodec(codec, "Operator.DNSSECKeyringGet", &arg, &reply))
+		require.Len(r, reply.Keys, 1)
+	})
+	first := reply.Keys[0]
+	require.True(t, first.Active)
+	require.Empty(t, first.PrivateKey)
+
+	// Private keys are only returned to agents signing answers.
+	signingArg := structs.NodeSpecificRequest{Datacenter: "dc1", Node: s1.config.NodeName}
+	var signing structs.IndexedDNSSECKeys
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Operator.DNSSECSigningKeys", &signingArg, &signing))
+	require.Len(t, signing.Keys, 1)
+	require.Equal(t, first.KeyTag, signing.Keys[0].KeyTag)
+	require.NotEmpty(t, signing.Keys[0].PrivateKey)
+
+	// Rotating publishes a new key that is pending activation.
+	rotateArg := structs.DNSSECKeyRequest{Datacenter: "dc1"}
+	var second structs.DNSSECKey
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Operator.DNSSECKeyringRotate", &rotateArg, &second))
+	require.False(t, second.Active)
+	require.Equal(t, second.CreateTime.Add(time.Hour), second.ActivateTime)
+	require.Empty(t, second.PrivateKey)
+	require.NotEqual(t, first.KeyTag, second.KeyTag)
+
+	// Only one key can be pending activation at a time.
+	var third structs.DNSSECKey
+	err := msgpackrpc.CallWithCodec(codec, "Operator.DNSSECKeyringRotate", &rotateArg, &third)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "already pending activation")
+
+	arg := structs.DCSpecificRequest{Datacenter: "dc1"}
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Operator.DNSSECKeyringGet", &arg, &reply))
+	require.Len(t, reply.Keys, 2)
+	require.True(t, reply.Keys[0].Active)
+	require.False(t, reply.Keys[1].Active)
+
+	// The pending key is not activated before its activation time.
+	require.NoError(t, s1.activateDNSSECKey(second.ActivateTime.Add(-time.Second)))
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Operator.DNSSECKeyringGet", &arg, &reply))
+	require.True(t, reply.Keys[0].Active)
+	require.False(t, reply.Keys[1].Active)
+
+	// Once activated, the previous key stays published but inactive.
+	require.NoError(t, s1.activateDNSSECKey(second.ActivateTime))
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Operator.DNSSECKeyringGet", &arg, &reply))
+	require.Len(t, reply.Keys, 2)
+	require.Equal(t, first.KeyTag, reply.Keys[0].KeyTag)
+	require.False(t, reply.Keys[0].Active)
+	require.Equal(t, second.KeyTag, reply.Keys[1].KeyTag)
+	require.True(t, reply.Keys[1].Active)
+	require.True(t, reply.Keys[1].ActivateTime.IsZero())
+
+	// The active key cannot be removed.
+	var out struct{}
+	removeArg := structs.DNSSECKeyRequest{Datacenter: "dc1", KeyTag: second.KeyTag}
+	err = msgpackrpc.CallWithCodec(codec, "Operator.DNSSECKeyringRemove", &removeArg, &out)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "is the active key")
+
+	// Unknown keys cannot be removed.
+	removeArg.KeyTag = first.KeyTag + second.KeyTag
+	err = msgpackrpc.CallWithCodec(codec, "Operator.DNSSECKeyringRemove", &removeArg, &out)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "not found")
+
+	removeArg.KeyTag = first.KeyTag
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Operator.DNSSECKeyringRemove", &removeArg, &out))
+
+	reply = structs.IndexedDNSSECKeys{}
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Operator.DNSSECKeyringGet", &arg, &reply))
+	require.Len(t, reply.Keys, 1)
+	require.Equal(t, second.KeyTag, reply.Keys[0].KeyTag)
+
+	// Concurrent rotations don't overwrite each other, so only one of them
+	// publishes a key.
+	var wg sync.WaitGroup
+	errs := make(chan error, 5)
+	for i := 0; i < cap(errs); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := s1.rotateDNSSECKey()
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	var rotated int
+	for err := range errs {
+		if err == nil {
+			rotated++
+			continue
+		}
+		require.Contains(t, err.Error(), "already pending activation")
+	}
+	require.Equal(t, 1, rotated)
+
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Operator.DNSSECKeyringGet", &arg, &reply))
+	require.Len(t, reply.Keys, 2)
+}
+
+func TestOperator_DNSSECKeyring_ACLDeny(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	dir1, s1 := testServerWithConfig(t, func(c *Config) {
+		c.PrimaryDatacenter = "dc1"
+		c.ACLsEnabled = true
+		c.ACLInitialManagementToken = "root"
+		c.ACLResolverSettings.ACLDefaultPolicy = "deny"
+	})
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	testrpc.WaitForTestAgent(t, s1.RPC, "dc1", testrpc.WithToken("root"))
+
+	// Make requests with no token to make sure they get denied.
+	arg := structs.DCSpecificRequest{Datacenter: "dc1"}
+	var reply structs.IndexedDNSSECKeys
+	err := msgpackrpc.CallWithCodec(codec, "Operator.DNSSECKeyringGet", &arg, &reply)
+	require.True(t, acl.IsErrPermissionDenied(err), "err: %v", err)
+
+	rotateArg := structs.DNSSECKeyRequest{Datacenter: "dc1"}
+	var key structs.DNSSECKey
+	err = msgpackrpc.CallWithCodec(codec, "Operator.DNSSECKeyringRotate", &rotateArg, &key)
+	require.True(t, acl.IsErrPermissionDenied(err), "err: %v", err)
+
+	// Keyring read access is not enough to rotate keys.
+	rotateArg.Token = createTokenWithPolicyName(t, codec, "keyring-read", `keyring = "read"`, "root")
+	err = msgpackrpc.CallWithCodec(codec, "Operator.DNSSECKeyringRotate", &rotateArg, &key)
+	require.True(t, acl.IsErrPermissionDenied(err), "err: %v", err)
+
+	arg.Token = rotateArg.Token
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Operator.DNSSECKeyringGet", &arg, &reply))
+	require.Empty(t, reply.Keys)
+
+	// Keyring read access is not enough to fetch the private keys either,
+	// that also takes write access to the agent's node.
+	signingArg := structs.NodeSpecificRequest{Datacenter: "dc1", Node: "agent"}
+	signingArg.Token = rotateArg.Token
+	err = msgpackrpc.CallWithCodec(codec, "Operator.DNSSECSigningKeys", &signingArg, &reply)
+	require.True(t, acl.IsErrPermissionDenied(err), "err: %v", err)
+
+	signingArg.Token = createTokenWithPolicyName(t, codec, "node-write", `node "agent" { policy = "write" }`, "root")
+	err = msgpackrpc.CallWithCodec(codec, "Operator.DNSSECSigningKeys", &signingArg, &reply)
+	require.True(t, acl.IsErrPermissionDenied(err), "err: %v", err)
+
+	signingArg.Token = createTokenWithPolicyName(t, codec, "agent", `
+		keyring = "read"
+		node "agent" { policy = "write" }
+	`, "root")
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Operator.DNSSECSigningKeys", &signingArg, &reply))
+	require.Empty(t, reply.Keys)
+
+	signingArg.Node = "other"
+	err = msgpackrpc.CallWithCodec(codec, "Operator.DNSSECSigningKeys", &signingArg, &reply)
+	require.True(t, acl.IsErrPermissionDenied(err), "err: %v", err)
+
+	rotateArg.Token = createTokenWithPolicyName(t, codec, "keyring-write", `keyring = "write"`, "root")
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Operator.DNSSECKeyringRotate", &rotateArg, &key))
+	require.True(t, key.Active)
+}
@@ -138,6 +138,7 @@ const (
 	caSigningMetricRoutineName            = "CA signing expiration metric"
 	configEntryControllersRoutineName     = "config entry controllers"
 	configReplicationRoutineName          = "config entry replication"
+	dnssecKeyActivationRoutineName        = "DNSSEC key activation"
 	federationStateReplicationRoutineName = "federation state replication"
 	federationStateAntiEntropyRoutineName = "federation state anti-entropy"
 	federationStatePruningRoutineName     = "federation state pruning"
@@ -354,6 +355,11 @@ type Server struct {
 	floodLock sync.RWMutex
 	floodCh   []chan struct{}
 
+	// dnssecKeyringLock serializes the read-modify-write updates of the
+	// DNSSEC keyring. They are only made by the leader of the primary
+	// datacenter, so a local lock keeps them from overwriting each other.
+	dnssecKeyringLock sync.Mutex
+
 	// sessionTimers track the expiration time of each Session that has
 	// a TTL. On expiration, a SessionDestroy event will occur, and
 	// destroy the session via standard session destroy processing
@@ -770,6 +770,11 @@ func (s *Store) deleteNodeTxn(tx WriteTxn, idx uint64, nodeName string, entMeta
 				return fmt.Errorf("failed deleting coordinate: %s", err)
 			}
 		}
+
+		// Delete any maintenance windows scheduled for this node.
+		if err := deleteMaintenanceWindowsTxn(tx, idx, nodeName, "", entMeta); err != nil {
+			return err
+		}
 	}
 
 	// Delete the node and update the index.
@@ -1974,6 +1979,13 @@ func (s *Store) deleteServiceTxn(tx WriteTxn, idx uint64, nodeName, serviceID st
 		return err
 	}
 
+	if peerName == "" {
+		// Delete any maintenance windows scheduled for the service.
+		if err := deleteMaintenanceWindowsTxn(tx, idx, nodeName, serviceID, entMeta); err != nil {
+			return err
+		}
+	}
+
 	// Delete the service and update the index
 	if err := tx.Delete(tableServices, service); err != nil {
 		return fmt.Errorf("failed deleting service: %s", err)
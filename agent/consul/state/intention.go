@@ -752,15 +752,7 @@ type IntentionDecisionOpts struct {
 // allowPermissions determines whether the presence of L7 permissions leads to a DENY decision.
 // This should be false when evaluating a connection between a source and destination, but not the request that will be sent.
 func (s *Store) IntentionDecision(opts IntentionDecisionOpts) (structs.IntentionDecisionSummary, error) {
-
-	// Figure out which source matches this request.
-	var ixnMatch *structs.Intention
-	for _, ixn := range opts.Intentions {
-		if _, ok := connect.AuthorizeIntentionTarget(opts.Target, opts.Namespace, opts.Partition, opts.Peer, ixn, opts.MatchType); ok {
-			ixnMatch = ixn
-			break
-		}
-	}
+	ixnMatch := IntentionDecisionMatch(opts)
 
 	resp := structs.IntentionDecisionSummary{
 		DefaultAllow: opts.DefaultDecision == acl.Allow,
@@ -790,6 +782,23 @@ func (s *Store) IntentionDecision(opts IntentionDecisionOpts) (structs.Intention
 	return resp, nil
 }
 
+// IntentionDecisionMatch returns the intention that decides whether a
+// connection is allowed given the options, or nil if no intention matches and
+// the default decision applies.
+func IntentionDecisionMatch(opts IntentionDecisionOpts) *structs.Intention {
+	// Figure out which source matches this request. Audited intentions are
+	// not enforced, so they are skipped.
+	for _, ixn := range opts.Intentions {
+		if ixn.IsAudit() {
+			continue
+		}
+		if connect.IntentionMatch(opts.Target, opts.Namespace, opts.Partition, opts.Peer, ixn, opts.MatchType) {
+			return ixn
+		}
+	}
+	return nil
+}
+
 // IntentionMatch returns the list of intentions that match the namespace and
 // name for either a source or destination. This applies the resolution rules
 // so wildcards will match any value.
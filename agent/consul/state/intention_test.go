@@ -1951,6 +1951,11 @@ func TestStore_IntentionDecision(t *testing.T) {
 					Name:   "*",
 					Action: structs.IntentionActionAllow,
 				},
+				{
+					Name:   "audited",
+					Action: structs.IntentionActionDeny,
+					Mode:   structs.IntentionModeAudit,
+				},
 			},
 		},
 	}
@@ -2048,6 +2053,17 @@ func TestStore_IntentionDecision(t *testing.T) {
 				HasExact:       false,
 			},
 		},
+		{
+			name:      "audited intention is not enforced",
+			src:       "audited",
+			dst:       "mysql",
+			matchType: structs.IntentionMatchDestination,
+			expect: structs.IntentionDecisionSummary{
+				Allowed:        true,
+				HasPermissions: false,
+				HasExact:       false,
+			},
+		},
 		{
 			name:      "allowed by matching on source",
 			src:       "web",
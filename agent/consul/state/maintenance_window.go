@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package state
+
+import (
+	"fmt"
+
+	memdb "github.com/hashicorp/go-memdb"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+const tableMaintenanceWindows = "maintenance-windows"
+
+func maintenanceWindowsTableSchema() *memdb.TableSchema {
+	return &memdb.TableSchema{
+		Name: tableMaintenanceWindows,
+		Indexes: map[string]*memdb.IndexSchema{
+			indexID: {
+				Name:         indexID,
+				AllowMissing: false,
+				Unique:       true,
+				Indexer: &memdb.StringFieldIndex{
+					Field: "ID",
+				},
+			},
+			indexNode: {
+				Name:         indexNode,
+				AllowMissing: false,
+				Unique:       false,
+				Indexer: &memdb.StringFieldIndex{
+					Field:     "Node",
+					Lowercase: true,
+				},
+			},
+		},
+	}
+}
+
+// MaintenanceWindows is used to pull all the maintenance windows for the snapshot.
+func (s *Snapshot) MaintenanceWindows() (structs.MaintenanceWindows, error) {
+	iter, err := s.tx.Get(tableMaintenanceWindows, indexID)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret structs.MaintenanceWindows
+	for wrapped := iter.Next(); wrapped != nil; wrapped = iter.Next() {
+		ret = append(ret, wrapped.(*structs.MaintenanceWindow))
+	}
+
+	return ret, nil
+}
+
+// MaintenanceWindow is used when restoring from a snapshot.
+func (s *Restore) MaintenanceWindow(window *structs.MaintenanceWindow) error {
+	if err := s.tx.Insert(tableMaintenanceWindows, window); err != nil {
+		return fmt.Errorf("failed restoring maintenance window: %s", err)
+	}
+	if err := indexUpdateMaxTxn(s.tx, window.ModifyIndex, tableMaintenanceWindows); err != nil {
+		return fmt.Errorf("failed updating index: %s", err)
+	}
+
+	return nil
+}
+
+// MaintenanceWindowSet is called to create or update a maintenance window.
+func (s *Store) MaintenanceWindowSet(idx uint64, window *structs.MaintenanceWindow) error {
+	tx := s.db.WriteTxn(idx)
+	defer tx.Abort()
+
+	if err := maintenanceWindowSetTxn(tx, idx, window); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func maintenanceWindowSetTxn(tx WriteTxn, idx uint64, window *structs.MaintenanceWindow) error {
+	if window.ID == "" {
+		return fmt.Errorf("missing maintenance window ID")
+	}
+	if err := window.Validate(); err != nil {
+		return err
+	}
+
+	// Check for existing.
+	existing, err := tx.First(tableMaintenanceWindows, indexID, window.ID)
+	if err != nil {
+		return fmt.Errorf("failed maintenance window lookup: %s", err)
+	}
+
+	// Set the indexes
+	if existing != nil {
+		window.CreateIndex = existing.(*structs.MaintenanceWindow).CreateIndex
+		window.ModifyIndex = idx
+	} else {
+		window.CreateIndex = idx
+		window.ModifyIndex = idx
+	}
+
+	if err := tx.Insert(tableMaintenanceWindows, window); err != nil {
+		return fmt.Errorf("failed inserting maintenance window: %s", err)
+	}
+	if err := tx.Insert(tableIndex, &IndexEntry{tableMaintenanceWindows, idx}); err != nil {
+		return fmt.Errorf("failed updating index: %v", err)
+	}
+
+	return nil
+}
+
+// MaintenanceWindowGet is called to get a maintenance window by ID.
+func (s *Store) MaintenanceWindowGet(ws memdb.WatchSet, id string) (uint64, *structs.MaintenanceWindow, error) {
+	tx := s.db.ReadTxn()
+	defer tx.Abort()
+
+	idx := maxIndexTxn(tx, tableMaintenanceWindows)
+
+	watchCh, existing, err := tx.FirstWatch(tableMaintenanceWindows, indexID, id)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed maintenance window lookup: %s", err)
+	}
+	ws.Add(watchCh)
+
+	if existing == nil {
+		return idx, nil, nil
+	}
+	return idx, existing.(*structs.MaintenanceWindow), nil
+}
+
+// MaintenanceWindowList is called to get the maintenance windows for a node,
+// or for all nodes if node is empty.
+func (s *Store) MaintenanceWindowList(ws memdb.WatchSet, node string) (uint64, structs.MaintenanceWindows, error) {
+	tx := s.db.ReadTxn()
+	defer tx.Abort()
+
+	idx := maxIndexTxn(tx, tableMaintenanceWindows)
+
+	var (
+		iter memdb.ResultIterator
+		err  error
+	)
+	if node == "" {
+		iter, err = tx.Get(tableMaintenanceWindows, indexID)
+	} else {
+		iter, err = tx.Get(tableMaintenanceWindows, indexNode, node)
+	}
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed maintenance window lookup: %s", err)
+	}
+	ws.Add(iter.WatchCh())
+
+	var results structs.MaintenanceWindows
+	for v := iter.Next(); v != nil; v = iter.Next() {
+		results = append(results, v.(*structs.MaintenanceWindow))
+	}
+	return idx, results, nil
+}
+
+// MaintenanceWindowDelete is called to remove a maintenance window.
+func (s *Store) MaintenanceWindowDelete(idx uint64, id string) error {
+	tx := s.db.WriteTxn(idx)
+	defer tx.Abort()
+
+	existing, err := tx.First(tableMaintenanceWindows, indexID, id)
+	if err != nil {
+		return fmt.Errorf("failed maintenance window lookup: %s", err)
+	}
+	if existing == nil {
+		return nil
+	}
+
+	if err := tx.Delete(tableMaintenanceWindows, existing); err != nil {
+		return fmt.Errorf("failed removing maintenance window: %s", err)
+	}
+	if err := tx.Insert(tableIndex, &IndexEntry{tableMaintenanceWindows, idx}); err != nil {
+		return fmt.Errorf("failed updating index: %s", err)
+	}
+
+	return tx.Commit()
+}
+
+// deleteMaintenanceWindowsTxn removes the maintenance windows of a service
+// instance when it is deregistered, or of the whole node, including those of
+// its services, if serviceID is empty.
+func deleteMaintenanceWindowsTxn(tx WriteTxn, idx uint64, node, serviceID string, entMeta *acl.EnterpriseMeta) error {
+	iter, err := tx.Get(tableMaintenanceWindows, indexNode, node)
+	if err != nil {
+		return fmt.Errorf("failed maintenance window lookup: %s", err)
+	}
+
+	var deleteWindows []*structs.MaintenanceWindow
+	for v := iter.Next(); v != nil; v = iter.Next() {
+		window := v.(*structs.MaintenanceWindow)
+		if window.PartitionOrDefault() != entMeta.PartitionOrDefault() {
+			continue
+		}
+		if serviceID != "" && (window.ServiceID != serviceID || !window.EnterpriseMeta.IsSame(entMeta)) {
+			continue
+		}
+		deleteWindows = append(deleteWindows, window)
+	}
+	if len(deleteWindows) == 0 {
+		return nil
+	}
+
+	// Do the delete in a separate loop so we don't trash the iterator.
+	for _, window := range deleteWindows {
+		if err := tx.Delete(tableMaintenanceWindows, window); err != nil {
+			return fmt.Errorf("failed removing maintenance window: %s", err)
+		}
+	}
+	if err := tx.Insert(tableIndex, &IndexEntry{tableMaintenanceWindows, idx}); err != nil {
+		return fmt.Errorf("failed updating index: %s", err)
+	}
+	return nil
+}
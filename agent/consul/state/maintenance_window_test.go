@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-memdb"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+func TestStore_MaintenanceWindows(t *testing.T) {
+	s := testStateStore(t)
+	start := time.Date(2023, 6, 4, 2, 0, 0, 0, time.UTC)
+
+	// Nothing is returned for an empty store.
+	idx, windows, err := s.MaintenanceWindowList(nil, "")
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), idx)
+	require.Empty(t, windows)
+
+	// Windows without an ID or with invalid fields are rejected.
+	require.Error(t, s.MaintenanceWindowSet(1, &structs.MaintenanceWindow{
+		Node: "node1", Start: start, Duration: time.Hour,
+	}))
+	require.Error(t, s.MaintenanceWindowSet(1, &structs.MaintenanceWindow{
+		ID: "w1", Node: "node1", Start: start,
+	}))
+
+	require.NoError(t, s.MaintenanceWindowSet(1, &structs.MaintenanceWindow{
+		ID: "w1", Node: "node1", Start: start, Duration: time.Hour,
+	}))
+	require.NoError(t, s.MaintenanceWindowSet(2, &structs.MaintenanceWindow{
+		ID: "w2", Node: "node2", ServiceID: "web", Start: start, Duration: time.Hour,
+	}))
+
+	ws := memdb.NewWatchSet()
+	idx, windows, err = s.MaintenanceWindowList(ws, "NODE1")
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), idx)
+	require.Len(t, windows, 1)
+	require.Equal(t, "w1", windows[0].ID)
+
+	// Updating a window keeps its create index and fires the watch.
+	require.NoError(t, s.MaintenanceWindowSet(3, &structs.MaintenanceWindow{
+		ID: "w1", Node: "node1", Start: start, Duration: 2 * time.Hour, Reason: "patching",
+	}))
+	require.True(t, watchFired(ws))
+
+	idx, window, err := s.MaintenanceWindowGet(nil, "w1")
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), idx)
+	require.Equal(t, uint64(1), window.CreateIndex)
+	require.Equal(t, uint64(3), window.ModifyIndex)
+	require.Equal(t, "patching", window.Reason)
+
+	_, windows, err = s.MaintenanceWindowList(nil, "")
+	require.NoError(t, err)
+	require.Len(t, windows, 2)
+
+	// Deleting a missing window is a no-op.
+	require.NoError(t, s.MaintenanceWindowDelete(4, "nope"))
+	require.NoError(t, s.MaintenanceWindowDelete(5, "w1"))
+
+	idx, window, err = s.MaintenanceWindowGet(nil, "w1")
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), idx)
+	require.Nil(t, window)
+}
+
+func TestStore_MaintenanceWindows_Deregister(t *testing.T) {
+	s := testStateStore(t)
+	start := time.Date(2023, 6, 4, 2, 0, 0, 0, time.UTC)
+
+	testRegisterNode(t, s, 1, "node1")
+	testRegisterService(t, s, 2, "node1", "web")
+	testRegisterService(t, s, 3, "node1", "api")
+	for i, w := range []*structs.MaintenanceWindow{
+		{ID: "node", Node: "node1"},
+		{ID: "web", Node: "node1", ServiceID: "web"},
+		{ID: "api", Node: "node1", ServiceID: "api"},
+		{ID: "other", Node: "node2"},
+	} {
+		w.Start, w.Duration = start, time.Hour
+		require.NoError(t, s.MaintenanceWindowSet(uint64(4+i), w))
+	}
+
+	// Deregistering a service removes only its windows.
+	ws := memdb.NewWatchSet()
+	_, _, err := s.MaintenanceWindowList(ws, "node1")
+	require.NoError(t, err)
+	require.NoError(t, s.DeleteService(10, "node1", "web", nil, ""))
+	require.True(t, watchFired(ws))
+
+	idx, windows, err := s.MaintenanceWindowList(nil, "node1")
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), idx)
+	require.Len(t, windows, 2)
+	require.ElementsMatch(t, []string{"api", "node"}, []string{windows[0].ID, windows[1].ID})
+
+	// Deregistering the node removes the rest of its windows.
+	require.NoError(t, s.DeleteNode(11, "node1", nil, ""))
+
+	idx, windows, err = s.MaintenanceWindowList(nil, "")
+	require.NoError(t, err)
+	require.Equal(t, uint64(11), idx)
+	require.Len(t, windows, 1)
+	require.Equal(t, "other", windows[0].ID)
+}
@@ -31,6 +31,7 @@ func newDBSchema() *memdb.DBSchema {
 		intentionsTableSchema,
 		kindServiceNameTableSchema,
 		kvsTableSchema,
+		maintenanceWindowsTableSchema,
 		meshTopologyTableSchema,
 		nodesTableSchema,
 		peeringTableSchema,
@@ -5,13 +5,16 @@ package agent
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/hex"
 	"errors"
 	"fmt"
 	"math"
 	"net"
 	"regexp"
+	"sort"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -38,6 +41,22 @@ var DNSCounters = []prometheus.CounterDefinition{
 		Name: []string{"dns", "stale_queries"},
 		Help: "Increments when an agent serves a query within the allowed stale threshold.",
 	},
+	{
+		Name: []string{"dns", "cache", "hit"},
+		Help: "Increments when a DNS response is served from the response cache.",
+	},
+	{
+		Name: []string{"dns", "cache", "miss"},
+		Help: "Increments when a cacheable DNS response is not in the response cache.",
+	},
+	{
+		Name: []string{"dns", "cache", "prefetch"},
+		Help: "Increments when a cached DNS response is refreshed before it expires.",
+	},
+	{
+		Name: []string{"dns", "dnstap", "dropped"},
+		Help: "Increments when a DNS query log message is dropped because the destination is unavailable or too slow.",
+	},
 }
 
 var DNSSummaries = []prometheus.SummaryDefinition{
@@ -93,20 +112,89 @@ type dnsConfig struct {
 	RecursorStrategy agentdns.RecursorStrategy
 	RecursorTimeout  time.Duration
 	Recursors        []string
-	SegmentName      string
-	UDPAnswerLimit   int
-	ARecordLimit     int
-	NodeMetaTXT      bool
-	SOAConfig        dnsSOAConfig
+	// ForwardingRules forward queries for particular domains to their own
+	// recursors. They are ordered from the most to the least specific domain.
+	ForwardingRules []dnsForwardingRule
+	SegmentName     string
+	UDPAnswerLimit  int
+	ARecordLimit    int
+	NodeMetaTXT     bool
+	SOAConfig       dnsSOAConfig
 	// TTLRadix sets service TTLs by prefix, eg: "database-*"
 	TTLRadix *radix.Tree
 	// TTLStict sets TTLs to service by full name match. It Has higher priority than TTLRadix
 	TTLStrict          map[string]time.Duration
 	DisableCompression bool
 
+	// ZoneTransferAllowedCIDRs are the networks allowed to request AXFR and
+	// IXFR zone transfers. Zone transfers are disabled when it is empty.
+	ZoneTransferAllowedCIDRs []*net.IPNet
+	// ZoneTransferTSIGKeys, when set, requires zone transfer requests to be
+	// signed with one of the keys.
+	ZoneTransferTSIGKeys []config.DNSTSIGKey
+
+	// UpdateKeys are the TSIG keys dynamic updates of services are accepted
+	// with. Dynamic updates are refused when there are none.
+	UpdateKeys                           []config.DNSUpdateKey
+	UpdateDeregisterCriticalServiceAfter time.Duration
+
+	// ProximityMode, when set, orders service answers by the distance of
+	// the instances to the client. In restrict mode only the ProximityLimit
+	// nearest instances are returned.
+	ProximityMode  string
+	ProximityLimit int
+
+	// QueryLogMode, when set, logs a sample of QueryLogSampleRate of the
+	// queries of the QueryLogQueryTypes, or of all types when empty.
+	QueryLogMode       string
+	QueryLogSampleRate float64
+	QueryLogQueryTypes map[uint16]bool
+
+	// DNSSECEnabled enables signing answers for clients requesting DNSSEC
+	// records.
+	DNSSECEnabled bool
+	// DNSSECSignatureValidity is how long signatures are valid for.
+	DNSSECSignatureValidity time.Duration
+
+	// ResponseCacheEnabled caches up to ResponseCacheSize responses for
+	// their TTL, bounded by ResponseCacheMinTTL and ResponseCacheMaxTTL.
+	// Responses served ResponseCachePrefetchHits times are refreshed when
+	// ResponseCachePrefetchPercent of their TTL is left.
+	ResponseCacheEnabled         bool
+	ResponseCacheSize            int
+	ResponseCacheMinTTL          time.Duration
+	ResponseCacheMaxTTL          time.Duration
+	ResponseCachePrefetchHits    int
+	ResponseCachePrefetchPercent int
+
+	// Views answer the clients they match with their own token and default
+	// enterprise meta. view is the view of the client of a request, only set
+	// on the copies made by requestConfig.
+	Views []dnsView
+	view  *dnsView
+
 	enterpriseDNSConfig
 }
 
+// dnsForwardingRule forwards queries for a domain to its own recursors.
+type dnsForwardingRule struct {
+	// Domain is the domain the rule applies to, without the "*." prefix of
+	// wildcard rules.
+	Domain string
+	// Wildcard rules only match names below Domain.
+	Wildcard         bool
+	Recursors        []string
+	RecursorStrategy agentdns.RecursorStrategy
+	RecursorTimeout  time.Duration
+}
+
+func (r *dnsForwardingRule) matches(name string) bool {
+	if !dns.IsSubDomain(r.Domain, name) {
+		return false
+	}
+	return !r.Wildcard || !strings.EqualFold(r.Domain, name)
+}
+
 type serviceLookup struct {
 	PeerName          string
 	Datacenter        string
@@ -140,6 +228,18 @@ type DNSServer struct {
 	// config stores the config as an atomic value (for hot-reloading). It is always of type *dnsConfig
 	config atomic.Value
 
+	// dnssecKeyring caches the keys answers are signed with when DNSSEC is
+	// enabled.
+	dnssecKeyring *dnssecKeyring
+
+	// proximity caches the node coordinates service answers are sorted by,
+	// by the name of the view they were read for.
+	proximityLock sync.Mutex
+	proximity     map[string]*dnsProximity
+
+	// responseCache caches the answers of the server and its recursors.
+	responseCache *dnsResponseCache
+
 	// recursorEnabled stores whever the recursor handler is enabled as an atomic flag.
 	// the recursor handler is only enabled if recursors are configured. This flag is used during config hot-reloading
 	recursorEnabled uint32
@@ -158,12 +258,16 @@ func NewDNSServer(a *Agent) (*DNSServer, error) {
 		logger:                a.logger.Named(logging.DNS),
 		defaultEnterpriseMeta: *a.AgentEnterpriseMeta(),
 		mux:                   dns.NewServeMux(),
+		dnssecKeyring:         &dnssecKeyring{},
+		proximity:             make(map[string]*dnsProximity),
+		responseCache:         &dnsResponseCache{},
 	}
 	cfg, err := GetDNSConfig(a.config)
 	if err != nil {
 		return nil, err
 	}
 	srv.config.Store(cfg)
+	srv.responseCache.configure(cfg)
 
 	srv.mux.HandleFunc("arpa.", srv.handlePtr)
 	srv.mux.HandleFunc(srv.domain, srv.handleQuery)
@@ -206,7 +310,24 @@ func GetDNSConfig(conf *config.RuntimeConfig) (*dnsConfig, error) {
 			Refresh: conf.DNSSOA.Refresh,
 			Retry:   conf.DNSSOA.Retry,
 		},
-		enterpriseDNSConfig: getEnterpriseDNSConfig(conf),
+		ZoneTransferAllowedCIDRs:             conf.DNSZoneTransferAllowedCIDRs,
+		ZoneTransferTSIGKeys:                 conf.DNSZoneTransferTSIGKeys,
+		UpdateKeys:                           conf.DNSUpdateKeys,
+		UpdateDeregisterCriticalServiceAfter: conf.DNSUpdateDeregisterCriticalServiceAfter,
+		ProximityMode:                        conf.DNSProximityMode,
+		ProximityLimit:                       conf.DNSProximityLimit,
+		QueryLogMode:                         conf.DNSQueryLogMode,
+		QueryLogSampleRate:                   conf.DNSQueryLogSampleRate,
+		DNSSECEnabled:                        conf.DNSSECEnabled,
+		DNSSECSignatureValidity:              conf.DNSSECSignatureValidity,
+		ResponseCacheEnabled:                 conf.DNSResponseCacheEnabled,
+		ResponseCacheSize:                    conf.DNSResponseCacheSize,
+		ResponseCacheMinTTL:                  conf.DNSResponseCacheMinTTL,
+		ResponseCacheMaxTTL:                  conf.DNSResponseCacheMaxTTL,
+		ResponseCachePrefetchHits:            conf.DNSResponseCachePrefetchHits,
+		ResponseCachePrefetchPercent:         conf.DNSResponseCachePrefetchPercent,
+		Views:                                getDNSViews(conf),
+		enterpriseDNSConfig:                  getEnterpriseDNSConfig(conf),
 	}
 	if conf.DNSServiceTTL != nil {
 		cfg.TTLRadix = radix.New()
@@ -222,6 +343,12 @@ func GetDNSConfig(conf *config.RuntimeConfig) (*dnsConfig, error) {
 			}
 		}
 	}
+	if len(conf.DNSQueryLogQueryTypes) > 0 {
+		cfg.QueryLogQueryTypes = make(map[uint16]bool, len(conf.DNSQueryLogQueryTypes))
+		for _, t := range conf.DNSQueryLogQueryTypes {
+			cfg.QueryLogQueryTypes[dns.StringToType[t]] = true
+		}
+	}
 	for _, r := range conf.DNSRecursors {
 		ra, err := recursorAddr(r)
 		if err != nil {
@@ -229,10 +356,48 @@ func GetDNSConfig(conf *config.RuntimeConfig) (*dnsConfig, error) {
 		}
 		cfg.Recursors = append(cfg.Recursors, ra)
 	}
+	for _, r := range conf.DNSForwardingRules {
+		rule := dnsForwardingRule{
+			Domain:           strings.TrimPrefix(r.Domain, "*."),
+			Wildcard:         strings.HasPrefix(r.Domain, "*."),
+			RecursorStrategy: r.RecursorStrategy,
+			RecursorTimeout:  r.RecursorTimeout,
+		}
+		for _, recursor := range r.Recursors {
+			ra, err := recursorAddr(recursor)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid recursor address for domain %q: %v", r.Domain, err)
+			}
+			rule.Recursors = append(rule.Recursors, ra)
+		}
+		cfg.ForwardingRules = append(cfg.ForwardingRules, rule)
+	}
+	// Rules for longer domains are more specific, so they are checked first.
+	sort.SliceStable(cfg.ForwardingRules, func(i, j int) bool {
+		ri, rj := cfg.ForwardingRules[i], cfg.ForwardingRules[j]
+		li, lj := dns.CountLabel(ri.Domain), dns.CountLabel(rj.Domain)
+		if li != lj {
+			return li > lj
+		}
+		return ri.Wildcard && !rj.Wildcard
+	})
 
 	return cfg, nil
 }
 
+// recursorsFor returns the recursors to forward queries for name to, along
+// with the strategy and timeout to use for them. The recursors of the most
+// specific matching forwarding rule are used, falling back to the default
+// recursors.
+func (cfg *dnsConfig) recursorsFor(name string) ([]string, agentdns.RecursorStrategy, time.Duration) {
+	for _, rule := range cfg.ForwardingRules {
+		if rule.matches(name) {
+			return rule.Recursors, rule.RecursorStrategy, rule.RecursorTimeout
+		}
+	}
+	return cfg.Recursors, cfg.RecursorStrategy, cfg.RecursorTimeout
+}
+
 // GetTTLForService Find the TTL for a given service.
 // return ttl, true if found, 0, false otherwise
 func (cfg *dnsConfig) GetTTLForService(service string) (time.Duration, bool) {
@@ -255,8 +420,10 @@ func (d *DNSServer) ListenAndServe(network, addr string, notif func()) error {
 	d.Server = &dns.Server{
 		Addr:              addr,
 		Net:               network,
-		Handler:           d.mux,
+		Handler:           d,
 		NotifyStartedFunc: notif,
+		TsigSecret:        tsigSecrets(d.config.Load().(*dnsConfig)),
+		MsgAcceptFunc:     acceptDNSMsg,
 	}
 	if network == "udp" {
 		d.UDPSize = 65535
@@ -264,9 +431,23 @@ func (d *DNSServer) ListenAndServe(network, addr string, notif func()) error {
 	return d.Server.ListenAndServe()
 }
 
+// ListenAndServeTLS serves DNS over TLS (RFC 7858) on the given TCP address.
+func (d *DNSServer) ListenAndServeTLS(addr string, tlsConfig *tls.Config, notif func()) error {
+	d.Server = &dns.Server{
+		Addr:              addr,
+		Net:               "tcp-tls",
+		TLSConfig:         tlsConfig,
+		Handler:           d,
+		NotifyStartedFunc: notif,
+		TsigSecret:        tsigSecrets(d.config.Load().(*dnsConfig)),
+		MsgAcceptFunc:     acceptDNSMsg,
+	}
+	return d.Server.ListenAndServe()
+}
+
 // toggleRecursorHandlerFromConfig enables or disables the recursor handler based on config idempotently
 func (d *DNSServer) toggleRecursorHandlerFromConfig(cfg *dnsConfig) {
-	shouldEnable := len(cfg.Recursors) > 0
+	shouldEnable := len(cfg.Recursors) > 0 || len(cfg.ForwardingRules) > 0
 
 	if shouldEnable && atomic.CompareAndSwapUint32(&d.recursorEnabled, 0, 1) {
 		d.mux.HandleFunc(".", d.handleRecurse)
@@ -288,6 +469,7 @@ func (d *DNSServer) ReloadConfig(newCfg *config.RuntimeConfig) error {
 		return err
 	}
 	d.config.Store(cfg)
+	d.responseCache.configure(cfg)
 	d.toggleRecursorHandlerFromConfig(cfg)
 	return nil
 }
@@ -394,7 +576,7 @@ func (d *DNSServer) handlePtr(resp dns.ResponseWriter, req *dns.Msg) {
 		)
 	}(time.Now())
 
-	cfg := d.config.Load().(*dnsConfig)
+	cfg := d.requestConfig(resp)
 
 	// Setup the message response
 	m := new(dns.Msg)
@@ -416,7 +598,7 @@ func (d *DNSServer) handlePtr(resp dns.ResponseWriter, req *dns.Msg) {
 	args := structs.DCSpecificRequest{
 		Datacenter: datacenter,
 		QueryOptions: structs.QueryOptions{
-			Token:      d.agent.tokens.UserToken(),
+			Token:      d.token(cfg),
 			AllowStale: cfg.AllowStale,
 		},
 	}
@@ -452,11 +634,11 @@ func (d *DNSServer) handlePtr(resp dns.ResponseWriter, req *dns.Msg) {
 		sargs := structs.ServiceSpecificRequest{
 			Datacenter: datacenter,
 			QueryOptions: structs.QueryOptions{
-				Token:      d.agent.tokens.UserToken(),
+				Token:      d.token(cfg),
 				AllowStale: cfg.AllowStale,
 			},
 			ServiceAddress: serviceAddress,
-			EnterpriseMeta: *d.defaultEnterpriseMeta.WithWildcardNamespace(),
+			EnterpriseMeta: *d.enterpriseMeta(cfg).WithWildcardNamespace(),
 		}
 
 		var sout structs.IndexedServiceNodes
@@ -511,7 +693,12 @@ func (d *DNSServer) handleQuery(resp dns.ResponseWriter, req *dns.Msg) {
 		network = "tcp"
 	}
 
-	cfg := d.config.Load().(*dnsConfig)
+	cfg := d.requestConfig(resp)
+
+	if req.Opcode == dns.OpcodeUpdate {
+		d.handleUpdate(cfg, resp, req)
+		return
+	}
 
 	// Setup the message response
 	m := new(dns.Msg)
@@ -525,7 +712,18 @@ func (d *DNSServer) handleQuery(resp dns.ResponseWriter, req *dns.Msg) {
 	switch req.Question[0].Qtype {
 	case dns.TypeSOA:
 		ns, glue := d.nameservers(req.Question[0].Name, cfg, maxRecursionLevelDefault)
-		m.Answer = append(m.Answer, d.soa(cfg, q.Name))
+		soa := d.soa(cfg, q.Name)
+		if zoneTransferClientAllowed(cfg, resp.RemoteAddr()) {
+			// Secondaries poll the SOA to decide whether to transfer the
+			// zone, so the serial has to track the catalog. Reading the
+			// catalog is expensive, so other clients get the static serial.
+			if serial, err := d.zoneSerial(cfg); err == nil {
+				soa.Serial = serial
+			} else {
+				d.logger.Warn("Unable to determine zone serial", "error", err)
+			}
+		}
+		m.Answer = append(m.Answer, soa)
 		m.Ns = append(m.Ns, ns...)
 		m.Extra = append(m.Extra, glue...)
 		m.SetRcode(req, dns.RcodeSuccess)
@@ -536,27 +734,42 @@ func (d *DNSServer) handleQuery(resp dns.ResponseWriter, req *dns.Msg) {
 		m.Extra = glue
 		m.SetRcode(req, dns.RcodeSuccess)
 
-	case dns.TypeAXFR:
-		m.SetRcode(req, dns.RcodeNotImplemented)
+	case dns.TypeAXFR, dns.TypeIXFR:
+		d.handleZoneTransfer(cfg, network, resp, req)
+		return
 
 	default:
-		err = d.dispatch(resp.RemoteAddr(), req, m, maxRecursionLevelDefault)
-		rCode := rCodeFromError(err)
-		if rCode == dns.RcodeNameError || errors.Is(err, errNoData) {
-			d.addSOA(cfg, m, q.Name)
+		if d.answerDNSSECApex(cfg, req, m) {
+			break
 		}
-		m.SetRcode(req, rCode)
+		err = d.answerQuery(cfg, resp.RemoteAddr(), req, m)
 	}
 
 	setEDNS(req, m, !errors.Is(err, errECSNotGlobal))
 
 	d.trimDNSResponse(cfg, network, req, m)
 
+	if cfg.DNSSECEnabled {
+		d.signDNSSEC(cfg, network, req, m)
+	}
+
 	if err := resp.WriteMsg(m); err != nil {
 		d.logger.Warn("failed to respond", "error", err)
 	}
 }
 
+// dispatchQuery looks up the answer of a query for the Consul domain and sets
+// the response code for it.
+func (d *DNSServer) dispatchQuery(cfg *dnsConfig, remoteAddr net.Addr, req, m *dns.Msg) error {
+	err := d.dispatch(cfg, remoteAddr, req, m, maxRecursionLevelDefault)
+	rCode := rCodeFromError(err)
+	if rCode == dns.RcodeNameError || errors.Is(err, errNoData) {
+		d.addSOA(cfg, m, req.Question[0].Name)
+	}
+	m.SetRcode(req, rCode)
+	return err
+}
+
 func (d *DNSServer) soa(cfg *dnsConfig, questionName string) *dns.SOA {
 	domain := d.domain
 	if d.altDomain != "" && strings.HasSuffix(questionName, "."+d.altDomain) {
@@ -633,7 +846,7 @@ func (d *DNSServer) nameservers(questionName string, cfg *dnsConfig, maxRecursio
 		}
 		ns = append(ns, nsrr)
 
-		extra = append(extra, d.makeRecordFromNode(o.Node, dns.TypeANY, fqdn, cfg.NodeTTL, maxRecursionLevel)...)
+		extra = append(extra, d.makeRecordFromNode(cfg, o.Node, dns.TypeANY, fqdn, cfg.NodeTTL, maxRecursionLevel)...)
 
 		// don't provide more than 3 servers
 		if len(ns) >= 3 {
@@ -727,7 +940,7 @@ func (l queryLocality) effectiveDatacenter(defaultDC string) string {
 
 // dispatch is used to parse a request and invoke the correct handler.
 // parameter maxRecursionLevel will handle whether recursive call can be performed
-func (d *DNSServer) dispatch(remoteAddr net.Addr, req, resp *dns.Msg, maxRecursionLevel int) error {
+func (d *DNSServer) dispatch(cfg *dnsConfig, remoteAddr net.Addr, req, resp *dns.Msg, maxRecursionLevel int) error {
 	// Choose correct response domain
 	respDomain := d.getResponseDomain(req.Question[0].Name)
 
@@ -738,8 +951,6 @@ func (d *DNSServer) dispatch(remoteAddr net.Addr, req, resp *dns.Msg, maxRecursi
 	// Split into the label parts
 	labels := dns.SplitDomainName(qName)
 
-	cfg := d.config.Load().(*dnsConfig)
-
 	var queryKind string
 	var queryParts []string
 	var querySuffixes []string
@@ -824,7 +1035,7 @@ func (d *DNSServer) dispatch(remoteAddr net.Addr, req, resp *dns.Msg, maxRecursi
 				// tag[.tag].name.service.consul
 			}
 
-			err = d.serviceLookup(cfg, lookup, req, resp)
+			err = d.serviceLookup(cfg, lookup, remoteAddr, req, resp)
 			// Return if we are error free right away, otherwise loop again if we can
 			if err == nil {
 				return nil
@@ -855,7 +1066,7 @@ func (d *DNSServer) dispatch(remoteAddr net.Addr, req, resp *dns.Msg, maxRecursi
 			EnterpriseMeta:    locality.EnterpriseMeta,
 		}
 		// name.connect.consul
-		return d.serviceLookup(cfg, lookup, req, resp)
+		return d.serviceLookup(cfg, lookup, remoteAddr, req, resp)
 
 	case "virtual":
 		if len(queryParts) < 1 {
@@ -875,7 +1086,7 @@ func (d *DNSServer) dispatch(remoteAddr net.Addr, req, resp *dns.Msg, maxRecursi
 			ServiceName:    queryParts[len(queryParts)-1],
 			EnterpriseMeta: locality.EnterpriseMeta,
 			QueryOptions: structs.QueryOptions{
-				Token: d.agent.tokens.UserToken(),
+				Token: d.token(cfg),
 			},
 		}
 		if args.PeerName == "" {
@@ -922,7 +1133,7 @@ func (d *DNSServer) dispatch(remoteAddr net.Addr, req, resp *dns.Msg, maxRecursi
 			EnterpriseMeta:    locality.EnterpriseMeta,
 		}
 		// name.ingress.consul
-		return d.serviceLookup(cfg, lookup, req, resp)
+		return d.serviceLookup(cfg, lookup, remoteAddr, req, resp)
 
 	case "node":
 		if len(queryParts) < 1 {
@@ -1093,7 +1304,7 @@ func (d *DNSServer) nodeLookup(cfg *dnsConfig, lookup nodeLookup, req, resp *dns
 		PeerName:   lookup.PeerName,
 		Node:       lookup.Node,
 		QueryOptions: structs.QueryOptions{
-			Token:      d.agent.tokens.UserToken(),
+			Token:      d.token(cfg),
 			AllowStale: cfg.AllowStale,
 		},
 		EnterpriseMeta: lookup.EnterpriseMeta,
@@ -1119,7 +1330,7 @@ func (d *DNSServer) nodeLookup(cfg *dnsConfig, lookup nodeLookup, req, resp *dns
 	q := req.Question[0]
 	// Only compute A and CNAME record if query is not TXT type
 	if qType != dns.TypeTXT {
-		records := d.makeRecordFromNode(n, q.Qtype, q.Name, cfg.NodeTTL, lookup.MaxRecursionLevel)
+		records := d.makeRecordFromNode(cfg, n, q.Qtype, q.Name, cfg.NodeTTL, lookup.MaxRecursionLevel)
 		resp.Answer = append(resp.Answer, records...)
 	}
 
@@ -1425,7 +1636,7 @@ func (d *DNSServer) lookupServiceNodes(cfg *dnsConfig, lookup serviceLookup) (st
 		ServiceTags: serviceTags,
 		TagFilter:   lookup.Tag != "",
 		QueryOptions: structs.QueryOptions{
-			Token:            d.agent.tokens.UserToken(),
+			Token:            d.token(cfg),
 			AllowStale:       cfg.AllowStale,
 			MaxAge:           cfg.CacheMaxAge,
 			UseCache:         cfg.UseCache,
@@ -1448,7 +1659,7 @@ func (d *DNSServer) lookupServiceNodes(cfg *dnsConfig, lookup serviceLookup) (st
 }
 
 // serviceLookup is used to handle a service query
-func (d *DNSServer) serviceLookup(cfg *dnsConfig, lookup serviceLookup, req, resp *dns.Msg) error {
+func (d *DNSServer) serviceLookup(cfg *dnsConfig, lookup serviceLookup, remoteAddr net.Addr, req, resp *dns.Msg) error {
 	out, err := d.lookupServiceNodes(cfg, lookup)
 	if err != nil {
 		return fmt.Errorf("rpc request failed: %w", err)
@@ -1462,6 +1673,12 @@ func (d *DNSServer) serviceLookup(cfg *dnsConfig, lookup serviceLookup, req, res
 	// Perform a random shuffle
 	out.Nodes.Shuffle()
 
+	// Order the nodes by their distance to the client, if enabled. The
+	// shuffle above still spreads the load across equally distant nodes.
+	if cfg.ProximityMode != "" && lookup.PeerName == "" && lookup.Datacenter == d.agent.config.Datacenter {
+		out.Nodes = d.sortByProximity(cfg, req, remoteAddr, out.Nodes)
+	}
+
 	// Determine the TTL
 	ttl, _ := cfg.GetTTLForService(lookup.Service)
 
@@ -1469,6 +1686,8 @@ func (d *DNSServer) serviceLookup(cfg *dnsConfig, lookup serviceLookup, req, res
 	qType := req.Question[0].Qtype
 	if qType == dns.TypeSRV {
 		d.serviceSRVRecords(cfg, lookup, out.Nodes, req, resp, ttl, lookup.MaxRecursionLevel)
+	} else if qType == dns.TypeSVCB || qType == dns.TypeHTTPS {
+		d.serviceSVCBRecords(cfg, lookup, out.Nodes, req, resp, ttl, lookup.MaxRecursionLevel)
 	} else {
 		d.serviceNodeRecords(cfg, lookup, out.Nodes, req, resp, ttl, lookup.MaxRecursionLevel)
 	}
@@ -1496,6 +1715,24 @@ func ednsSubnetForRequest(req *dns.Msg) *dns.EDNS0_SUBNET {
 	return nil
 }
 
+// sourceIPForRequest returns the address of the client a query is made on
+// behalf of. The EDNS Client Subnet address is preferred over the address of
+// the resolver the query came from.
+func sourceIPForRequest(req *dns.Msg, remoteAddr net.Addr) net.IP {
+	if subnet := ednsSubnetForRequest(req); subnet != nil {
+		return subnet.Address
+	}
+	switch v := remoteAddr.(type) {
+	case *net.UDPAddr:
+		return v.IP
+	case *net.TCPAddr:
+		return v.IP
+	case *net.IPAddr:
+		return v.IP
+	}
+	return nil
+}
+
 // preparedQueryLookup is used to handle a prepared query.
 func (d *DNSServer) preparedQueryLookup(cfg *dnsConfig, datacenter, query string, remoteAddr net.Addr, req, resp *dns.Msg, maxRecursionLevel int) error {
 	// Execute the prepared query.
@@ -1503,7 +1740,7 @@ func (d *DNSServer) preparedQueryLookup(cfg *dnsConfig, datacenter, query string
 		Datacenter:    datacenter,
 		QueryIDOrName: query,
 		QueryOptions: structs.QueryOptions{
-			Token:      d.agent.tokens.UserToken(),
+			Token:      d.token(cfg),
 			AllowStale: cfg.AllowStale,
 			MaxAge:     cfg.CacheMaxAge,
 		},
@@ -1520,19 +1757,8 @@ func (d *DNSServer) preparedQueryLookup(cfg *dnsConfig, datacenter, query string
 		},
 	}
 
-	subnet := ednsSubnetForRequest(req)
-
-	if subnet != nil {
-		args.Source.Ip = subnet.Address.String()
-	} else {
-		switch v := remoteAddr.(type) {
-		case *net.UDPAddr:
-			args.Source.Ip = v.IP.String()
-		case *net.TCPAddr:
-			args.Source.Ip = v.IP.String()
-		case *net.IPAddr:
-			args.Source.Ip = v.IP.String()
-		}
+	if ip := sourceIPForRequest(req, remoteAddr); ip != nil {
+		args.Source.Ip = ip.String()
 	}
 
 	out, err := d.lookupPreparedQuery(cfg, args)
@@ -1769,7 +1995,7 @@ func makeARecord(qType uint16, ip net.IP, ttl time.Duration) dns.RR {
 // Craft dns records for a node
 // In case of an SRV query the answer will be a IN SRV and additional data will store an IN A to the node IP
 // Otherwise it will return a IN A record
-func (d *DNSServer) makeRecordFromNode(node *structs.Node, qType uint16, qName string, ttl time.Duration, maxRecursionLevel int) []dns.RR {
+func (d *DNSServer) makeRecordFromNode(cfg *dnsConfig, node *structs.Node, qType uint16, qName string, ttl time.Duration, maxRecursionLevel int) []dns.RR {
 	addrTranslate := TranslateAddressAcceptDomain
 	if qType == dns.TypeA {
 		addrTranslate |= TranslateAddressAcceptIPv4
@@ -1796,7 +2022,7 @@ func (d *DNSServer) makeRecordFromNode(node *structs.Node, qType uint16, qName s
 		})
 
 		res = append(res,
-			d.resolveCNAME(d.config.Load().(*dnsConfig), dns.Fqdn(node.Address), maxRecursionLevel)...,
+			d.resolveCNAME(cfg, dns.Fqdn(node.Address), maxRecursionLevel)...,
 		)
 
 		return res
@@ -2063,14 +2289,71 @@ func (d *DNSServer) handleRecurse(resp dns.ResponseWriter, req *dns.Msg) {
 		network = "tcp"
 	}
 
-	// Recursively resolve
-	c := &dns.Client{Net: network, Timeout: cfg.RecursorTimeout}
-	var r *dns.Msg
-	var rtt time.Duration
-	var err error
-	for _, idx := range cfg.RecursorStrategy.Indexes(len(cfg.Recursors)) {
-		recursor := cfg.Recursors[idx]
-		r, rtt, err = c.Exchange(req, recursor)
+	if recursors, _, _ := cfg.recursorsFor(q.Name); len(recursors) == 0 {
+		// Only forwarding rules are configured and none of them matches.
+		m := &dns.Msg{}
+		m.SetRcode(req, dns.RcodeRefused)
+		if err := resp.WriteMsg(m); err != nil {
+			d.logger.Warn("failed to respond", "error", err)
+		}
+		return
+	}
+
+	key := newDNSCacheKey(dnsCacheSourceRecursor, cfg, req)
+	cached, prefetch, generation := d.responseCache.lookup(key)
+	if cached != nil && fitsDNSResponse(network, req, cached) {
+		cached.Id = req.Id
+		cached.Question = req.Question
+		cached.Compress = !cfg.DisableCompression
+		if prefetch {
+			go d.prefetchRecurse(cfg, key, network, req.Copy(), generation)
+		}
+		if err := resp.WriteMsg(cached); err != nil {
+			d.logger.Warn("failed to respond", "error", err)
+		}
+		return
+	}
+
+	if r := d.recurse(cfg, network, req); r != nil {
+		d.responseCache.store(key, r, generation)
+
+		// Compress the response; we don't know if the incoming
+		// response was compressed or not, so by not compressing
+		// we might generate an invalid packet on the way out.
+		r.Compress = !cfg.DisableCompression
+		if err := resp.WriteMsg(r); err != nil {
+			d.logger.Warn("failed to respond", "error", err)
+		}
+		return
+	}
+
+	// If all resolvers fail, return a SERVFAIL message
+	d.logger.Error("all resolvers failed for question from client",
+		"question", q,
+		"client", resp.RemoteAddr().String(),
+		"client_network", resp.RemoteAddr().Network(),
+	)
+	m := &dns.Msg{}
+	m.SetReply(req)
+	m.Compress = !cfg.DisableCompression
+	m.RecursionAvailable = true
+	m.SetRcode(req, dns.RcodeServerFailure)
+	if edns := req.IsEdns0(); edns != nil {
+		setEDNS(req, m, true)
+	}
+	resp.WriteMsg(m)
+}
+
+// recurse forwards a query to the recursors for its name, returning the first
+// answer or name error, or nil when all of them failed.
+func (d *DNSServer) recurse(cfg *dnsConfig, network string, req *dns.Msg) *dns.Msg {
+	q := req.Question[0]
+	recursors, strategy, timeout := cfg.recursorsFor(q.Name)
+
+	c := &dns.Client{Net: network, Timeout: timeout}
+	for _, idx := range strategy.Indexes(len(recursors)) {
+		recursor := recursors[idx]
+		r, rtt, err := c.Exchange(req, recursor)
 		// Check if the response is valid and has the desired Response code
 		if r != nil && (r.Rcode != dns.RcodeSuccess && r.Rcode != dns.RcodeNameError) {
 			d.logger.Debug("recurse failed for question",
@@ -2083,40 +2366,16 @@ func (d *DNSServer) handleRecurse(resp dns.ResponseWriter, req *dns.Msg) {
 			// we move forward onto the next one else the loop ends
 			continue
 		} else if err == nil || (r != nil && r.Truncated) {
-			// Compress the response; we don't know if the incoming
-			// response was compressed or not, so by not compressing
-			// we might generate an invalid packet on the way out.
-			r.Compress = !cfg.DisableCompression
-
-			// Forward the response
 			d.logger.Debug("recurse succeeded for question",
 				"question", q,
 				"rtt", rtt,
 				"recursor", recursor,
 			)
-			if err := resp.WriteMsg(r); err != nil {
-				d.logger.Warn("failed to respond", "error", err)
-			}
-			return
+			return r
 		}
 		d.logger.Error("recurse failed", "error", err)
 	}
-
-	// If all resolvers fail, return a SERVFAIL message
-	d.logger.Error("all resolvers failed for question from client",
-		"question", q,
-		"client", resp.RemoteAddr().String(),
-		"client_network", resp.RemoteAddr().Network(),
-	)
-	m := &dns.Msg{}
-	m.SetReply(req)
-	m.Compress = !cfg.DisableCompression
-	m.RecursionAvailable = true
-	m.SetRcode(req, dns.RcodeServerFailure)
-	if edns := req.IsEdns0(); edns != nil {
-		setEDNS(req, m, true)
-	}
-	resp.WriteMsg(m)
+	return nil
 }
 
 // resolveCNAME is used to recursively resolve CNAME records
@@ -2135,13 +2394,14 @@ func (d *DNSServer) resolveCNAME(cfg *dnsConfig, name string, maxRecursionLevel
 
 		req.SetQuestion(name, dns.TypeANY)
 		// TODO: handle error response
-		d.dispatch(nil, req, resp, maxRecursionLevel-1)
+		d.dispatch(cfg, nil, req, resp, maxRecursionLevel-1)
 
 		return resp.Answer
 	}
 
 	// Do nothing if we don't have a recursor
-	if len(cfg.Recursors) == 0 {
+	recursors, strategy, timeout := cfg.recursorsFor(name)
+	if len(recursors) == 0 {
 		return nil
 	}
 
@@ -2150,12 +2410,12 @@ func (d *DNSServer) resolveCNAME(cfg *dnsConfig, name string, maxRecursionLevel
 	m.SetQuestion(name, dns.TypeA)
 
 	// Make a DNS lookup request
-	c := &dns.Client{Net: "udp", Timeout: cfg.RecursorTimeout}
+	c := &dns.Client{Net: "udp", Timeout: timeout}
 	var r *dns.Msg
 	var rtt time.Duration
 	var err error
-	for _, idx := range cfg.RecursorStrategy.Indexes(len(cfg.Recursors)) {
-		recursor := cfg.Recursors[idx]
+	for _, idx := range strategy.Indexes(len(recursors)) {
+		recursor := recursors[idx]
 		r, rtt, err = c.Exchange(m, recursor)
 		if err == nil {
 			d.logger.Debug("cname recurse RTT for name",
@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstap
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// Frame streams control frame types, see
+// https://farsightsec.github.io/fstrm/ for the protocol.
+const (
+	controlAccept = 0x01
+	controlStart  = 0x02
+	controlStop   = 0x03
+	controlReady  = 0x04
+	controlFinish = 0x05
+
+	controlFieldContentType = 0x01
+
+	// maxControlFrameLength bounds the control frames read from a collector.
+	maxControlFrameLength = 512
+)
+
+// frameWriter writes data frames of the dnstap content type to a frame
+// stream.
+type frameWriter struct {
+	w *bufio.Writer
+}
+
+func newFrameWriter(w io.Writer) *frameWriter {
+	return &frameWriter{w: bufio.NewWriter(w)}
+}
+
+// writeFrame writes a data frame, which is its length followed by the data.
+func (f *frameWriter) writeFrame(data []byte) error {
+	var length [4]byte
+	binary.BigEndian.PutUint32(length[:], uint32(len(data)))
+	if _, err := f.w.Write(length[:]); err != nil {
+		return err
+	}
+	_, err := f.w.Write(data)
+	return err
+}
+
+// writeControl writes a control frame. Control frames are escaped by a zero
+// length, followed by the length of the control frame, its type and for
+// READY, ACCEPT and START frames the content type.
+func (f *frameWriter) writeControl(typ uint32) error {
+	var frame []byte
+	frame = binary.BigEndian.AppendUint32(frame, 0)
+	var body []byte
+	body = binary.BigEndian.AppendUint32(body, typ)
+	if typ == controlReady || typ == controlAccept || typ == controlStart {
+		body = binary.BigEndian.AppendUint32(body, controlFieldContentType)
+		body = binary.BigEndian.AppendUint32(body, uint32(len(ContentType)))
+		body = append(body, ContentType...)
+	}
+	frame = binary.BigEndian.AppendUint32(frame, uint32(len(body)))
+	frame = append(frame, body...)
+	if _, err := f.w.Write(frame); err != nil {
+		return err
+	}
+	return f.w.Flush()
+}
+
+func (f *frameWriter) flush() error {
+	return f.w.Flush()
+}
+
+// readControl reads a control frame from a bidirectional stream and checks
+// that it is of the expected type.
+func readControl(r io.Reader, want uint32) error {
+	var header [8]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return err
+	}
+	if escape := binary.BigEndian.Uint32(header[:4]); escape != 0 {
+		return fmt.Errorf("expected a control frame, got a data frame")
+	}
+	length := binary.BigEndian.Uint32(header[4:])
+	if length < 4 || length > maxControlFrameLength {
+		return fmt.Errorf("invalid control frame length %d", length)
+	}
+	body := make([]byte, length)
+	if _, err := io.ReadFull(r, body); err != nil {
+		return err
+	}
+	if typ := binary.BigEndian.Uint32(body[:4]); typ != want {
+		return fmt.Errorf("expected control frame type %d, got %d", want, typ)
+	}
+	if want != controlAccept {
+		return nil
+	}
+
+	// The collector has to accept the dnstap content type.
+	fields := body[4:]
+	for len(fields) >= 8 {
+		field := binary.BigEndian.Uint32(fields[:4])
+		size := binary.BigEndian.Uint32(fields[4:8])
+		fields = fields[8:]
+		if uint32(len(fields)) < size {
+			break
+		}
+		if field == controlFieldContentType && string(fields[:size]) == ContentType {
+			return nil
+		}
+		fields = fields[size:]
+	}
+	return fmt.Errorf("collector does not accept content type %q", ContentType)
+}
@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package dnstap encodes DNS messages in the dnstap format and writes them to
+// a dnstap collector, such as a Unix socket served by dnstap-read or
+// fstrm_capture, or to a file.
+//
+// See https://dnstap.info for the format. The protobuf messages are encoded
+// by hand as only a few fields of the schema are used.
+package dnstap
+
+import (
+	"net"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// ContentType is the frame streams content type of dnstap frames.
+const ContentType = "protobuf:dnstap.Dnstap"
+
+// MessageType is the kind of a logged DNS message.
+type MessageType int32
+
+const (
+	// ClientQuery is a query received by the agent from a client.
+	ClientQuery MessageType = 5
+
+	// ClientResponse is a response sent by the agent to a client.
+	ClientResponse MessageType = 6
+)
+
+// SocketProtocol is the transport a DNS message was received with.
+type SocketProtocol int32
+
+const (
+	ProtocolUDP SocketProtocol = 1
+	ProtocolTCP SocketProtocol = 2
+	ProtocolDOT SocketProtocol = 3
+	ProtocolDOH SocketProtocol = 4
+)
+
+const (
+	// dnstapTypeMessage is the Dnstap.Type of a Dnstap carrying a Message.
+	dnstapTypeMessage = 1
+
+	socketFamilyINET  = 1
+	socketFamilyINET6 = 2
+)
+
+// Field numbers of the Dnstap protobuf message.
+const (
+	fieldDnstapIdentity = 1
+	fieldDnstapVersion  = 2
+	fieldDnstapMessage  = 14
+	fieldDnstapType     = 15
+)
+
+// Field numbers of the Message protobuf message.
+const (
+	fieldMessageType             = 1
+	fieldMessageSocketFamily     = 2
+	fieldMessageSocketProtocol   = 3
+	fieldMessageQueryAddress     = 4
+	fieldMessageResponseAddress  = 5
+	fieldMessageQueryPort        = 6
+	fieldMessageResponsePort     = 7
+	fieldMessageQueryTimeSec     = 8
+	fieldMessageQueryTimeNsec    = 9
+	fieldMessageQueryMessage     = 10
+	fieldMessageResponseTimeSec  = 12
+	fieldMessageResponseTimeNsec = 13
+	fieldMessageResponseMessage  = 14
+)
+
+// Message is a single DNS message seen by the agent.
+type Message struct {
+	Type     MessageType
+	Protocol SocketProtocol
+
+	// QueryAddress is the address of the client. ResponseAddress is the
+	// address of the agent the query was received on.
+	QueryAddress    net.IP
+	QueryPort       uint16
+	ResponseAddress net.IP
+	ResponsePort    uint16
+
+	// QueryTime is when the query was received, and QueryMessage its wire
+	// format. They are only set on ClientQuery messages.
+	QueryTime    time.Time
+	QueryMessage []byte
+
+	// ResponseTime is when the response was sent, and ResponseMessage its
+	// wire format. They are only set on ClientResponse messages.
+	ResponseTime    time.Time
+	ResponseMessage []byte
+}
+
+// Marshal encodes m as a Dnstap protobuf message, identifying the agent by
+// identity and version when they are not empty.
+func (m *Message) Marshal(identity, version string) []byte {
+	var msg []byte
+	msg = protowire.AppendTag(msg, fieldMessageType, protowire.VarintType)
+	msg = protowire.AppendVarint(msg, uint64(m.Type))
+
+	addr := m.QueryAddress
+	if addr == nil {
+		addr = m.ResponseAddress
+	}
+	if addr != nil {
+		family := socketFamilyINET6
+		if addr.To4() != nil {
+			family = socketFamilyINET
+		}
+		msg = protowire.AppendTag(msg, fieldMessageSocketFamily, protowire.VarintType)
+		msg = protowire.AppendVarint(msg, uint64(family))
+	}
+	if m.Protocol != 0 {
+		msg = protowire.AppendTag(msg, fieldMessageSocketProtocol, protowire.VarintType)
+		msg = protowire.AppendVarint(msg, uint64(m.Protocol))
+	}
+	if m.QueryAddress != nil {
+		msg = protowire.AppendTag(msg, fieldMessageQueryAddress, protowire.BytesType)
+		msg = protowire.AppendBytes(msg, ipBytes(m.QueryAddress))
+		msg = protowire.AppendTag(msg, fieldMessageQueryPort, protowire.VarintType)
+		msg = protowire.AppendVarint(msg, uint64(m.QueryPort))
+	}
+	if m.ResponseAddress != nil {
+		msg = protowire.AppendTag(msg, fieldMessageResponseAddress, protowire.BytesType)
+		msg = protowire.AppendBytes(msg, ipBytes(m.ResponseAddress))
+		msg = protowire.AppendTag(msg, fieldMessageResponsePort, protowire.VarintType)
+		msg = protowire.AppendVarint(msg, uint64(m.ResponsePort))
+	}
+	if !m.QueryTime.IsZero() {
+		msg = protowire.AppendTag(msg, fieldMessageQueryTimeSec, protowire.VarintType)
+		msg = protowire.AppendVarint(msg, uint64(m.QueryTime.Unix()))
+		msg = protowire.AppendTag(msg, fieldMessageQueryTimeNsec, protowire.Fixed32Type)
+		msg = protowire.AppendFixed32(msg, uint32(m.QueryTime.Nanosecond()))
+	}
+	if m.QueryMessage != nil {
+		msg = protowire.AppendTag(msg, fieldMessageQueryMessage, protowire.BytesType)
+		msg = protowire.AppendBytes(msg, m.QueryMessage)
+	}
+	if !m.ResponseTime.IsZero() {
+		msg = protowire.AppendTag(msg, fieldMessageResponseTimeSec, protowire.VarintType)
+		msg = protowire.AppendVarint(msg, uint64(m.ResponseTime.Unix()))
+		msg = protowire.AppendTag(msg, fieldMessageResponseTimeNsec, protowire.Fixed32Type)
+		msg = protowire.AppendFixed32(msg, uint32(m.ResponseTime.Nanosecond()))
+	}
+	if m.ResponseMessage != nil {
+		msg = protowire.AppendTag(msg, fieldMessageResponseMessage, protowire.BytesType)
+		msg = protowire.AppendBytes(msg, m.ResponseMessage)
+	}
+
+	var b []byte
+	if identity != "" {
+		b = protowire.AppendTag(b, fieldDnstapIdentity, protowire.BytesType)
+		b = protowire.AppendString(b, identity)
+	}
+	if version != "" {
+		b = protowire.AppendTag(b, fieldDnstapVersion, protowire.BytesType)
+		b = protowire.AppendString(b, version)
+	}
+	b = protowire.AppendTag(b, fieldDnstapMessage, protowire.BytesType)
+	b = protowire.AppendBytes(b, msg)
+	b = protowire.AppendTag(b, fieldDnstapType, protowire.VarintType)
+	b = protowire.AppendVarint(b, dnstapTypeMessage)
+	return b
+}
+
+// ipBytes returns the 4 byte form of IPv4 addresses, which dnstap expects.
+func ipBytes(ip net.IP) []byte {
+	if ip4 := ip.To4(); ip4 != nil {
+		return ip4
+	}
+	return ip.To16()
+}
@@ -0,0 +1,238 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstap
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/hashicorp/go-hclog"
+)
+
+const (
+	// defaultBufferSize is how many frames are queued for writing before
+	// new frames are dropped.
+	defaultBufferSize = 1024
+
+	// handshakeTimeout bounds connecting to a collector and the control
+	// frame exchanges with it.
+	handshakeTimeout = 5 * time.Second
+
+	// maxReconnectWait is the longest wait between attempts to connect to
+	// a collector.
+	maxReconnectWait = 30 * time.Second
+)
+
+// Config is the configuration of a Sink. Exactly one of SocketPath and
+// FilePath must be set.
+type Config struct {
+	// SocketPath is the path of the Unix socket of a dnstap collector.
+	SocketPath string
+
+	// FilePath is the path of a file frames are written to. The file is
+	// truncated when the sink is created.
+	FilePath string
+
+	// Identity and Version identify the agent in the logged messages.
+	Identity string
+	Version  string
+
+	// BufferSize is how many frames are queued for writing. Defaults to
+	// 1024.
+	BufferSize int
+
+	Logger hclog.Logger
+}
+
+// Sink writes dnstap messages to a collector or a file in the background.
+// Messages are dropped rather than slowing down DNS queries when the
+// collector cannot keep up or is unavailable.
+type Sink struct {
+	config Config
+	logger hclog.Logger
+	frames chan []byte
+
+	closeOnce sync.Once
+	closeCh   chan struct{}
+	doneCh    chan struct{}
+
+	// file is set when writing to a file, and is opened by NewSink so that
+	// a bad path is reported immediately.
+	file *os.File
+}
+
+// NewSink returns a Sink writing to the configured destination.
+func NewSink(config Config) (*Sink, error) {
+	if (config.SocketPath == "") == (config.FilePath == "") {
+		return nil, fmt.Errorf("exactly one of a socket path or a file path is required")
+	}
+	if config.BufferSize <= 0 {
+		config.BufferSize = defaultBufferSize
+	}
+	if config.Logger == nil {
+		config.Logger = hclog.NewNullLogger()
+	}
+	s := &Sink{
+		config:  config,
+		logger:  config.Logger,
+		frames:  make(chan []byte, config.BufferSize),
+		closeCh: make(chan struct{}),
+		doneCh:  make(chan struct{}),
+	}
+	if config.FilePath != "" {
+		f, err := os.OpenFile(config.FilePath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open dnstap file: %w", err)
+		}
+		s.file = f
+	}
+	go s.run()
+	return s, nil
+}
+
+// Log queues m for writing. It never blocks, and returns false when m was
+// dropped because the queue is full.
+func (s *Sink) Log(m *Message) bool {
+	frame := m.Marshal(s.config.Identity, s.config.Version)
+	select {
+	case s.frames <- frame:
+		return true
+	default:
+		metrics.IncrCounter([]string{"dns", "dnstap", "dropped"}, 1)
+		return false
+	}
+}
+
+// Close stops the sink, writing the queued frames and ending the stream.
+func (s *Sink) Close() error {
+	s.closeOnce.Do(func() {
+		close(s.closeCh)
+	})
+	<-s.doneCh
+	return nil
+}
+
+func (s *Sink) run() {
+	defer close(s.doneCh)
+
+	if s.file != nil {
+		s.runFile()
+		return
+	}
+
+	wait := time.Second
+	for {
+		conn, w, err := s.connect()
+		if err != nil {
+			s.logger.Warn("failed to connect to dnstap collector", "path", s.config.SocketPath, "error", err)
+			// Drop frames until the collector is back rather than queueing
+			// stale messages.
+			timer := time.NewTimer(wait)
+		DRAIN:
+			for {
+				select {
+				case <-s.frames:
+					metrics.IncrCounter([]string{"dns", "dnstap", "dropped"}, 1)
+				case <-timer.C:
+					break DRAIN
+				case <-s.closeCh:
+					timer.Stop()
+					return
+				}
+			}
+			if wait *= 2; wait > maxReconnectWait {
+				wait = maxReconnectWait
+			}
+			continue
+		}
+		wait = time.Second
+
+		err = s.write(w)
+		if err == nil {
+			// The sink was closed, finish the stream.
+			conn.SetDeadline(time.Now().Add(handshakeTimeout))
+			if err := w.writeControl(controlStop); err == nil {
+				readControl(conn, controlFinish)
+			}
+			conn.Close()
+			return
+		}
+		s.logger.Warn("failed to write to dnstap collector", "path", s.config.SocketPath, "error", err)
+		conn.Close()
+	}
+}
+
+func (s *Sink) runFile() {
+	defer s.file.Close()
+
+	w := newFrameWriter(s.file)
+	if err := w.writeControl(controlStart); err != nil {
+		s.logger.Error("failed to write to dnstap file", "path", s.config.FilePath, "error", err)
+		return
+	}
+	if err := s.write(w); err != nil {
+		s.logger.Error("failed to write to dnstap file", "path", s.config.FilePath, "error", err)
+		return
+	}
+	if err := w.writeControl(controlStop); err != nil {
+		s.logger.Error("failed to write to dnstap file", "path", s.config.FilePath, "error", err)
+	}
+}
+
+// connect connects to the collector and starts a bidirectional frame stream.
+func (s *Sink) connect() (net.Conn, *frameWriter, error) {
+	conn, err := net.DialTimeout("unix", s.config.SocketPath, handshakeTimeout)
+	if err != nil {
+		return nil, nil, err
+	}
+	conn.SetDeadline(time.Now().Add(handshakeTimeout))
+	w := newFrameWriter(conn)
+	if err := w.writeControl(controlReady); err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	if err := readControl(conn, controlAccept); err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	if err := w.writeControl(controlStart); err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	conn.SetDeadline(time.Time{})
+	return conn, w, nil
+}
+
+// write writes queued frames until the sink is closed, returning nil, or a
+// write fails. Frames are flushed whenever the queue is empty.
+func (s *Sink) write(w *frameWriter) error {
+	for {
+		select {
+		case frame := <-s.frames:
+			if err := w.writeFrame(frame); err != nil {
+				return err
+			}
+			if len(s.frames) == 0 {
+				if err := w.flush(); err != nil {
+					return err
+				}
+			}
+		case <-s.closeCh:
+			for {
+				select {
+				case frame := <-s.frames:
+					if err := w.writeFrame(frame); err != nil {
+						return err
+					}
+				default:
+					return w.flush()
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstap
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// readFrame reads a frame, returning the control type of control frames and
+// the data of data frames.
+func readFrame(t *testing.T, r io.Reader) (uint32, []byte) {
+	t.Helper()
+	var length [4]byte
+	_, err := io.ReadFull(r, length[:])
+	require.NoError(t, err)
+	if n := binary.BigEndian.Uint32(length[:]); n > 0 {
+		data := make([]byte, n)
+		_, err := io.ReadFull(r, data)
+		require.NoError(t, err)
+		return 0, data
+	}
+	_, err = io.ReadFull(r, length[:])
+	require.NoError(t, err)
+	body := make([]byte, binary.BigEndian.Uint32(length[:]))
+	_, err = io.ReadFull(r, body)
+	require.NoError(t, err)
+	return binary.BigEndian.Uint32(body[:4]), nil
+}
+
+// fields decodes the top level fields of a protobuf message, keeping the
+// last value of each.
+func fields(t *testing.T, b []byte) map[protowire.Number]interface{} {
+	t.Helper()
+	out := make(map[protowire.Number]interface{})
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		require.GreaterOrEqual(t, n, 0)
+		b = b[n:]
+		switch typ {
+		case protowire.VarintType:
+			v, n := protowire.ConsumeVarint(b)
+			require.GreaterOrEqual(t, n, 0)
+			out[num] = v
+			b = b[n:]
+		case protowire.Fixed32Type:
+			v, n := protowire.ConsumeFixed32(b)
+			require.GreaterOrEqual(t, n, 0)
+			out[num] = v
+			b = b[n:]
+		case protowire.BytesType:
+			v, n := protowire.ConsumeBytes(b)
+			require.GreaterOrEqual(t, n, 0)
+			out[num] = v
+			b = b[n:]
+		default:
+			t.Fatalf("unexpected wire type %v", typ)
+		}
+	}
+	return out
+}
+
+func testMessage() *Message {
+	return &Message{
+		Type:            ClientResponse,
+		Protocol:        ProtocolUDP,
+		QueryAddress:    net.ParseIP("10.0.0.1"),
+		QueryPort:       53124,
+		ResponseAddress: net.ParseIP("127.0.0.1"),
+		ResponsePort:    8600,
+		ResponseTime:    time.Unix(1700000000, 500),
+		ResponseMessage: []byte("response"),
+	}
+}
+
+func TestMessage_Marshal(t *testing.T) {
+	envelope := fields(t, testMessage().Marshal("node1", "consul 1.16.0"))
+	require.Equal(t, []byte("node1"), envelope[fieldDnstapIdentity])
+	require.Equal(t, []byte("consul 1.16.0"), envelope[fieldDnstapVersion])
+	require.Equal(t, uint64(dnstapTypeMessage), envelope[fieldDnstapType])
+
+	msg := fields(t, envelope[fieldDnstapMessage].([]byte))
+	require.Equal(t, uint64(ClientResponse), msg[fieldMessageType])
+	require.Equal(t, uint64(socketFamilyINET), msg[fieldMessageSocketFamily])
+	require.Equal(t, uint64(ProtocolUDP), msg[fieldMessageSocketProtocol])
+	require.Equal(t, []byte{10, 0, 0, 1}, msg[fieldMessageQueryAddress])
+	require.Equal(t, uint64(53124), msg[fieldMessageQueryPort])
+	require.Equal(t, []byte{127, 0, 0, 1}, msg[fieldMessageResponseAddress])
+	require.Equal(t, uint64(8600), msg[fieldMessageResponsePort])
+	require.Equal(t, uint64(1700000000), msg[fieldMessageResponseTimeSec])
+	require.Equal(t, uint32(500), msg[fieldMessageResponseTimeNsec])
+	require.Equal(t, []byte("response"), msg[fieldMessageResponseMessage])
+	require.NotContains(t, msg, protowire.Number(fieldMessageQueryMessage))
+}
+
+func TestSink_File(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dnstap.fstrm")
+	sink, err := NewSink(Config{FilePath: path})
+	require.NoError(t, err)
+	require.True(t, sink.Log(testMessage()))
+	require.True(t, sink.Log(testMessage()))
+	require.NoError(t, sink.Close())
+
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	r := bytes.NewReader(b)
+
+	typ, _ := readFrame(t, r)
+	require.Equal(t, uint32(controlStart), typ)
+	for i := 0; i < 2; i++ {
+		typ, data := readFrame(t, r)
+		require.Zero(t, typ)
+		require.Equal(t, testMessage().Marshal("", ""), data)
+	}
+	typ, _ = readFrame(t, r)
+	require.Equal(t, uint32(controlStop), typ)
+	require.Zero(t, r.Len())
+}
+
+func TestSink_Socket(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dnstap.sock")
+	l, err := net.Listen("unix", path)
+	require.NoError(t, err)
+	defer l.Close()
+
+	frames := make(chan []byte, 10)
+	errCh := make(chan error, 1)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			errCh <- err
+			return
+		}
+		defer conn.Close()
+
+		typ, _ := readFrame(t, conn)
+		require.Equal(t, uint32(controlReady), typ)
+		w := newFrameWriter(conn)
+		require.NoError(t, w.writeControl(controlAccept))
+		typ, _ = readFrame(t, conn)
+		require.Equal(t, uint32(controlStart), typ)
+		for {
+			typ, data := readFrame(t, conn)
+			if typ == controlStop {
+				errCh <- w.writeControl(controlFinish)
+				return
+			}
+			frames <- data
+		}
+	}()
+
+	sink, err := NewSink(Config{SocketPath: path, Identity: "node1"})
+	require.NoError(t, err)
+	require.True(t, sink.Log(testMessage()))
+
+	select {
+	case data := <-frames:
+		require.Equal(t, testMessage().Marshal("node1", ""), data)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for frame")
+	}
+
+	require.NoError(t, sink.Close())
+	select {
+	case err := <-errCh:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the stream to finish")
+	}
+}
+
+func TestNewSink_Destination(t *testing.T) {
+	_, err := NewSink(Config{})
+	require.Error(t, err)
+	_, err = NewSink(Config{SocketPath: "a", FilePath: "b"})
+	require.Error(t, err)
+}
@@ -0,0 +1,348 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package agent
+
+import (
+	"errors"
+	"math/rand"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/armon/go-metrics"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/miekg/dns"
+)
+
+const (
+	// dnsCacheSourceConsul labels the metrics of answers for the Consul
+	// domain.
+	dnsCacheSourceConsul = "consul"
+	// dnsCacheSourceRecursor labels the metrics of answers of recursors.
+	dnsCacheSourceRecursor = "recursor"
+)
+
+// dnsCacheKey identifies the responses that can be answered the same way.
+// The name keeps its case, so clients checking the case of the question
+// (draft-vixie-dnsext-dns0x20) get the names they asked for.
+type dnsCacheKey struct {
+	source string
+	view   string
+	name   string
+	qtype  uint16
+	qclass uint16
+	edns   bool
+	do     bool
+	cd     bool
+}
+
+func newDNSCacheKey(source string, cfg *dnsConfig, req *dns.Msg) dnsCacheKey {
+	q := req.Question[0]
+	key := dnsCacheKey{
+		source: source,
+		name:   q.Name,
+		qtype:  q.Qtype,
+		qclass: q.Qclass,
+		cd:     req.CheckingDisabled,
+	}
+	if cfg.view != nil {
+		key.view = cfg.view.Name
+	}
+	if opt := req.IsEdns0(); opt != nil {
+		key.edns = true
+		key.do = opt.Do()
+	}
+	return key
+}
+
+// dnsCacheEntry is a cached response.
+type dnsCacheEntry struct {
+	msg     *dns.Msg
+	stored  time.Time
+	expires time.Time
+	ttl     time.Duration
+
+	// hits counts the times the entry was served, and prefetching is set
+	// once a refresh of the entry started.
+	hits        uint32
+	prefetching uint32
+}
+
+// dnsResponseCache caches DNS responses for the TTL of their records, or of
+// their SOA record for negative responses as described in RFC 2308. The
+// least recently used responses are evicted when the cache is full, and
+// popular responses are refreshed in the background before they expire.
+type dnsResponseCache struct {
+	lock sync.RWMutex
+	// entries is nil when the cache is disabled.
+	entries *lru.Cache
+	// generation changes when the cache is reset, so that prefetches started
+	// before are not stored.
+	generation uint64
+
+	minTTL          time.Duration
+	maxTTL          time.Duration
+	prefetchHits    uint32
+	prefetchPercent int
+
+	// now is replaced in tests.
+	now func() time.Time
+}
+
+// configure resets the cache with the settings of the config.
+func (c *dnsResponseCache) configure(cfg *dnsConfig) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.entries = nil
+	if cfg.ResponseCacheEnabled {
+		// The size is validated to be positive.
+		c.entries, _ = lru.New(cfg.ResponseCacheSize)
+	}
+	c.generation++
+	c.minTTL = cfg.ResponseCacheMinTTL
+	c.maxTTL = cfg.ResponseCacheMaxTTL
+	c.prefetchHits = uint32(cfg.ResponseCachePrefetchHits)
+	c.prefetchPercent = cfg.ResponseCachePrefetchPercent
+	if c.now == nil {
+		c.now = time.Now
+	}
+}
+
+func (c *dnsResponseCache) enabled() bool {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.entries != nil
+}
+
+// lookup returns a copy of the cached response for the key, with the TTLs
+// of its records decreased by the time it was cached for, or nil when there
+// is none. prefetch is set for the one caller that should refresh the
+// response, and generation must be passed to store along with the refreshed
+// response.
+func (c *dnsResponseCache) lookup(key dnsCacheKey) (msg *dns.Msg, prefetch bool, generation uint64) {
+	c.lock.RLock()
+	entries, generation, now := c.entries, c.generation, c.now()
+	prefetchHits, prefetchPercent := c.prefetchHits, c.prefetchPercent
+	c.lock.RUnlock()
+	if entries == nil {
+		return nil, false, generation
+	}
+
+	labels := []metrics.Label{{Name: "source", Value: key.source}}
+	raw, ok := entries.Get(key)
+	if !ok {
+		metrics.IncrCounterWithLabels([]string{"dns", "cache", "miss"}, 1, labels)
+		return nil, false, generation
+	}
+	entry := raw.(*dnsCacheEntry)
+	if !now.Before(entry.expires) {
+		entries.Remove(key)
+		metrics.IncrCounterWithLabels([]string{"dns", "cache", "miss"}, 1, labels)
+		return nil, false, generation
+	}
+	metrics.IncrCounterWithLabels([]string{"dns", "cache", "hit"}, 1, labels)
+
+	hits := atomic.AddUint32(&entry.hits, 1)
+	if prefetchHits > 0 && hits >= prefetchHits &&
+		entry.expires.Sub(now) <= entry.ttl*time.Duration(prefetchPercent)/100 {
+		prefetch = atomic.CompareAndSwapUint32(&entry.prefetching, 0, 1)
+	}
+
+	msg = entry.msg.Copy()
+	age := uint32(now.Sub(entry.stored) / time.Second)
+	for _, section := range [][]dns.RR{msg.Answer, msg.Ns, msg.Extra} {
+		for _, rr := range section {
+			hdr := rr.Header()
+			if hdr.Rrtype == dns.TypeOPT {
+				continue
+			}
+			if hdr.Ttl > age {
+				hdr.Ttl -= age
+			} else {
+				hdr.Ttl = 0
+			}
+		}
+	}
+	return msg, prefetch, generation
+}
+
+// store caches a copy of the response, unless it has no TTL or the cache
+// was reset since generation.
+func (c *dnsResponseCache) store(key dnsCacheKey, m *dns.Msg, generation uint64) {
+	c.lock.RLock()
+	entries, now := c.entries, c.now()
+	current, minTTL, maxTTL := c.generation, c.minTTL, c.maxTTL
+	c.lock.RUnlock()
+	if entries == nil || generation != current {
+		return
+	}
+
+	ttl, ok := dnsResponseTTL(m)
+	if !ok {
+		return
+	}
+	if ttl < minTTL {
+		ttl = minTTL
+	}
+	if ttl > maxTTL {
+		ttl = maxTTL
+	}
+	if ttl <= 0 {
+		return
+	}
+	entries.Add(key, &dnsCacheEntry{
+		msg:     m.Copy(),
+		stored:  now,
+		expires: now.Add(ttl),
+		ttl:     ttl,
+	})
+}
+
+// dnsResponseTTL returns how long a response may be cached for. Positive
+// responses are cached for the lowest TTL of their records, and negative
+// responses for the lower of the TTL and the minimum field of their SOA
+// record. Other responses are not cached.
+func dnsResponseTTL(m *dns.Msg) (time.Duration, bool) {
+	if m.Truncated {
+		return 0, false
+	}
+	negative := m.Rcode == dns.RcodeNameError || (m.Rcode == dns.RcodeSuccess && len(m.Answer) == 0)
+	if negative {
+		for _, rr := range m.Ns {
+			if soa, ok := rr.(*dns.SOA); ok {
+				ttl := soa.Hdr.Ttl
+				if soa.Minttl < ttl {
+					ttl = soa.Minttl
+				}
+				return time.Duration(ttl) * time.Second, true
+			}
+		}
+		return 0, false
+	}
+	if m.Rcode != dns.RcodeSuccess {
+		return 0, false
+	}
+
+	minTTL := uint32(0)
+	first := true
+	for _, section := range [][]dns.RR{m.Answer, m.Ns, m.Extra} {
+		for _, rr := range section {
+			hdr := rr.Header()
+			if hdr.Rrtype == dns.TypeOPT {
+				continue
+			}
+			if first || hdr.Ttl < minTTL {
+				minTTL = hdr.Ttl
+				first = false
+			}
+		}
+	}
+	return time.Duration(minTTL) * time.Second, true
+}
+
+// shuffleDNSAnswers reorders the records of an answer of a single type, so
+// that cached answers spread the load of clients over the instances like
+// the answers of the catalog do.
+func shuffleDNSAnswers(m *dns.Msg) {
+	if len(m.Answer) < 2 {
+		return
+	}
+	rrtype := m.Answer[0].Header().Rrtype
+	for _, rr := range m.Answer[1:] {
+		if rr.Header().Rrtype != rrtype {
+			return
+		}
+	}
+	rand.Shuffle(len(m.Answer), func(i, j int) {
+		m.Answer[i], m.Answer[j] = m.Answer[j], m.Answer[i]
+	})
+}
+
+// consulCacheKey returns the cache key of a query for the Consul domain, or
+// false when its answer depends on the client and must not be cached.
+func (d *DNSServer) consulCacheKey(cfg *dnsConfig, req *dns.Msg) (dnsCacheKey, bool) {
+	if !d.responseCache.enabled() {
+		return dnsCacheKey{}, false
+	}
+	// Answers sorted by proximity, and prepared queries, which may sort by
+	// the source address, are specific to the client.
+	if cfg.ProximityMode != "" || ednsSubnetForRequest(req) != nil {
+		return dnsCacheKey{}, false
+	}
+	for _, label := range dns.SplitDomainName(d.trimDomain(req.Question[0].Name)) {
+		if label == "query" {
+			return dnsCacheKey{}, false
+		}
+	}
+	return newDNSCacheKey(dnsCacheSourceConsul, cfg, req), true
+}
+
+// answerQuery answers a query for the Consul domain from the response cache,
+// or by looking it up and caching the answer.
+func (d *DNSServer) answerQuery(cfg *dnsConfig, remoteAddr net.Addr, req, m *dns.Msg) error {
+	key, ok := d.consulCacheKey(cfg, req)
+	if !ok {
+		return d.dispatchQuery(cfg, remoteAddr, req, m)
+	}
+	cached, prefetch, generation := d.responseCache.lookup(key)
+	if cached != nil {
+		shuffleDNSAnswers(cached)
+		m.Answer, m.Ns, m.Extra = cached.Answer, cached.Ns, cached.Extra
+		m.Rcode = cached.Rcode
+		if prefetch {
+			go d.prefetchQuery(cfg, key, req.Copy(), generation)
+		}
+		return nil
+	}
+	err := d.dispatchQuery(cfg, remoteAddr, req, m)
+	if cacheableDNSError(err) {
+		d.responseCache.store(key, m, generation)
+	}
+	return err
+}
+
+// prefetchQuery refreshes the cached answer of a query for the Consul domain.
+func (d *DNSServer) prefetchQuery(cfg *dnsConfig, key dnsCacheKey, req *dns.Msg, generation uint64) {
+	metrics.IncrCounterWithLabels([]string{"dns", "cache", "prefetch"}, 1,
+		[]metrics.Label{{Name: "source", Value: key.source}})
+	m := new(dns.Msg)
+	m.SetReply(req)
+	if err := d.dispatchQuery(cfg, nil, req, m); cacheableDNSError(err) {
+		d.responseCache.store(key, m, generation)
+	}
+}
+
+// prefetchRecurse refreshes the cached answer of a recursor.
+func (d *DNSServer) prefetchRecurse(cfg *dnsConfig, key dnsCacheKey, network string, req *dns.Msg, generation uint64) {
+	metrics.IncrCounterWithLabels([]string{"dns", "cache", "prefetch"}, 1,
+		[]metrics.Label{{Name: "source", Value: key.source}})
+	if r := d.recurse(cfg, network, req); r != nil {
+		d.responseCache.store(key, r, generation)
+	}
+}
+
+// fitsDNSResponse returns whether a response can be sent to the client of
+// the request without being truncated.
+func fitsDNSResponse(network string, req, m *dns.Msg) bool {
+	if network != "udp" {
+		return true
+	}
+	size := defaultMaxUDPSize
+	if opt := req.IsEdns0(); opt != nil && int(opt.UDPSize()) > size {
+		size = int(opt.UDPSize())
+	}
+	return m.Len() <= size
+}
+
+// cacheableDNSError returns whether the answer of a lookup that returned the
+// error may be cached.
+func cacheableDNSError(err error) bool {
+	switch rCodeFromError(err) {
+	case dns.RcodeSuccess, dns.RcodeNameError:
+		return !errors.Is(err, errECSNotGlobal)
+	}
+	return false
+}
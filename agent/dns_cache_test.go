@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package agent
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+func TestDNSResponseTTL(t *testing.T) {
+	soa := &dns.SOA{Hdr: dns.RR_Header{Name: "consul.", Rrtype: dns.TypeSOA, Ttl: 60}, Minttl: 30}
+	a := func(ttl uint32) dns.RR {
+		return &dns.A{Hdr: dns.RR_Header{Name: "web.service.consul.", Rrtype: dns.TypeA, Ttl: ttl}}
+	}
+	opt := &dns.OPT{Hdr: dns.RR_Header{Name: ".", Rrtype: dns.TypeOPT}}
+
+	cases := map[string]struct {
+		msg *dns.Msg
+		ttl time.Duration
+		ok  bool
+	}{
+		"lowest record TTL": {
+			msg: &dns.Msg{Answer: []dns.RR{a(20), a(10)}, Extra: []dns.RR{opt}},
+			ttl: 10 * time.Second,
+			ok:  true,
+		},
+		"name error": {
+			msg: &dns.Msg{MsgHdr: dns.MsgHdr{Rcode: dns.RcodeNameError}, Ns: []dns.RR{soa}},
+			ttl: 30 * time.Second,
+			ok:  true,
+		},
+		"no data": {
+			msg: &dns.Msg{Ns: []dns.RR{soa}},
+			ttl: 30 * time.Second,
+			ok:  true,
+		},
+		"negative without SOA": {
+			msg: &dns.Msg{MsgHdr: dns.MsgHdr{Rcode: dns.RcodeNameError}},
+		},
+		"server failure": {
+			msg: &dns.Msg{MsgHdr: dns.MsgHdr{Rcode: dns.RcodeServerFailure}},
+		},
+		"truncated": {
+			msg: &dns.Msg{MsgHdr: dns.MsgHdr{Truncated: true}, Answer: []dns.RR{a(20)}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ttl, ok := dnsResponseTTL(tc.msg)
+			require.Equal(t, tc.ok, ok)
+			require.Equal(t, tc.ttl, ttl)
+		})
+	}
+}
+
+func TestDNSResponseCache(t *testing.T) {
+	now := time.Now()
+	c := &dnsResponseCache{now: func() time.Time { return now }}
+	c.configure(&dnsConfig{
+		ResponseCacheEnabled:         true,
+		ResponseCacheSize:            2,
+		ResponseCacheMaxTTL:          time.Minute,
+		ResponseCachePrefetchHits:    2,
+		ResponseCachePrefetchPercent: 50,
+	})
+
+	key := func(name string) dnsCacheKey {
+		return newDNSCacheKey(dnsCacheSourceConsul, &dnsConfig{}, new(dns.Msg).SetQuestion(name, dns.TypeA))
+	}
+	answer := func(name string, ttl uint32) *dns.Msg {
+		m := new(dns.Msg).SetQuestion(name, dns.TypeA)
+		m.Answer = []dns.RR{&dns.A{Hdr: dns.RR_Header{Name: name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: ttl}}}
+		return m
+	}
+
+	m, _, generation := c.lookup(key("web.service.consul."))
+	require.Nil(t, m)
+	c.store(key("web.service.consul."), answer("web.service.consul.", 10), generation)
+
+	// The TTL decreases with the time the answer is cached for.
+	now = now.Add(3 * time.Second)
+	m, prefetch, _ := c.lookup(key("web.service.consul."))
+	require.NotNil(t, m)
+	require.False(t, prefetch)
+	require.Equal(t, uint32(7), m.Answer[0].Header().Ttl)
+
+	// Popular answers are prefetched once when they are about to expire.
+	now = now.Add(3 * time.Second)
+	_, prefetch, _ = c.lookup(key("web.service.consul."))
+	require.True(t, prefetch)
+	_, prefetch, _ = c.lookup(key("web.service.consul."))
+	require.False(t, prefetch)
+
+	now = now.Add(4 * time.Second)
+	m, _, _ = c.lookup(key("web.service.consul."))
+	require.Nil(t, m)
+
+	// Answers without a TTL are not cached, and TTLs are capped.
+	c.store(key("zero.service.consul."), answer("zero.service.consul.", 0), generation)
+	m, _, _ = c.lookup(key("zero.service.consul."))
+	require.Nil(t, m)
+	c.store(key("long.service.consul."), answer("long.service.consul.", 3600), generation)
+	now = now.Add(time.Minute)
+	m, _, _ = c.lookup(key("long.service.consul."))
+	require.Nil(t, m)
+
+	// The least recently used answers are evicted.
+	for _, name := range []string{"a.service.consul.", "b.service.consul.", "c.service.consul."} {
+		c.store(key(name), answer(name, 10), generation)
+	}
+	m, _, _ = c.lookup(key("a.service.consul."))
+	require.Nil(t, m)
+	m, _, _ = c.lookup(key("c.service.consul."))
+	require.NotNil(t, m)
+
+	// Answers looked up before the cache was reset are not stored.
+	c.configure(&dnsConfig{ResponseCacheEnabled: true, ResponseCacheSize: 2, ResponseCacheMaxTTL: time.Minute})
+	m, _, _ = c.lookup(key("c.service.consul."))
+	require.Nil(t, m)
+	c.store(key("c.service.consul."), answer("c.service.consul.", 10), generation)
+	m, _, _ = c.lookup(key("c.service.consul."))
+	require.Nil(t, m)
+}
+
+func TestDNS_ResponseCache(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	var recursorQueries int32
+	mux := dns.NewServeMux()
+	mux.HandleFunc(".", func(w dns.ResponseWriter, req *dns.Msg) {
+		atomic.AddInt32(&recursorQueries, 1)
+		m := new(dns.Msg).SetReply(req)
+		m.Answer = []dns.RR{dnsA(req.Question[0].Name, "192.0.2.1")}
+		m.Answer[0].Header().Ttl = 300
+		require.NoError(t, w.WriteMsg(m))
+	})
+	recursor := &dns.Server{Addr: "127.0.0.1:0", Net: "udp", Handler: mux}
+	up := make(chan struct{})
+	recursor.NotifyStartedFunc = func() { close(up) }
+	go recursor.ListenAndServe()
+	<-up
+	defer recursor.Shutdown()
+
+	a := NewTestAgent(t, `
+		recursors = ["`+recursor.PacketConn.LocalAddr().String()+`"]
+		dns_config {
+			service_ttl {
+				"*" = "60s"
+			}
+			response_cache {
+				enabled = true
+			}
+		}
+	`)
+	defer a.Shutdown()
+	testrpc.WaitForLeader(t, a.RPC, "dc1")
+
+	args := &structs.RegisterRequest{
+		Datacenter: "dc1",
+		Node:       "foo",
+		Address:    "127.0.0.1",
+		Service: &structs.NodeService{
+			Service: "db",
+			Port:    12345,
+		},
+	}
+	var out struct{}
+	require.NoError(t, a.RPC(context.Background(), "Catalog.Register", args, &out))
+
+	query := func(name string) *dns.Msg {
+		m := new(dns.Msg)
+		m.SetQuestion(name, dns.TypeA)
+		in, _, err := new(dns.Client).Exchange(m, a.DNSAddr())
+		require.NoError(t, err)
+		return in
+	}
+
+	in := query("db.service.consul.")
+	require.Len(t, in.Answer, 1)
+
+	// The cached answer is served until it expires, even though the service
+	// is gone.
+	dereg := &structs.DeregisterRequest{Datacenter: "dc1", Node: "foo"}
+	require.NoError(t, a.RPC(context.Background(), "Catalog.Deregister", dereg, &out))
+	in = query("db.service.consul.")
+	require.Len(t, in.Answer, 1)
+	require.LessOrEqual(t, in.Answer[0].Header().Ttl, uint32(60))
+
+	// Recursor answers are cached.
+	for i := 0; i < 3; i++ {
+		in = query("www.example.com.")
+		require.Len(t, in.Answer, 1)
+	}
+	require.Equal(t, int32(1), atomic.LoadInt32(&recursorQueries))
+
+	// Reloading the configuration clears the cache.
+	for _, srv := range a.dnsServers {
+		require.NoError(t, srv.ReloadConfig(a.config))
+	}
+	in = query("db.service.consul.")
+	require.Empty(t, in.Answer)
+}
@@ -0,0 +1,461 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package agent
+
+import (
+	"context"
+	"crypto"
+	"encoding/base32"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+const (
+	// dnssecKeyringRefreshInterval is how long the DNSSEC keys fetched from
+	// the servers are used before they are fetched again.
+	dnssecKeyringRefreshInterval = time.Minute
+
+	// dnssecInceptionOffset backdates the inception of signatures to allow
+	// for clock skew between the agent and validating resolvers.
+	dnssecInceptionOffset = time.Hour
+)
+
+// dnssecKey is a parsed DNSSEC key. The DNSKEY record has the root as owner
+// name and is copied for the zone being signed.
+type dnssecKey struct {
+	dnskey *dns.DNSKEY
+	signer crypto.Signer
+	active bool
+}
+
+// dnssecKeyring caches the DNSSEC keys of the cluster.
+type dnssecKeyring struct {
+	lock    sync.Mutex
+	keys    []*dnssecKey
+	fetched time.Time
+
+	// refreshing is closed once the running refresh finishes. It is nil when
+	// no refresh is running.
+	refreshing chan struct{}
+
+	// err is the error of the last refresh, if it failed.
+	err error
+}
+
+// dnssecKeys returns the DNSSEC keys. When the cached keys are stale, they
+// are refreshed from the servers in the background and keep being used until
+// the refresh succeeds. Only the first fetch is waited for.
+func (d *DNSServer) dnssecKeys() ([]*dnssecKey, error) {
+	k := d.dnssecKeyring
+	k.lock.Lock()
+	if !k.fetched.IsZero() && time.Since(k.fetched) < dnssecKeyringRefreshInterval {
+		keys := k.keys
+		k.lock.Unlock()
+		return keys, nil
+	}
+	if k.refreshing == nil {
+		// Fetch at most once per interval, even if the fetch fails.
+		k.fetched = time.Now()
+		k.refreshing = make(chan struct{})
+		go d.refreshDNSSECKeys(k.refreshing)
+	}
+	if k.keys != nil {
+		keys := k.keys
+		k.lock.Unlock()
+		return keys, nil
+	}
+	refreshing := k.refreshing
+	k.lock.Unlock()
+
+	<-refreshing
+
+	k.lock.Lock()
+	defer k.lock.Unlock()
+	if k.keys == nil {
+		return nil, k.err
+	}
+	return k.keys, nil
+}
+
+// refreshDNSSECKeys fetches the DNSSEC keys from the servers and replaces the
+// cached keys with them. It closes done once it is finished.
+func (d *DNSServer) refreshDNSSECKeys(done chan struct{}) {
+	keys, err := d.fetchDNSSECKeys()
+
+	k := d.dnssecKeyring
+	k.lock.Lock()
+	defer k.lock.Unlock()
+	defer close(done)
+	k.refreshing = nil
+	k.err = err
+
+	if err != nil {
+		if k.keys != nil {
+			d.logger.Warn("failed to refresh DNSSEC keys, using cached keys", "error", err)
+		}
+		return
+	}
+	k.keys = keys
+	if len(keys) == 0 {
+		// The leader generates the first key shortly after DNSSEC is
+		// enabled, so keep asking until there is one.
+		k.fetched = time.Time{}
+	}
+}
+
+// fetchDNSSECKeys fetches and parses the DNSSEC keys from the servers.
+func (d *DNSServer) fetchDNSSECKeys() ([]*dnssecKey, error) {
+	args := structs.NodeSpecificRequest{
+		Datacenter:     d.agent.config.Datacenter,
+		Node:           d.agent.config.NodeName,
+		EnterpriseMeta: *d.agent.AgentEnterpriseMeta(),
+		QueryOptions: structs.QueryOptions{
+			Token:      d.agent.tokens.AgentToken(),
+			AllowStale: true,
+		},
+	}
+	var out structs.IndexedDNSSECKeys
+	if err := d.agent.RPC(context.Background(), "Operator.DNSSECSigningKeys", &args, &out); err != nil {
+		return nil, fmt.Errorf("failed to fetch DNSSEC keys: %w", err)
+	}
+
+	keys := make([]*dnssecKey, 0, len(out.Keys))
+	for _, key := range out.Keys {
+		parsed, err := parseDNSSECKey(key)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, parsed)
+	}
+	return keys, nil
+}
+
+func parseDNSSECKey(key *structs.DNSSECKey) (*dnssecKey, error) {
+	dnskey := &dns.DNSKEY{
+		Hdr: dns.RR_Header{
+			Name:   ".",
+			Rrtype: dns.TypeDNSKEY,
+			Class:  dns.ClassINET,
+		},
+		Flags:     key.Flags,
+		Protocol:  3,
+		Algorithm: key.Algorithm,
+		PublicKey: key.PublicKey,
+	}
+	priv, err := dnskey.ReadPrivateKey(strings.NewReader(key.PrivateKey), "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse DNSSEC key %d: %w", key.KeyTag, err)
+	}
+	signer, ok := priv.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("DNSSEC key %d cannot sign", key.KeyTag)
+	}
+	return &dnssecKey{dnskey: dnskey, signer: signer, active: key.Active}, nil
+}
+
+// answerDNSSECApex answers DNSKEY and NSEC3PARAM queries for the zone apex.
+// It returns false when the query is not one of those.
+func (d *DNSServer) answerDNSSECApex(cfg *dnsConfig, req, m *dns.Msg) bool {
+	q := req.Question[0]
+	if !cfg.DNSSECEnabled || (q.Qtype != dns.TypeDNSKEY && q.Qtype != dns.TypeNSEC3PARAM) {
+		return false
+	}
+	zone := d.getResponseDomain(q.Name)
+	if !strings.EqualFold(q.Name, zone) {
+		return false
+	}
+
+	switch q.Qtype {
+	case dns.TypeDNSKEY:
+		keys, err := d.dnssecKeys()
+		if err != nil {
+			d.logger.Warn("Unable to get DNSSEC keys", "error", err)
+			m.SetRcode(req, dns.RcodeServerFailure)
+			return true
+		}
+		for _, key := range keys {
+			m.Answer = append(m.Answer, zoneDNSKEY(key, zone, cfg.SOAConfig.Minttl))
+		}
+	case dns.TypeNSEC3PARAM:
+		m.Answer = append(m.Answer, &dns.NSEC3PARAM{
+			Hdr: dns.RR_Header{
+				Name:   zone,
+				Rrtype: dns.TypeNSEC3PARAM,
+				Class:  dns.ClassINET,
+				Ttl:    cfg.SOAConfig.Minttl,
+			},
+			Hash: dns.SHA1,
+		})
+	}
+	m.SetRcode(req, dns.RcodeSuccess)
+	return true
+}
+
+func zoneDNSKEY(key *dnssecKey, zone string, ttl uint32) *dns.DNSKEY {
+	dnskey := *key.dnskey
+	dnskey.Hdr.Name = zone
+	dnskey.Hdr.Ttl = ttl
+	return &dnskey
+}
+
+// signDNSSEC adds the NSEC3 records proving the non-existence of names and
+// types and signs the records of the response, if the client asked for
+// DNSSEC records.
+func (d *DNSServer) signDNSSEC(cfg *dnsConfig, network string, req, m *dns.Msg) {
+	opt := req.IsEdns0()
+	if opt == nil || !opt.Do() {
+		return
+	}
+	if respOpt := m.IsEdns0(); respOpt != nil {
+		respOpt.SetDo()
+	}
+
+	keys, err := d.dnssecKeys()
+	if err != nil {
+		d.logger.Warn("Unable to get DNSSEC keys", "error", err)
+		return
+	}
+	var active *dnssecKey
+	for _, key := range keys {
+		if key.active {
+			active = key
+		}
+	}
+	if active == nil {
+		d.logger.Warn("No active DNSSEC key, answers are not signed")
+		return
+	}
+
+	q := req.Question[0]
+	zone := d.getResponseDomain(q.Name)
+	ttl := cfg.SOAConfig.Minttl
+
+	switch {
+	case m.Rcode == dns.RcodeNameError:
+		m.Ns = append(m.Ns, nsec3NameError(zone, q.Name, ttl)...)
+	case m.Rcode == dns.RcodeSuccess && len(m.Answer) == 0:
+		// Validators need the SOA record to accept a signed empty answer.
+		if !hasSOA(m.Ns) {
+			d.addSOA(cfg, m, q.Name)
+		}
+		m.Ns = append(m.Ns, nsec3NoData(zone, q.Name, q.Qtype, ttl))
+	}
+
+	now := time.Now()
+	rrsig := func(key *dnssecKey) *dns.RRSIG {
+		return &dns.RRSIG{
+			Algorithm:  key.dnskey.Algorithm,
+			KeyTag:     key.dnskey.KeyTag(),
+			SignerName: zone,
+			Inception:  uint32(now.Add(-dnssecInceptionOffset).Unix()),
+			Expiration: uint32(now.Add(cfg.DNSSECSignatureValidity).Unix()),
+		}
+	}
+	sign := func(rrs []dns.RR) []dns.RR {
+		sigs, err := signRRsets(rrsig(active), active.signer, zone, rrs)
+		if err != nil {
+			d.logger.Warn("failed to sign DNS response", "error", err)
+			return rrs
+		}
+
+		// The DNSKEY RRset is also signed with the inactive keys, so that it
+		// validates against the DS record of any published key while the
+		// parent zone is updated during a rollover.
+		if dnskeys := rrsOfType(rrs, dns.TypeDNSKEY); len(dnskeys) > 0 {
+			for _, key := range keys {
+				if key == active {
+					continue
+				}
+				keySigs, err := signRRsets(rrsig(key), key.signer, zone, dnskeys)
+				if err != nil {
+					d.logger.Warn("failed to sign DNS response", "error", err)
+					return rrs
+				}
+				sigs = append(sigs, keySigs...)
+			}
+		}
+		return append(rrs, sigs...)
+	}
+	m.Answer = sign(m.Answer)
+	m.Ns = sign(m.Ns)
+	m.Extra = sign(m.Extra)
+
+	// The signatures can push the response over the size the client accepts.
+	if network != "tcp" {
+		maxSize := dns.MinMsgSize
+		if size := int(opt.UDPSize()); size > maxSize {
+			maxSize = size
+		}
+		if maxSize > maxUDPDatagramSize {
+			maxSize = maxUDPDatagramSize
+		}
+		m.Truncate(maxSize)
+	}
+}
+
+// signRRsets returns the signatures of the RRsets within the zone.
+func signRRsets(tmpl *dns.RRSIG, signer crypto.Signer, zone string, rrs []dns.RR) ([]dns.RR, error) {
+	type rrsetKey struct {
+		name  string
+		rtype uint16
+	}
+	var order []rrsetKey
+	rrsets := make(map[rrsetKey][]dns.RR)
+	for _, rr := range rrs {
+		hdr := rr.Header()
+		if hdr.Rrtype == dns.TypeOPT || hdr.Rrtype == dns.TypeRRSIG || !dns.IsSubDomain(zone, hdr.Name) {
+			continue
+		}
+		key := rrsetKey{name: strings.ToLower(hdr.Name), rtype: hdr.Rrtype}
+		if _, ok := rrsets[key]; !ok {
+			order = append(order, key)
+		}
+		rrsets[key] = append(rrsets[key], rr)
+	}
+
+	sigs := make([]dns.RR, 0, len(order))
+	for _, key := range order {
+		rrset := rrsets[key]
+		sig := *tmpl
+		sig.Hdr = dns.RR_Header{
+			Name:   rrset[0].Header().Name,
+			Rrtype: dns.TypeRRSIG,
+			Class:  dns.ClassINET,
+			Ttl:    rrset[0].Header().Ttl,
+		}
+		if err := sig.Sign(signer, rrset); err != nil {
+			return nil, err
+		}
+		sigs = append(sigs, &sig)
+	}
+	return sigs, nil
+}
+
+func rrsOfType(rrs []dns.RR, rtype uint16) []dns.RR {
+	var out []dns.RR
+	for _, rr := range rrs {
+		if rr.Header().Rrtype == rtype {
+			out = append(out, rr)
+		}
+	}
+	return out
+}
+
+func hasSOA(rrs []dns.RR) bool {
+	for _, rr := range rrs {
+		if rr.Header().Rrtype == dns.TypeSOA {
+			return true
+		}
+	}
+	return false
+}
+
+// nsec3NameError returns the NSEC3 records proving that name does not exist.
+// Names are not enumerated, so the records are minimally covering "white
+// lies": the parent of name is claimed to be the closest encloser, and the
+// records covering name and the wildcard below the parent only cover their
+// own hashes.
+func nsec3NameError(zone, name string, ttl uint32) []dns.RR {
+	encloser := zone
+	if labels := dns.SplitDomainName(name); len(labels) > 1 && !strings.EqualFold(name, zone) {
+		if parent := dns.Fqdn(strings.Join(labels[1:], ".")); dns.IsSubDomain(zone, parent) {
+			encloser = parent
+		}
+	}
+
+	rrs := []dns.RR{
+		nsec3Matching(zone, encloser, nsec3TypesFor(zone, encloser, 0), ttl),
+		nsec3Covering(zone, name, ttl),
+	}
+	if wildcard := "*." + encloser; !strings.EqualFold(wildcard, name) {
+		rrs = append(rrs, nsec3Covering(zone, wildcard, ttl))
+	}
+	return rrs
+}
+
+// nsec3NoData returns the NSEC3 record proving that name has no record of
+// type qtype.
+func nsec3NoData(zone, name string, qtype uint16, ttl uint32) dns.RR {
+	return nsec3Matching(zone, name, nsec3TypesFor(zone, name, qtype), ttl)
+}
+
+// nsec3TypesFor returns the type bitmap of an NSEC3 record matching name.
+// The apex has a fixed set of types. Other names claim every type Consul
+// may answer with except the one queried, so a validator never wrongly
+// concludes that a record does not exist.
+func nsec3TypesFor(zone, name string, qtype uint16) []uint16 {
+	if strings.EqualFold(zone, name) {
+		return []uint16{dns.TypeNS, dns.TypeSOA, dns.TypeRRSIG, dns.TypeDNSKEY, dns.TypeNSEC3PARAM}
+	}
+	if qtype == 0 {
+		// An empty non-terminal.
+		return nil
+	}
+	var types []uint16
+	for _, t := range []uint16{dns.TypeA, dns.TypeTXT, dns.TypeAAAA, dns.TypeSRV, dns.TypeRRSIG, dns.TypeSVCB, dns.TypeHTTPS} {
+		if t != qtype {
+			types = append(types, t)
+		}
+	}
+	return types
+}
+
+func nsec3Matching(zone, name string, types []uint16, ttl uint32) dns.RR {
+	hash := nsec3Hash(name)
+	return newNSEC3(zone, hash, nsec3Add(hash, 1), types, ttl)
+}
+
+func nsec3Covering(zone, name string, ttl uint32) dns.RR {
+	hash := nsec3Hash(name)
+	return newNSEC3(zone, nsec3Add(hash, -1), nsec3Add(hash, 1), nil, ttl)
+}
+
+func newNSEC3(zone string, owner, next []byte, types []uint16, ttl uint32) dns.RR {
+	return &dns.NSEC3{
+		Hdr: dns.RR_Header{
+			Name:   strings.ToLower(base32.HexEncoding.EncodeToString(owner)) + "." + zone,
+			Rrtype: dns.TypeNSEC3,
+			Class:  dns.ClassINET,
+			Ttl:    ttl,
+		},
+		Hash:       dns.SHA1,
+		HashLength: uint8(len(next)),
+		NextDomain: base32.HexEncoding.EncodeToString(next),
+		TypeBitMap: types,
+	}
+}
+
+// nsec3Hash returns the NSEC3 hash of name, with no salt and no additional
+// iterations as recommended by RFC 9276.
+func nsec3Hash(name string) []byte {
+	hash, _ := base32.HexEncoding.DecodeString(dns.HashName(name, dns.SHA1, 0, ""))
+	return hash
+}
+
+// nsec3Add adds delta, which is 1 or -1, to a hash treated as a big-endian
+// number, wrapping around at the ends of the hash space.
+func nsec3Add(hash []byte, delta int) []byte {
+	out := make([]byte, len(hash))
+	copy(out, hash)
+	for i := len(out) - 1; i >= 0; i-- {
+		if delta > 0 {
+			out[i]++
+			if out[i] != 0 {
+				break
+			}
+		} else {
+			out[i]--
+			if out[i] != 0xff {
+				break
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package agent
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/sdk/testutil/retry"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+func TestDNS_DNSSEC(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := NewTestAgent(t, `
+		dns_config {
+			dnssec {
+				enabled = true
+			}
+		}
+	`)
+	defer a.Shutdown()
+	testrpc.WaitForLeader(t, a.RPC, "dc1")
+
+	args := &structs.RegisterRequest{
+		Datacenter: "dc1",
+		Node:       "foo",
+		Address:    "127.0.0.2",
+	}
+	var out struct{}
+	require.NoError(t, a.RPC(context.Background(), "Catalog.Register", args, &out))
+
+	// The leader generates the first key once it is established.
+	retry.Run(t, func(r *retry.R) {
+		req := structs.DCSpecificRequest{Datacenter: "dc1"}
+		var keys structs.IndexedDNSSECKeys
+		require.NoError(r, a.RPC(context.Background(), "Operator.DNSSECKeyringGet", &req, &keys))
+		require.Len(r, keys.Keys, 1)
+	})
+
+	query := func(t *testing.T, name string, qtype uint16, do bool) *dns.Msg {
+		t.Helper()
+		m := new(dns.Msg)
+		m.SetQuestion(name, qtype)
+		m.SetEdns0(4096, do)
+		in, _, err := new(dns.Client).Exchange(m, a.DNSAddr())
+		require.NoError(t, err)
+		return in
+	}
+
+	// The DNSKEY RRset at the apex is signed with the key itself.
+	in := query(t, "consul.", dns.TypeDNSKEY, true)
+	require.Equal(t, dns.RcodeSuccess, in.Rcode)
+	require.Len(t, in.Answer, 2)
+	dnskey, ok := in.Answer[0].(*dns.DNSKEY)
+	require.True(t, ok, "answer is not a DNSKEY record")
+	require.Equal(t, "consul.", dnskey.Hdr.Name)
+	sig, ok := in.Answer[1].(*dns.RRSIG)
+	require.True(t, ok, "answer is not an RRSIG record")
+	require.NoError(t, sig.Verify(dnskey, in.Answer[:1]))
+	require.True(t, sig.ValidityPeriod(time.Now()))
+
+	verify := func(t *testing.T, rrs []dns.RR) {
+		t.Helper()
+		var rrset []dns.RR
+		var sigs []*dns.RRSIG
+		for _, rr := range rrs {
+			if s, ok := rr.(*dns.RRSIG); ok {
+				sigs = append(sigs, s)
+			} else {
+				rrset = append(rrset, rr)
+			}
+		}
+		require.NotEmpty(t, sigs)
+		for _, s := range sigs {
+			var covered []dns.RR
+			for _, rr := range rrset {
+				if rr.Header().Rrtype == s.TypeCovered && rr.Header().Name == s.Hdr.Name {
+					covered = append(covered, rr)
+				}
+			}
+			require.NotEmpty(t, covered)
+			require.Equal(t, dnskey.KeyTag(), s.KeyTag)
+			require.NoError(t, s.Verify(dnskey, covered))
+		}
+	}
+
+	t.Run("signed answer", func(t *testing.T) {
+		in := query(t, "foo.node.consul.", dns.TypeA, true)
+		require.Equal(t, dns.RcodeSuccess, in.Rcode)
+		require.Len(t, in.Answer, 2)
+		require.True(t, in.IsEdns0().Do())
+		verify(t, in.Answer)
+	})
+
+	t.Run("unsigned without DO bit", func(t *testing.T) {
+		in := query(t, "foo.node.consul.", dns.TypeA, false)
+		require.Len(t, in.Answer, 1)
+		require.IsType(t, &dns.A{}, in.Answer[0])
+	})
+
+	t.Run("name error", func(t *testing.T) {
+		in := query(t, "nope.node.consul.", dns.TypeA, true)
+		require.Equal(t, dns.RcodeNameError, in.Rcode)
+
+		var nsec3 []dns.RR
+		for _, rr := range in.Ns {
+			if rr.Header().Rrtype == dns.TypeNSEC3 {
+				nsec3 = append(nsec3, rr)
+			}
+		}
+		require.Len(t, nsec3, 3)
+		for _, rr := range nsec3 {
+			require.True(t, rr.(*dns.NSEC3).Cover("nope.node.consul.") || rr.(*dns.NSEC3).Match("node.consul.") ||
+				rr.(*dns.NSEC3).Cover("*.node.consul."))
+		}
+		verify(t, in.Ns)
+	})
+
+	t.Run("no data", func(t *testing.T) {
+		in := query(t, "foo.node.consul.", dns.TypeMX, true)
+		require.Equal(t, dns.RcodeSuccess, in.Rcode)
+		require.Empty(t, in.Answer)
+
+		var found bool
+		for _, rr := range in.Ns {
+			if n, ok := rr.(*dns.NSEC3); ok {
+				require.True(t, n.Match("foo.node.consul."))
+				require.NotContains(t, n.TypeBitMap, dns.TypeMX)
+				found = true
+			}
+		}
+		require.True(t, found, "no NSEC3 record in %v", in)
+		verify(t, in.Ns)
+	})
+
+	t.Run("rollover", func(t *testing.T) {
+		req := structs.DNSSECKeyRequest{Datacenter: "dc1"}
+		var pending structs.DNSSECKey
+		require.NoError(t, a.RPC(context.Background(), "Operator.DNSSECKeyringRotate", &req, &pending))
+		require.False(t, pending.Active)
+		for _, srv := range a.dnsServers {
+			srv.dnssecKeyring.lock.Lock()
+			srv.dnssecKeyring.fetched = time.Time{}
+			srv.dnssecKeyring.lock.Unlock()
+		}
+
+		// The pending key is published and signs the DNSKEY RRset along
+		// with the active key, once the keys are refreshed in the background.
+		var dnskeys, sigs []dns.RR
+		retry.Run(t, func(r *retry.R) {
+			in := query(t, "consul.", dns.TypeDNSKEY, true)
+			require.Equal(r, dns.RcodeSuccess, in.Rcode)
+			dnskeys = rrsOfType(in.Answer, dns.TypeDNSKEY)
+			sigs = rrsOfType(in.Answer, dns.TypeRRSIG)
+			require.Len(r, dnskeys, 2)
+			require.Len(r, sigs, 2)
+		})
+		for _, rr := range dnskeys {
+			key := rr.(*dns.DNSKEY)
+			var verified bool
+			for _, sig := range sigs {
+				if sig.(*dns.RRSIG).KeyTag == key.KeyTag() {
+					require.NoError(t, sig.(*dns.RRSIG).Verify(key, dnskeys))
+					verified = true
+				}
+			}
+			require.True(t, verified, "DNSKEY RRset is not signed with key %d", key.KeyTag())
+		}
+
+		// Other answers are still signed with the active key only.
+		in := query(t, "foo.node.consul.", dns.TypeA, true)
+		require.Equal(t, dns.RcodeSuccess, in.Rcode)
+		require.Len(t, in.Answer, 2)
+		verify(t, in.Answer)
+	})
+}
@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package agent
+
+import (
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/miekg/dns"
+)
+
+const (
+	// dnsOverHTTPSPath is the path DNS over HTTPS queries are served on, as
+	// suggested by RFC 8484.
+	dnsOverHTTPSPath = "/dns-query"
+
+	// dnsOverHTTPSContentType is the media type of DNS over HTTPS requests
+	// and responses.
+	dnsOverHTTPSContentType = "application/dns-message"
+)
+
+// ServeHTTP answers DNS over HTTPS (RFC 8484) queries. Queries are passed to
+// the same handlers as the UDP and TCP listeners and are treated like TCP
+// queries, so responses are never truncated.
+func (d *DNSServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != dnsOverHTTPSPath {
+		http.NotFound(w, r)
+		return
+	}
+
+	var buf []byte
+	switch r.Method {
+	case http.MethodGet:
+		q := r.URL.Query().Get("dns")
+		if q == "" {
+			http.Error(w, "missing dns query parameter", http.StatusBadRequest)
+			return
+		}
+		var err error
+		buf, err = base64.RawURLEncoding.DecodeString(q)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid dns query parameter: %v", err), http.StatusBadRequest)
+			return
+		}
+
+	case http.MethodPost:
+		if ct := r.Header.Get("Content-Type"); !strings.EqualFold(ct, dnsOverHTTPSContentType) {
+			http.Error(w, fmt.Sprintf("unsupported content type %q", ct), http.StatusUnsupportedMediaType)
+			return
+		}
+		var err error
+		buf, err = io.ReadAll(io.LimitReader(r.Body, dns.MaxMsgSize+1))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to read request: %v", err), http.StatusBadRequest)
+			return
+		}
+		if len(buf) > dns.MaxMsgSize {
+			http.Error(w, "request too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	req := new(dns.Msg)
+	if err := req.Unpack(buf); err != nil {
+		http.Error(w, fmt.Sprintf("invalid dns message: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	// Zone transfers span several messages which a single HTTP response
+	// cannot carry.
+	if len(req.Question) > 0 && (req.Question[0].Qtype == dns.TypeAXFR || req.Question[0].Qtype == dns.TypeIXFR) {
+		m := new(dns.Msg)
+		m.SetRcode(req, dns.RcodeNotImplemented)
+		d.writeDNSOverHTTPSMsg(w, m)
+		return
+	}
+
+	rw := &dohResponseWriter{
+		localAddr:  tcpAddrFromString(r.Context().Value(http.LocalAddrContextKey)),
+		remoteAddr: tcpAddrFromString(r.RemoteAddr),
+		tlsState:   r.TLS,
+	}
+	d.ServeDNS(rw, req)
+	if rw.msg == nil {
+		http.Error(w, "no response", http.StatusInternalServerError)
+		return
+	}
+
+	d.writeDNSOverHTTPSMsg(w, rw.msg)
+}
+
+// writeDNSOverHTTPSMsg writes a DNS response to an HTTP response.
+func (d *DNSServer) writeDNSOverHTTPSMsg(w http.ResponseWriter, m *dns.Msg) {
+	out, err := m.Pack()
+	if err != nil {
+		d.logger.Error("error packing message", "error", err)
+		http.Error(w, "failed to pack response", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", dnsOverHTTPSContentType)
+	if ttl, ok := dohCacheTTL(m); ok {
+		w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", ttl))
+	}
+	if _, err := w.Write(out); err != nil {
+		d.logger.Warn("failed to respond", "error", err)
+	}
+}
+
+// dohCacheTTL returns the freshness lifetime of a response as required by
+// RFC 8484 section 5.1, which is the smallest TTL of its records.
+func dohCacheTTL(m *dns.Msg) (uint32, bool) {
+	var ttl uint32
+	found := false
+	for _, section := range [][]dns.RR{m.Answer, m.Ns, m.Extra} {
+		for _, rr := range section {
+			if rr.Header().Rrtype == dns.TypeOPT {
+				continue
+			}
+			if !found || rr.Header().Ttl < ttl {
+				ttl = rr.Header().Ttl
+				found = true
+			}
+		}
+	}
+	return ttl, found
+}
+
+// tcpAddrFromString returns the TCP address of a connection. DNS over HTTPS
+// queries are presented to the DNS handlers as TCP queries. Addresses which
+// cannot be parsed, e.g. of UNIX sockets, are returned as an empty address.
+func tcpAddrFromString(v interface{}) *net.TCPAddr {
+	var s string
+	switch x := v.(type) {
+	case string:
+		s = x
+	case net.Addr:
+		s = x.String()
+	}
+	addr, err := net.ResolveTCPAddr("tcp", s)
+	if err != nil {
+		return &net.TCPAddr{}
+	}
+	return addr
+}
+
+// dohResponseWriter captures the response to a DNS over HTTPS query.
+type dohResponseWriter struct {
+	localAddr  net.Addr
+	remoteAddr net.Addr
+	tlsState   *tls.ConnectionState
+	msg        *dns.Msg
+}
+
+// ConnectionState returns the TLS state of the HTTPS request, or nil for
+// HTTP requests.
+func (w *dohResponseWriter) ConnectionState() *tls.ConnectionState {
+	return w.tlsState
+}
+
+// LocalAddr returns the net.Addr of the server
+func (w *dohResponseWriter) LocalAddr() net.Addr {
+	return w.localAddr
+}
+
+// RemoteAddr returns the net.Addr of the client that sent the current request.
+func (w *dohResponseWriter) RemoteAddr() net.Addr {
+	return w.remoteAddr
+}
+
+// WriteMsg captures the reply for the client. Only the first message is kept
+// since a DNS over HTTPS response carries a single message.
+func (w *dohResponseWriter) WriteMsg(m *dns.Msg) error {
+	if w.msg != nil {
+		return fmt.Errorf("response already written")
+	}
+	w.msg = m
+	return nil
+}
+
+// Write captures a raw reply for the client.
+func (w *dohResponseWriter) Write(b []byte) (int, error) {
+	m := new(dns.Msg)
+	if err := m.Unpack(b); err != nil {
+		return 0, err
+	}
+	if err := w.WriteMsg(m); err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
+
+// Close closes the connection.
+func (w *dohResponseWriter) Close() error {
+	// There's nothing for us to do here as we don't handle the connection.
+	return nil
+}
+
+// TsigStatus returns the status of the Tsig. TSIG signatures are not
+// verified for DNS over HTTPS queries, so signed queries are never trusted.
+func (w *dohResponseWriter) TsigStatus() error {
+	return errors.New("TSIG is not supported for DNS over HTTPS")
+}
+
+// TsigTimersOnly sets the tsig timers only boolean.
+func (w *dohResponseWriter) TsigTimersOnly(bool) {}
+
+// Hijack lets the caller take over the connection.
+func (w *dohResponseWriter) Hijack() {}
@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package agent
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/sdk/freeport"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+func startDNSOverTLSAgent(t *testing.T) (*TestAgent, string, string) {
+	t.Helper()
+
+	ports := freeport.GetN(t, 2)
+	a := NewTestAgent(t, fmt.Sprintf(`
+		ports {
+			dns_tls = %d
+			dns_https = %d
+		}
+		tls {
+			https {
+				cert_file = "../test/hostname/Alice.crt"
+				key_file = "../test/hostname/Alice.key"
+			}
+		}
+	`, ports[0], ports[1]))
+	testrpc.WaitForLeader(t, a.RPC, "dc1")
+
+	args := &structs.RegisterRequest{
+		Datacenter: "dc1",
+		Node:       "foo",
+		Address:    "127.0.0.1",
+	}
+	var out struct{}
+	require.NoError(t, a.RPC(context.Background(), "Catalog.Register", args, &out))
+
+	return a, fmt.Sprintf("127.0.0.1:%d", ports[0]), fmt.Sprintf("127.0.0.1:%d", ports[1])
+}
+
+func TestDNS_OverTLS(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a, dotAddr, _ := startDNSOverTLSAgent(t)
+	defer a.Shutdown()
+
+	m := new(dns.Msg)
+	m.SetQuestion("foo.node.consul.", dns.TypeA)
+
+	c := &dns.Client{Net: "tcp-tls", TLSConfig: &tls.Config{InsecureSkipVerify: true}}
+	in, _, err := c.Exchange(m, dotAddr)
+	require.NoError(t, err)
+	require.Len(t, in.Answer, 1)
+	aRec, ok := in.Answer[0].(*dns.A)
+	require.True(t, ok, "answer is not an A record")
+	require.Equal(t, "127.0.0.1", aRec.A.String())
+
+	// Plaintext connections are not served.
+	c = &dns.Client{Net: "tcp"}
+	_, _, err = c.Exchange(m, dotAddr)
+	require.Error(t, err)
+}
+
+func TestDNS_OverHTTPS(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a, _, dohAddr := startDNSOverTLSAgent(t)
+	defer a.Shutdown()
+
+	client := &http.Client{
+		Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}},
+	}
+	url := "https://" + dohAddr + dnsOverHTTPSPath
+
+	m := new(dns.Msg)
+	m.SetQuestion("foo.node.consul.", dns.TypeA)
+	m.Id = 0
+	buf, err := m.Pack()
+	require.NoError(t, err)
+
+	requireAnswer := func(t *testing.T, resp *http.Response) {
+		t.Helper()
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, dnsOverHTTPSContentType, resp.Header.Get("Content-Type"))
+		require.Equal(t, "max-age=0", resp.Header.Get("Cache-Control"))
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		in := new(dns.Msg)
+		require.NoError(t, in.Unpack(body))
+		require.Len(t, in.Answer, 1)
+		aRec, ok := in.Answer[0].(*dns.A)
+		require.True(t, ok, "answer is not an A record")
+		require.Equal(t, "127.0.0.1", aRec.A.String())
+	}
+
+	t.Run("GET", func(t *testing.T) {
+		resp, err := client.Get(url + "?dns=" + base64.RawURLEncoding.EncodeToString(buf))
+		require.NoError(t, err)
+		requireAnswer(t, resp)
+	})
+
+	t.Run("POST", func(t *testing.T) {
+		resp, err := client.Post(url, dnsOverHTTPSContentType, bytes.NewReader(buf))
+		require.NoError(t, err)
+		requireAnswer(t, resp)
+	})
+
+	t.Run("wrong content type", func(t *testing.T) {
+		resp, err := client.Post(url, "application/json", bytes.NewReader(buf))
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusUnsupportedMediaType, resp.StatusCode)
+	})
+
+	t.Run("invalid message", func(t *testing.T) {
+		resp, err := client.Get(url + "?dns=AAAA")
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("unknown path", func(t *testing.T) {
+		resp, err := client.Get("https://" + dohAddr + "/v1/agent/self")
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
@@ -24,7 +24,7 @@ func getEnterpriseDNSConfig(conf *config.RuntimeConfig) enterpriseDNSConfig {
 // we parse a "peerOrDatacenter". The caller or RPC handler are responsible for disambiguating.
 func (d *DNSServer) parseLocality(labels []string, cfg *dnsConfig) (queryLocality, bool) {
 	locality := queryLocality{
-		EnterpriseMeta: d.defaultEnterpriseMeta,
+		EnterpriseMeta: *d.enterpriseMeta(cfg),
 	}
 
 	switch len(labels) {
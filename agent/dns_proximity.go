@@ -0,0 +1,241 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package agent
+
+import (
+	"context"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/lib"
+)
+
+const (
+	// dnsProximityModeSort orders service answers by their distance to the
+	// client.
+	dnsProximityModeSort = "sort"
+
+	// dnsProximityModeRestrict only answers with the nearest instances.
+	dnsProximityModeRestrict = "restrict"
+
+	// dnsProximityRefreshInterval is how long the node addresses and
+	// coordinates used to sort answers are used before they are fetched
+	// again.
+	dnsProximityRefreshInterval = 30 * time.Second
+)
+
+// dnsProximity caches the addresses and network coordinates of the nodes in
+// the local datacenter, so that answers can be sorted without querying the
+// servers for every lookup.
+type dnsProximity struct {
+	lock    sync.Mutex
+	fetched time.Time
+
+	// index is replaced as a whole by each successful refresh and is not
+	// modified afterwards, so it can be used without holding lock.
+	index *dnsProximityIndex
+
+	// refreshing is closed once the running refresh finishes. It is nil when
+	// no refresh is running.
+	refreshing chan struct{}
+}
+
+// dnsProximityIndex is the node data answers are sorted by.
+type dnsProximityIndex struct {
+	// coords are the coordinates of each node, by lower case node name.
+	coords map[string]lib.CoordinateSet
+
+	// addrs maps node addresses to node names. Addresses shared by several
+	// nodes map to the first node in name order.
+	addrs map[string]string
+
+	// nodes are the addresses of the nodes, for matching client subnets.
+	nodes []dnsProximityNode
+}
+
+type dnsProximityNode struct {
+	name string
+	ip   net.IP
+}
+
+// proximityIndex returns the node coordinates and addresses visible to the
+// request's view. When they are stale, they are refreshed from the servers in
+// the background and the previous index keeps being used until the refresh
+// succeeds. Only the first fetch is waited for. It returns nil if there is no
+// index yet.
+func (d *DNSServer) proximityIndex(cfg *dnsConfig) *dnsProximityIndex {
+	var view string
+	if cfg.view != nil {
+		view = cfg.view.Name
+	}
+	d.proximityLock.Lock()
+	p, ok := d.proximity[view]
+	if !ok {
+		p = &dnsProximity{}
+		d.proximity[view] = p
+	}
+	d.proximityLock.Unlock()
+
+	p.lock.Lock()
+	if !p.fetched.IsZero() && time.Since(p.fetched) < dnsProximityRefreshInterval {
+		index := p.index
+		p.lock.Unlock()
+		return index
+	}
+	if p.refreshing == nil {
+		// Fetch at most once per interval, even if the fetch fails.
+		p.fetched = time.Now()
+		p.refreshing = make(chan struct{})
+		go d.refreshProximityIndex(cfg, p, p.refreshing)
+	}
+	if p.index != nil {
+		index := p.index
+		p.lock.Unlock()
+		return index
+	}
+	refreshing := p.refreshing
+	p.lock.Unlock()
+
+	<-refreshing
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.index
+}
+
+// refreshProximityIndex fetches the node coordinates and addresses from the
+// servers and replaces the index of p with them. It closes done once it is
+// finished.
+func (d *DNSServer) refreshProximityIndex(cfg *dnsConfig, p *dnsProximity, done chan struct{}) {
+	index := d.fetchProximityIndex(cfg)
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	defer close(done)
+	p.refreshing = nil
+	if index != nil {
+		p.index = index
+	}
+}
+
+// fetchProximityIndex fetches the node coordinates and addresses from the
+// servers. It returns nil if they cannot be fetched.
+func (d *DNSServer) fetchProximityIndex(cfg *dnsConfig) *dnsProximityIndex {
+	args := structs.DCSpecificRequest{
+		Datacenter: d.agent.config.Datacenter,
+		QueryOptions: structs.QueryOptions{
+			Token:      d.token(cfg),
+			AllowStale: cfg.AllowStale,
+		},
+		EnterpriseMeta: *d.enterpriseMeta(cfg),
+	}
+	var coords structs.IndexedCoordinates
+	if err := d.agent.RPC(context.Background(), "Coordinate.ListNodes", &args, &coords); err != nil {
+		d.logger.Warn("failed to fetch node coordinates, answers are not sorted by proximity", "error", err)
+		return nil
+	}
+	var nodes structs.IndexedNodes
+	if err := d.agent.RPC(context.Background(), "Catalog.ListNodes", &args, &nodes); err != nil {
+		d.logger.Warn("failed to fetch nodes, answers are not sorted by proximity", "error", err)
+		return nil
+	}
+
+	index := &dnsProximityIndex{
+		coords: make(map[string]lib.CoordinateSet, len(coords.Coordinates)),
+	}
+	for _, c := range coords.Coordinates {
+		name := strings.ToLower(c.Node)
+		if index.coords[name] == nil {
+			index.coords[name] = lib.CoordinateSet{}
+		}
+		index.coords[name][c.Segment] = c.Coord
+	}
+
+	// The catalog returns the nodes sorted by name.
+	index.addrs = make(map[string]string, len(nodes.Nodes))
+	index.nodes = make([]dnsProximityNode, 0, len(nodes.Nodes))
+	for _, n := range nodes.Nodes {
+		name := strings.ToLower(n.Node)
+		for _, addr := range []string{n.Address, n.TaggedAddresses[structs.TaggedAddressLANIPv4], n.TaggedAddresses[structs.TaggedAddressLANIPv6]} {
+			ip := net.ParseIP(addr)
+			if ip == nil {
+				continue
+			}
+			if _, ok := index.addrs[ip.String()]; !ok {
+				index.addrs[ip.String()] = name
+			}
+			index.nodes = append(index.nodes, dnsProximityNode{name: name, ip: ip})
+		}
+	}
+	return index
+}
+
+// sourceNode returns the coordinates of the node the client runs on. The
+// node is found by the client address, or for an EDNS Client Subnet which
+// doesn't carry the full address, by any node within the subnet.
+func (p *dnsProximityIndex) sourceNode(req *dns.Msg, remoteAddr net.Addr) lib.CoordinateSet {
+	ip := sourceIPForRequest(req, remoteAddr)
+	if ip == nil {
+		return nil
+	}
+	if name, ok := p.addrs[ip.String()]; ok {
+		return p.coords[name]
+	}
+
+	subnet := ednsSubnetForRequest(req)
+	if subnet == nil || subnet.SourceNetmask == 0 {
+		return nil
+	}
+	bits := 8 * net.IPv4len
+	if subnet.Family == 2 {
+		bits = 8 * net.IPv6len
+	}
+	network := &net.IPNet{IP: ip, Mask: net.CIDRMask(int(subnet.SourceNetmask), bits)}
+	for _, n := range p.nodes {
+		if network.Contains(n.ip) {
+			if cs, ok := p.coords[n.name]; ok {
+				return cs
+			}
+		}
+	}
+	return nil
+}
+
+// sortByProximity orders nodes by their estimated round trip time to the
+// client, placing nodes without coordinates last. In restrict mode only the
+// nearest nodes are returned. Nodes are returned unchanged when the client
+// cannot be mapped to a node with a coordinate.
+func (d *DNSServer) sortByProximity(cfg *dnsConfig, req *dns.Msg, remoteAddr net.Addr, nodes structs.CheckServiceNodes) structs.CheckServiceNodes {
+	p := d.proximityIndex(cfg)
+	if p == nil {
+		return nodes
+	}
+
+	source := p.sourceNode(req, remoteAddr)
+	if source == nil {
+		return nodes
+	}
+
+	dist := make(map[string]float64, len(nodes))
+	for _, n := range nodes {
+		name := strings.ToLower(n.Node.Node)
+		if _, ok := dist[name]; !ok {
+			dist[name] = lib.ComputeDistance(source.Intersect(p.coords[name]))
+		}
+	}
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return dist[strings.ToLower(nodes[i].Node.Node)] < dist[strings.ToLower(nodes[j].Node.Node)]
+	})
+
+	if cfg.ProximityMode == dnsProximityModeRestrict && len(nodes) > cfg.ProximityLimit {
+		nodes = nodes[:cfg.ProximityLimit]
+	}
+	return nodes
+}
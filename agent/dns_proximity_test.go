@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package agent
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/lib"
+	"github.com/hashicorp/consul/sdk/testutil/retry"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+func TestDNS_ServiceLookup_Proximity(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := NewTestAgent(t, `
+		dns_config {
+			proximity {
+				mode = "sort"
+			}
+		}
+	`)
+	defer a.Shutdown()
+	testrpc.WaitForLeader(t, a.RPC, "dc1")
+
+	// The client runs on a node at the origin, the web instances are at
+	// increasing distances from it.
+	nodes := map[string]struct {
+		address string
+		rtt     time.Duration
+		service bool
+	}{
+		"client": {"10.1.0.5", 0, false},
+		"near":   {"10.2.0.1", 5 * time.Millisecond, true},
+		"middle": {"10.3.0.1", 20 * time.Millisecond, true},
+		"far":    {"10.4.0.1", 80 * time.Millisecond, true},
+	}
+	for name, n := range nodes {
+		args := &structs.RegisterRequest{
+			Datacenter: "dc1",
+			Node:       name,
+			Address:    n.address,
+		}
+		if n.service {
+			args.Service = &structs.NodeService{Service: "web", Port: 80}
+		}
+		var out struct{}
+		require.NoError(t, a.RPC(context.Background(), "Catalog.Register", args, &out))
+
+		update := &structs.CoordinateUpdateRequest{
+			Datacenter: "dc1",
+			Node:       name,
+			Coord:      lib.GenerateCoordinate(n.rtt),
+		}
+		require.NoError(t, a.RPC(context.Background(), "Coordinate.Update", update, &out))
+	}
+	retry.Run(t, func(r *retry.R) {
+		args := structs.DCSpecificRequest{Datacenter: "dc1"}
+		var out structs.IndexedCoordinates
+		require.NoError(r, a.RPC(context.Background(), "Coordinate.ListNodes", &args, &out))
+		require.GreaterOrEqual(r, len(out.Coordinates), len(nodes))
+	})
+
+	query := func(t *testing.T, clientSubnet string) []string {
+		t.Helper()
+		m := new(dns.Msg)
+		m.SetQuestion("web.service.consul.", dns.TypeA)
+		if clientSubnet != "" {
+			_, network, err := net.ParseCIDR(clientSubnet)
+			require.NoError(t, err)
+			ones, _ := network.Mask.Size()
+			m.SetEdns0(4096, false)
+			m.IsEdns0().Option = append(m.IsEdns0().Option, &dns.EDNS0_SUBNET{
+				Code:          dns.EDNS0SUBNET,
+				Family:        1,
+				SourceNetmask: uint8(ones),
+				Address:       network.IP,
+			})
+		}
+		in, _, err := new(dns.Client).Exchange(m, a.DNSAddr())
+		require.NoError(t, err)
+
+		var ips []string
+		for _, rr := range in.Answer {
+			ips = append(ips, rr.(*dns.A).A.String())
+		}
+		return ips
+	}
+
+	t.Run("client address", func(t *testing.T) {
+		for i := 0; i < 5; i++ {
+			require.Equal(t, []string{"10.2.0.1", "10.3.0.1", "10.4.0.1"}, query(t, "10.1.0.5/32"))
+		}
+	})
+
+	t.Run("client subnet", func(t *testing.T) {
+		require.Equal(t, []string{"10.2.0.1", "10.3.0.1", "10.4.0.1"}, query(t, "10.1.0.0/24"))
+	})
+
+	t.Run("unknown client", func(t *testing.T) {
+		require.ElementsMatch(t, []string{"10.2.0.1", "10.3.0.1", "10.4.0.1"}, query(t, "192.168.0.0/24"))
+	})
+
+	t.Run("restrict", func(t *testing.T) {
+		cfg := *a.Config
+		cfg.DNSProximityMode = "restrict"
+		cfg.DNSProximityLimit = 2
+		require.NoError(t, a.reloadConfigInternal(&cfg))
+
+		require.Equal(t, []string{"10.2.0.1", "10.3.0.1"}, query(t, "10.1.0.5/32"))
+		// Answers are not restricted when the client is unknown.
+		require.Len(t, query(t, "192.168.0.0/24"), 3)
+	})
+}
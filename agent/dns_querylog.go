@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package agent
+
+import (
+	"crypto/tls"
+	"math/rand"
+	"net"
+	"time"
+
+	"github.com/miekg/dns"
+
+	"github.com/hashicorp/consul/agent/dns/dnstap"
+	"github.com/hashicorp/consul/logging"
+)
+
+const (
+	// dnsQueryLogModeDnstap writes queries and responses to a dnstap sink.
+	dnsQueryLogModeDnstap = "dnstap"
+
+	// dnsQueryLogModeLog writes a line per query to the agent log.
+	dnsQueryLogModeLog = "log"
+)
+
+// startDNSQueryLog starts the dnstap sink DNS queries are written to when
+// enabled. The destination of the sink is not reloadable.
+func (a *Agent) startDNSQueryLog() error {
+	if a.config.DNSQueryLogMode != dnsQueryLogModeDnstap {
+		return nil
+	}
+	sink, err := dnstap.NewSink(dnstap.Config{
+		SocketPath: a.config.DNSQueryLogDnstapSocket,
+		FilePath:   a.config.DNSQueryLogDnstapFile,
+		Identity:   a.config.NodeName,
+		Version:    "consul " + a.config.VersionWithMetadata(),
+		Logger:     a.logger.Named(logging.DNS),
+	})
+	if err != nil {
+		return err
+	}
+	a.dnstap = sink
+	return nil
+}
+
+// ServeDNS serves a DNS query from any of the listeners of the server,
+// logging it and its response when query logging is enabled.
+func (d *DNSServer) ServeDNS(w dns.ResponseWriter, req *dns.Msg) {
+	cfg := d.config.Load().(*dnsConfig)
+	if !d.shouldLogQuery(cfg, req) {
+		d.mux.ServeDNS(w, req)
+		return
+	}
+
+	lw := &queryLogResponseWriter{
+		ResponseWriter: w,
+		server:         d,
+		cfg:            cfg,
+		req:            req,
+		start:          time.Now(),
+	}
+	if cfg.QueryLogMode == dnsQueryLogModeDnstap {
+		d.logDnstap(w, dnstap.ClientQuery, req, lw.start)
+	}
+	d.mux.ServeDNS(lw, req)
+}
+
+// shouldLogQuery returns whether the query is sampled and of a logged type.
+func (d *DNSServer) shouldLogQuery(cfg *dnsConfig, req *dns.Msg) bool {
+	switch cfg.QueryLogMode {
+	case dnsQueryLogModeLog:
+	case dnsQueryLogModeDnstap:
+		if d.agent.dnstap == nil {
+			// dnstap was enabled by a config reload, which requires a
+			// restart to take effect.
+			return false
+		}
+	default:
+		return false
+	}
+	if len(req.Question) == 0 {
+		return false
+	}
+	if len(cfg.QueryLogQueryTypes) > 0 && !cfg.QueryLogQueryTypes[req.Question[0].Qtype] {
+		return false
+	}
+	return cfg.QueryLogSampleRate >= 1 || rand.Float64() < cfg.QueryLogSampleRate
+}
+
+// logDnstap writes a query or response to the dnstap sink.
+func (d *DNSServer) logDnstap(w dns.ResponseWriter, typ dnstap.MessageType, m *dns.Msg, t time.Time) {
+	wire, err := m.Pack()
+	if err != nil {
+		d.logger.Debug("failed to pack DNS message for dnstap", "error", err)
+		return
+	}
+	msg := &dnstap.Message{
+		Type:     typ,
+		Protocol: d.queryLogProtocol(w),
+	}
+	msg.QueryAddress, msg.QueryPort = addrIPPort(w.RemoteAddr())
+	msg.ResponseAddress, msg.ResponsePort = addrIPPort(w.LocalAddr())
+	if typ == dnstap.ClientQuery {
+		msg.QueryTime = t
+		msg.QueryMessage = wire
+	} else {
+		msg.ResponseTime = t
+		msg.ResponseMessage = wire
+	}
+	d.agent.dnstap.Log(msg)
+}
+
+// queryLogProtocol returns the transport a query was received with.
+func (d *DNSServer) queryLogProtocol(w dns.ResponseWriter) dnstap.SocketProtocol {
+	if _, ok := w.(*dohResponseWriter); ok {
+		return dnstap.ProtocolDOH
+	}
+	if d.Server != nil && d.Server.Net == "tcp-tls" {
+		return dnstap.ProtocolDOT
+	}
+	if _, ok := w.RemoteAddr().(*net.TCPAddr); ok {
+		return dnstap.ProtocolTCP
+	}
+	return dnstap.ProtocolUDP
+}
+
+func addrIPPort(addr net.Addr) (net.IP, uint16) {
+	switch a := addr.(type) {
+	case *net.UDPAddr:
+		return a.IP, uint16(a.Port)
+	case *net.TCPAddr:
+		return a.IP, uint16(a.Port)
+	}
+	return nil, 0
+}
+
+// queryLogResponseWriter logs the responses written for a query.
+type queryLogResponseWriter struct {
+	dns.ResponseWriter
+	server *DNSServer
+	cfg    *dnsConfig
+	req    *dns.Msg
+	start  time.Time
+}
+
+// ConnectionState returns the TLS state of DNS over TLS and HTTPS queries, so
+// the views of the client can be matched.
+func (w *queryLogResponseWriter) ConnectionState() *tls.ConnectionState {
+	if cs, ok := w.ResponseWriter.(dns.ConnectionStater); ok {
+		return cs.ConnectionState()
+	}
+	return nil
+}
+
+func (w *queryLogResponseWriter) WriteMsg(m *dns.Msg) error {
+	err := w.ResponseWriter.WriteMsg(m)
+
+	d := w.server
+	if w.cfg.QueryLogMode == dnsQueryLogModeDnstap {
+		d.logDnstap(w.ResponseWriter, dnstap.ClientResponse, m, time.Now())
+		return err
+	}
+
+	q := w.req.Question[0]
+	d.logger.Info("query",
+		"name", q.Name,
+		"type", dns.Type(q.Qtype),
+		"class", dns.Class(q.Qclass),
+		"rcode", dns.RcodeToString[m.Rcode],
+		"answers", len(m.Answer),
+		"truncated", m.Truncated,
+		"latency", time.Since(w.start).String(),
+		"client", w.RemoteAddr().String(),
+		"client_network", w.RemoteAddr().Network(),
+	)
+	return err
+}
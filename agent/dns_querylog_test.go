@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package agent
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/hashicorp/consul/agent/config"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+// dnstapResponses returns the DNS responses logged in a dnstap file.
+func dnstapResponses(t *testing.T, path string) []*dns.Msg {
+	t.Helper()
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	r := bytes.NewReader(b)
+
+	// nested returns the bytes field num of a protobuf message.
+	nested := func(b []byte, num protowire.Number) []byte {
+		for len(b) > 0 {
+			n, typ, l := protowire.ConsumeTag(b)
+			require.GreaterOrEqual(t, l, 0)
+			b = b[l:]
+			l = protowire.ConsumeFieldValue(n, typ, b)
+			require.GreaterOrEqual(t, l, 0)
+			if n == num && typ == protowire.BytesType {
+				v, _ := protowire.ConsumeBytes(b)
+				return v
+			}
+			b = b[l:]
+		}
+		return nil
+	}
+
+	var msgs []*dns.Msg
+	for r.Len() > 0 {
+		var length uint32
+		require.NoError(t, binary.Read(r, binary.BigEndian, &length))
+		if length == 0 {
+			// Skip control frames.
+			require.NoError(t, binary.Read(r, binary.BigEndian, &length))
+			_, err := io.CopyN(io.Discard, r, int64(length))
+			require.NoError(t, err)
+			continue
+		}
+		frame := make([]byte, length)
+		_, err := io.ReadFull(r, frame)
+		require.NoError(t, err)
+
+		// Dnstap.message (14) holds the Message, whose response_message
+		// (14) holds the response.
+		wire := nested(nested(frame, 14), 14)
+		if wire == nil {
+			continue
+		}
+		m := new(dns.Msg)
+		require.NoError(t, m.Unpack(wire))
+		msgs = append(msgs, m)
+	}
+	return msgs
+}
+
+func TestDNS_QueryLog_Dnstap(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "dnstap.fstrm")
+	a := NewTestAgent(t, fmt.Sprintf(`
+		dns_config {
+			query_log {
+				mode = "dnstap"
+				dnstap_file = %q
+				query_types = ["SRV"]
+			}
+		}
+	`, path))
+	testrpc.WaitForLeader(t, a.RPC, "dc1")
+
+	for _, qtype := range []uint16{dns.TypeSRV, dns.TypeA, dns.TypeSRV} {
+		m := new(dns.Msg)
+		m.SetQuestion("consul.service.consul.", qtype)
+		_, _, err := new(dns.Client).Exchange(m, a.DNSAddr())
+		require.NoError(t, err)
+	}
+
+	// Stopping the agent flushes the sink.
+	a.Shutdown()
+
+	responses := dnstapResponses(t, path)
+	require.Len(t, responses, 2)
+	for _, m := range responses {
+		require.Equal(t, dns.TypeSRV, m.Question[0].Qtype)
+		require.Equal(t, "consul.service.consul.", m.Question[0].Name)
+		require.NotEmpty(t, m.Answer)
+	}
+}
+
+func TestDNSServer_shouldLogQuery(t *testing.T) {
+	d := &DNSServer{agent: &Agent{}}
+	srv := new(dns.Msg).SetQuestion("web.service.consul.", dns.TypeSRV)
+	a := new(dns.Msg).SetQuestion("web.service.consul.", dns.TypeA)
+
+	cfg := &dnsConfig{}
+	require.False(t, d.shouldLogQuery(cfg, srv))
+
+	cfg = &dnsConfig{QueryLogMode: dnsQueryLogModeLog, QueryLogSampleRate: 1}
+	require.True(t, d.shouldLogQuery(cfg, srv))
+	require.True(t, d.shouldLogQuery(cfg, a))
+
+	cfg.QueryLogQueryTypes = map[uint16]bool{dns.TypeSRV: true}
+	require.True(t, d.shouldLogQuery(cfg, srv))
+	require.False(t, d.shouldLogQuery(cfg, a))
+
+	// The dnstap sink is only started with the agent.
+	cfg = &dnsConfig{QueryLogMode: dnsQueryLogModeDnstap, QueryLogSampleRate: 1}
+	require.False(t, d.shouldLogQuery(cfg, srv))
+
+	rt := &config.RuntimeConfig{DNSQueryLogMode: "log", DNSQueryLogSampleRate: 0.5, DNSQueryLogQueryTypes: []string{"AAAA"}}
+	cfg, err := GetDNSConfig(rt)
+	require.NoError(t, err)
+	require.Equal(t, map[uint16]bool{dns.TypeAAAA: true}, cfg.QueryLogQueryTypes)
+	require.Equal(t, 0.5, cfg.QueryLogSampleRate)
+}
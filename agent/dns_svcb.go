@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package agent
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+
+	cachetype "github.com/hashicorp/consul/agent/cache-types"
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// serviceSVCBRecords is used to add the SVCB or HTTPS records for a service
+// lookup. Each instance is answered with a record carrying its port, the
+// ALPN of the service protocol and its addresses as hints. Instances with
+// higher weights are given lower, more preferred, priorities.
+func (d *DNSServer) serviceSVCBRecords(cfg *dnsConfig, lookup serviceLookup, nodes structs.CheckServiceNodes, req, resp *dns.Msg, ttl time.Duration, maxRecursionLevel int) {
+	q := req.Question[0]
+
+	// Build the records from the SRV records of the instances, which have
+	// the targets and ports, and their additional address records.
+	srvReq := req.Copy()
+	srvReq.Question[0].Qtype = dns.TypeSRV
+	srvResp := new(dns.Msg)
+	d.serviceSRVRecords(cfg, lookup, nodes, srvReq, srvResp, ttl, maxRecursionLevel)
+
+	alpn := d.serviceALPN(cfg, lookup)
+	priorities := svcbPriorities(srvResp.Answer)
+
+	for _, rr := range srvResp.Answer {
+		srv, ok := rr.(*dns.SRV)
+		if !ok {
+			continue
+		}
+
+		var values []dns.SVCBKeyValue
+		if len(alpn) > 0 {
+			values = append(values, &dns.SVCBAlpn{Alpn: alpn})
+		}
+		values = append(values, &dns.SVCBPort{Port: srv.Port})
+
+		var v4, v6 []net.IP
+		for _, extra := range srvResp.Extra {
+			if !strings.EqualFold(extra.Header().Name, srv.Target) {
+				continue
+			}
+			switch x := extra.(type) {
+			case *dns.A:
+				v4 = append(v4, x.A)
+			case *dns.AAAA:
+				v6 = append(v6, x.AAAA)
+			}
+		}
+		if len(v4) > 0 {
+			values = append(values, &dns.SVCBIPv4Hint{Hint: v4})
+		}
+		if len(v6) > 0 {
+			values = append(values, &dns.SVCBIPv6Hint{Hint: v6})
+		}
+
+		svcb := dns.SVCB{
+			Hdr: dns.RR_Header{
+				Name:   q.Name,
+				Rrtype: q.Qtype,
+				Class:  dns.ClassINET,
+				Ttl:    srv.Hdr.Ttl,
+			},
+			Priority: priorities[srv.Weight],
+			Target:   srv.Target,
+			Value:    values,
+		}
+		if q.Qtype == dns.TypeHTTPS {
+			resp.Answer = append(resp.Answer, &dns.HTTPS{SVCB: svcb})
+		} else {
+			resp.Answer = append(resp.Answer, &svcb)
+		}
+	}
+	resp.Extra = append(resp.Extra, srvResp.Extra...)
+}
+
+// svcbPriorities maps the weights of the SRV records to SVCB priorities.
+// SVCB clients only use records of the lowest priority while they work, so
+// the highest weight is given priority 1, the next highest 2, and so on.
+func svcbPriorities(answers []dns.RR) map[uint16]uint16 {
+	var weights []uint16
+	seen := make(map[uint16]bool)
+	for _, rr := range answers {
+		if srv, ok := rr.(*dns.SRV); ok && !seen[srv.Weight] {
+			seen[srv.Weight] = true
+			weights = append(weights, srv.Weight)
+		}
+	}
+	sort.Slice(weights, func(i, j int) bool { return weights[i] > weights[j] })
+
+	priorities := make(map[uint16]uint16, len(weights))
+	for i, w := range weights {
+		priorities[w] = uint16(i + 1)
+	}
+	return priorities
+}
+
+// serviceALPN returns the ALPN protocol IDs of the protocol configured for a
+// service in its service-defaults or the proxy-defaults. Protocols without an
+// ALPN protocol ID, such as tcp, return nothing. The configuration of
+// services imported from peers is not known, so they have no ALPN either.
+func (d *DNSServer) serviceALPN(cfg *dnsConfig, lookup serviceLookup) []string {
+	if lookup.PeerName != "" {
+		return nil
+	}
+
+	args := structs.ServiceConfigRequest{
+		Name:           lookup.Service,
+		Datacenter:     lookup.Datacenter,
+		EnterpriseMeta: lookup.EnterpriseMeta,
+		QueryOptions: structs.QueryOptions{
+			Token:      d.token(cfg),
+			AllowStale: cfg.AllowStale,
+		},
+	}
+	raw, _, err := d.agent.cache.Get(context.TODO(), cachetype.ResolvedServiceConfigName, &args)
+	if err != nil {
+		d.logger.Warn("failed to resolve service protocol", "service", lookup.Service, "error", err)
+		return nil
+	}
+	reply, ok := raw.(*structs.ServiceConfigResponse)
+	if !ok {
+		d.logger.Error("internal error: response type not correct", "type", fmt.Sprintf("%T", raw))
+		return nil
+	}
+
+	protocol, _ := reply.ProxyConfig["protocol"].(string)
+	switch strings.ToLower(protocol) {
+	case "http":
+		return []string{"http/1.1"}
+	case "http2", "grpc":
+		return []string{"h2"}
+	default:
+		return nil
+	}
+}
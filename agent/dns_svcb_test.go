@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package agent
+
+import (
+	"context"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/sdk/testutil/retry"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+func TestDNS_ServiceLookup_SVCB(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+	testrpc.WaitForLeader(t, a.RPC, "dc1")
+
+	instances := []struct {
+		node    string
+		address string
+		port    int
+		weight  int
+	}{
+		{"heavy", "10.1.0.1", 8443, 10},
+		{"light", "10.2.0.1", 9443, 1},
+		{"other", "10.3.0.1", 7443, 10},
+	}
+	for _, i := range instances {
+		args := &structs.RegisterRequest{
+			Datacenter: "dc1",
+			Node:       i.node,
+			Address:    i.address,
+			Service: &structs.NodeService{
+				Service: "web",
+				Port:    i.port,
+				Weights: &structs.Weights{Passing: i.weight, Warning: 1},
+			},
+		}
+		var out struct{}
+		require.NoError(t, a.RPC(context.Background(), "Catalog.Register", args, &out))
+	}
+
+	query := func(t require.TestingT, qtype uint16) *dns.Msg {
+		m := new(dns.Msg)
+		m.SetQuestion("web.service.consul.", qtype)
+		in, _, err := new(dns.Client).Exchange(m, a.DNSAddr())
+		require.NoError(t, err)
+		require.Len(t, in.Answer, len(instances))
+		return in
+	}
+
+	t.Run("SVCB without protocol", func(t *testing.T) {
+		in := query(t, dns.TypeSVCB)
+		for _, rr := range in.Answer {
+			svcb, ok := rr.(*dns.SVCB)
+			require.True(t, ok, "bad: %#v", rr)
+			require.Equal(t, "web.service.consul.", svcb.Hdr.Name)
+
+			var port uint16
+			for _, kv := range svcb.Value {
+				switch v := kv.(type) {
+				case *dns.SVCBAlpn:
+					t.Fatalf("unexpected alpn: %v", v.Alpn)
+				case *dns.SVCBPort:
+					port = v.Port
+				}
+			}
+
+			switch svcb.Target {
+			case "heavy.node.dc1.consul.":
+				require.Equal(t, uint16(1), svcb.Priority)
+				require.Equal(t, uint16(8443), port)
+			case "other.node.dc1.consul.":
+				require.Equal(t, uint16(1), svcb.Priority)
+				require.Equal(t, uint16(7443), port)
+			case "light.node.dc1.consul.":
+				require.Equal(t, uint16(2), svcb.Priority)
+				require.Equal(t, uint16(9443), port)
+			default:
+				t.Fatalf("unexpected target: %s", svcb.Target)
+			}
+		}
+		require.Len(t, in.Extra, len(instances))
+	})
+
+	// Configure the service protocol.
+	{
+		req := structs.ConfigEntryRequest{
+			Op:         structs.ConfigEntryUpsert,
+			Datacenter: "dc1",
+			Entry: &structs.ServiceConfigEntry{
+				Kind:     structs.ServiceDefaults,
+				Name:     "web",
+				Protocol: "http2",
+			},
+		}
+		var out bool
+		require.NoError(t, a.RPC(context.Background(), "ConfigEntry.Apply", req, &out))
+		require.True(t, out)
+	}
+
+	t.Run("HTTPS with protocol", func(t *testing.T) {
+		// The protocol is cached by the agent, retry until it sees the update.
+		retry.Run(t, func(r *retry.R) {
+			in := query(r, dns.TypeHTTPS)
+			for _, rr := range in.Answer {
+				https, ok := rr.(*dns.HTTPS)
+				require.True(r, ok, "bad: %#v", rr)
+
+				var alpn []string
+				var hints int
+				for _, kv := range https.Value {
+					switch v := kv.(type) {
+					case *dns.SVCBAlpn:
+						alpn = v.Alpn
+					case *dns.SVCBIPv4Hint:
+						hints = len(v.Hint)
+					}
+				}
+				require.Equal(r, []string{"h2"}, alpn)
+				require.Equal(r, 1, hints)
+			}
+		})
+	})
+}
+
+func TestSVCBPriorities(t *testing.T) {
+	srv := func(weight uint16) dns.RR {
+		return &dns.SRV{Weight: weight}
+	}
+	priorities := svcbPriorities([]dns.RR{srv(1), srv(5), srv(10), srv(5)})
+	require.Equal(t, map[uint16]uint16{10: 1, 5: 2, 1: 3}, priorities)
+}
@@ -4253,6 +4253,115 @@ func TestDNS_Recurse_Truncation(t *testing.T) {
 	}
 }
 
+func TestDNS_Recurse_ForwardingRules(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	corp := makeRecursor(t, dns.Msg{
+		Answer: []dns.RR{dnsA("ad.corp.example.com", "10.0.0.1")},
+	})
+	defer corp.Shutdown()
+	internal := makeRecursor(t, dns.Msg{
+		Answer: []dns.RR{dnsA("db.internal", "10.0.0.2")},
+	})
+	defer internal.Shutdown()
+	public := makeRecursor(t, dns.Msg{
+		Answer: []dns.RR{dnsA("apple.com", "1.2.3.4")},
+	})
+	defer public.Shutdown()
+
+	a := NewTestAgent(t, `
+		recursors = ["`+public.Addr+`"]
+		dns_config {
+			forwarding_rules = [
+				{
+					domain = "corp.example.com"
+					recursors = ["`+corp.Addr+`"]
+					recursor_strategy = "random"
+				},
+				{
+					domain = "*.internal"
+					recursors = ["`+internal.Addr+`"]
+					recursor_timeout = "1s"
+				}
+			]
+		}
+	`)
+	defer a.Shutdown()
+	testrpc.WaitForLeader(t, a.RPC, "dc1")
+
+	query := func(t *testing.T, name string) *dns.Msg {
+		t.Helper()
+		m := new(dns.Msg)
+		m.SetQuestion(name, dns.TypeA)
+		in, _, err := new(dns.Client).Exchange(m, a.DNSAddr())
+		require.NoError(t, err)
+		return in
+	}
+	requireAnswer := func(t *testing.T, in *dns.Msg, ip string) {
+		t.Helper()
+		require.Equal(t, dns.RcodeSuccess, in.Rcode)
+		require.Len(t, in.Answer, 1)
+		require.Equal(t, ip, in.Answer[0].(*dns.A).A.String())
+	}
+
+	requireAnswer(t, query(t, "corp.example.com."), "10.0.0.1")
+	requireAnswer(t, query(t, "ad.CORP.example.com."), "10.0.0.1")
+	requireAnswer(t, query(t, "db.internal."), "10.0.0.2")
+	// The wildcard rule doesn't match the domain itself.
+	requireAnswer(t, query(t, "internal."), "1.2.3.4")
+	requireAnswer(t, query(t, "example.com."), "1.2.3.4")
+
+	// Without default recursors, queries no rule matches are refused.
+	newCfg := *a.Config
+	newCfg.DNSRecursors = nil
+	require.NoError(t, a.reloadConfigInternal(&newCfg))
+
+	requireAnswer(t, query(t, "ad.corp.example.com."), "10.0.0.1")
+	require.Equal(t, dns.RcodeRefused, query(t, "example.com.").Rcode)
+
+	// Removing all rules disables recursion.
+	newCfg.DNSForwardingRules = nil
+	require.NoError(t, a.reloadConfigInternal(&newCfg))
+	require.Equal(t, dns.RcodeRefused, query(t, "ad.corp.example.com.").Rcode)
+}
+
+func TestDNSConfig_recursorsFor(t *testing.T) {
+	conf := &config.RuntimeConfig{
+		DNSRecursors:        []string{"8.8.8.8"},
+		DNSRecursorStrategy: agentdns.RecursorStrategySequential,
+		DNSRecursorTimeout:  2 * time.Second,
+		DNSForwardingRules: []config.DNSForwardingRule{
+			{Domain: "example.com.", Recursors: []string{"10.0.0.1"}, RecursorStrategy: agentdns.RecursorStrategySequential, RecursorTimeout: time.Second},
+			{Domain: "*.corp.example.com.", Recursors: []string{"10.0.0.2"}, RecursorStrategy: agentdns.RecursorStrategyRandom, RecursorTimeout: 3 * time.Second},
+			{Domain: "corp.example.com.", Recursors: []string{"10.0.0.3"}, RecursorStrategy: agentdns.RecursorStrategySequential, RecursorTimeout: time.Second},
+		},
+	}
+	cfg, err := GetDNSConfig(conf)
+	require.NoError(t, err)
+
+	cases := map[string]struct {
+		recursor string
+		strategy agentdns.RecursorStrategy
+		timeout  time.Duration
+	}{
+		"apple.com.":               {"8.8.8.8:53", agentdns.RecursorStrategySequential, 2 * time.Second},
+		"example.com.":             {"10.0.0.1:53", agentdns.RecursorStrategySequential, time.Second},
+		"www.EXAMPLE.com.":         {"10.0.0.1:53", agentdns.RecursorStrategySequential, time.Second},
+		"corp.example.com.":        {"10.0.0.3:53", agentdns.RecursorStrategySequential, time.Second},
+		"ad.corp.example.com.":     {"10.0.0.2:53", agentdns.RecursorStrategyRandom, 3 * time.Second},
+		"dc1.ad.corp.example.com.": {"10.0.0.2:53", agentdns.RecursorStrategyRandom, 3 * time.Second},
+	}
+	for name, tc := range cases {
+		recursors, strategy, timeout := cfg.recursorsFor(name)
+		require.Equal(t, []string{tc.recursor}, recursors, name)
+		require.Equal(t, tc.strategy, strategy, name)
+		require.Equal(t, tc.timeout, timeout, name)
+	}
+}
+
 func TestDNS_RecursorTimeout(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
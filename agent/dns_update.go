@@ -0,0 +1,403 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package agent
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/config"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/types"
+)
+
+const (
+	// dnsUpdateExternalSource is the external source of services added
+	// through dynamic updates. Updates never change other services.
+	dnsUpdateExternalSource = "dns-update"
+
+	// dnsUpdateMetaType is the service metadata key holding the type of the
+	// record a service was added with, so that deleting the record set of
+	// a type only removes the services added with that type.
+	dnsUpdateMetaType = "dns-update-type"
+
+	// dnsUpdateMinTTL is the shortest TTL of the check of a service added
+	// through an update.
+	dnsUpdateMinTTL = 10 * time.Second
+)
+
+// acceptDNSMsg accepts RFC 2136 UPDATE messages, which carry records in all
+// sections, in addition to the messages accepted by dns.DefaultMsgAcceptFunc.
+func acceptDNSMsg(dh dns.Header) dns.MsgAcceptAction {
+	opcode := int(dh.Bits>>11) & 0xF
+	if opcode != dns.OpcodeUpdate {
+		return dns.DefaultMsgAcceptFunc(dh)
+	}
+	if isResponse := dh.Bits&(1<<15) != 0; isResponse {
+		return dns.MsgIgnore
+	}
+	if dh.Qdcount != 1 {
+		return dns.MsgReject
+	}
+	return dns.MsgAccept
+}
+
+// dnsUpdateOp is a change to the services of the agent requested by a record
+// of an UPDATE message.
+type dnsUpdateOp struct {
+	service string
+
+	// add is the service to add. Otherwise the services of the service name
+	// are deleted, limited to those added with rrtype, or to the one with
+	// serviceID, when set.
+	add       *structs.NodeService
+	ttl       time.Duration
+	rrtype    uint16
+	serviceID string
+}
+
+// handleUpdate applies an RFC 2136 UPDATE message for the service domain.
+// Adding SRV, A and AAAA records registers service instances with the local
+// agent, and deleting the records deregisters them again.
+func (d *DNSServer) handleUpdate(cfg *dnsConfig, resp dns.ResponseWriter, req *dns.Msg) {
+	m := new(dns.Msg)
+	m.SetReply(req)
+
+	rcode := d.applyUpdate(cfg, resp, req)
+	if rcode != dns.RcodeSuccess {
+		d.logger.Warn("DNS update failed",
+			"zone", req.Question[0].Name,
+			"rcode", dns.RcodeToString[rcode],
+			"client", resp.RemoteAddr().String(),
+		)
+	}
+	m.SetRcode(req, rcode)
+
+	if tsig := req.IsTsig(); tsig != nil && resp.TsigStatus() == nil {
+		m.SetTsig(tsig.Hdr.Name, tsig.Algorithm, dnsTSIGFudge, time.Now().Unix())
+	}
+	if err := resp.WriteMsg(m); err != nil {
+		d.logger.Warn("failed to respond", "error", err)
+	}
+}
+
+// applyUpdate checks and applies the changes of an UPDATE message, returning
+// the rcode to answer with. Either all changes are applied, or none of them
+// unless the agent fails to apply one.
+func (d *DNSServer) applyUpdate(cfg *dnsConfig, resp dns.ResponseWriter, req *dns.Msg) int {
+	if len(cfg.UpdateKeys) == 0 {
+		return dns.RcodeRefused
+	}
+	key, ok := updateKeyFor(cfg, resp, req)
+	if !ok {
+		return dns.RcodeNotAuth
+	}
+
+	q := req.Question[0]
+	if q.Qtype != dns.TypeSOA {
+		return dns.RcodeFormatError
+	}
+	zone := strings.ToLower(q.Name)
+	if zone != "service."+d.domain && (d.altDomain == "." || zone != "service."+d.altDomain) {
+		return dns.RcodeNotAuth
+	}
+
+	// Prerequisites are not supported.
+	if len(req.Answer) > 0 {
+		return dns.RcodeNotImplemented
+	}
+
+	ops, rcode := d.parseUpdate(zone, req.Ns)
+	if rcode != dns.RcodeSuccess {
+		return rcode
+	}
+
+	// Keys always have a token when ACLs are enabled.
+	token := key.Token
+	entMeta := *d.enterpriseMeta(cfg)
+	authz, err := d.agent.delegate.ResolveTokenAndDefaultMeta(token, &entMeta, nil)
+	if err != nil {
+		d.logger.Warn("failed to resolve the token of a DNS update key", "key", key.Name, "error", err)
+		return dns.RcodeServerFailure
+	}
+	var authzContext acl.AuthorizerContext
+	entMeta.FillAuthzContext(&authzContext)
+	for _, op := range ops {
+		if err := authz.ToAllowAuthorizer().ServiceWriteAllowed(op.service, &authzContext); err != nil {
+			d.logger.Warn("DNS update denied", "key", key.Name, "service", op.service, "error", err)
+			return dns.RcodeRefused
+		}
+	}
+
+	// Check every change before applying any, so that an invalid record
+	// doesn't leave the changes before it applied.
+	for _, op := range ops {
+		if op.add == nil {
+			continue
+		}
+		if err := d.checkUpdateService(cfg, op, &entMeta); err != nil {
+			d.logger.Warn("DNS update rejected", "key", key.Name, "service", op.service, "error", err)
+			return dns.RcodeRefused
+		}
+	}
+
+	for _, op := range ops {
+		var err error
+		if op.add != nil {
+			err = d.addUpdateService(cfg, op, token, &entMeta)
+		} else {
+			err = d.removeUpdateServices(op, &entMeta)
+		}
+		if err != nil {
+			d.logger.Error("failed to apply DNS update", "service", op.service, "error", err)
+			return dns.RcodeServerFailure
+		}
+	}
+	if err := d.agent.State.SyncChanges(); err != nil {
+		d.logger.Error("failed to sync changes", "error", err)
+	}
+	return dns.RcodeSuccess
+}
+
+// updateKeyFor returns the key the request was signed with, if its
+// signature is valid.
+func updateKeyFor(cfg *dnsConfig, resp dns.ResponseWriter, req *dns.Msg) (config.DNSUpdateKey, bool) {
+	tsig := req.IsTsig()
+	if tsig == nil || resp.TsigStatus() != nil {
+		return config.DNSUpdateKey{}, false
+	}
+	for _, key := range cfg.UpdateKeys {
+		if strings.EqualFold(key.Name, tsig.Hdr.Name) &&
+			strings.EqualFold(key.Algorithm, strings.TrimSuffix(tsig.Algorithm, ".")) {
+			return key, true
+		}
+	}
+	return config.DNSUpdateKey{}, false
+}
+
+// parseUpdate turns the records of the update section into the changes to
+// apply. A and AAAA records named like the target of an added SRV record are
+// the addresses of that record rather than instances of their own.
+func (d *DNSServer) parseUpdate(zone string, records []dns.RR) ([]dnsUpdateOp, int) {
+	glue := make(map[string]string)
+	for _, rr := range records {
+		if srv, ok := rr.(*dns.SRV); ok && srv.Hdr.Class == dns.ClassINET {
+			glue[strings.ToLower(srv.Target)] = ""
+		}
+	}
+	for _, rr := range records {
+		hdr := rr.Header()
+		name := strings.ToLower(hdr.Name)
+		if _, ok := glue[name]; !ok || hdr.Class != dns.ClassINET || glue[name] != "" {
+			continue
+		}
+		if ip := updateAddress(rr); ip != nil {
+			glue[name] = ip.String()
+		}
+	}
+
+	var ops []dnsUpdateOp
+	for _, rr := range records {
+		hdr := rr.Header()
+		name := strings.ToLower(hdr.Name)
+		if _, ok := glue[name]; ok && hdr.Class == dns.ClassINET && (hdr.Rrtype == dns.TypeA || hdr.Rrtype == dns.TypeAAAA) {
+			continue
+		}
+		if !dns.IsSubDomain(zone, name) {
+			return nil, dns.RcodeNotZone
+		}
+		service, ok := updateServiceName(zone, name)
+		if !ok {
+			return nil, dns.RcodeRefused
+		}
+		op := dnsUpdateOp{service: service, rrtype: hdr.Rrtype}
+
+		switch hdr.Class {
+		case dns.ClassINET:
+			ttl := time.Duration(hdr.Ttl) * time.Second
+			if ttl < dnsUpdateMinTTL {
+				ttl = dnsUpdateMinTTL
+			}
+			op.ttl = ttl
+			switch x := rr.(type) {
+			case *dns.SRV:
+				address := glue[strings.ToLower(x.Target)]
+				if address == "" {
+					address = strings.TrimSuffix(x.Target, ".")
+				}
+				op.add = d.updateNodeService(service, dnsUpdateServiceID(service, x.Target, x.Port), address, int(x.Port), hdr.Rrtype)
+			case *dns.A, *dns.AAAA:
+				ip := updateAddress(rr).String()
+				op.add = d.updateNodeService(service, dnsUpdateServiceID(service, ip, 0), ip, 0, hdr.Rrtype)
+			default:
+				return nil, dns.RcodeRefused
+			}
+
+		case dns.ClassANY:
+			// Deletes the record set of the type, or all record sets.
+			if hdr.Ttl != 0 || hdr.Rdlength != 0 {
+				return nil, dns.RcodeFormatError
+			}
+			switch hdr.Rrtype {
+			case dns.TypeANY:
+				op.rrtype = 0
+			case dns.TypeSRV, dns.TypeA, dns.TypeAAAA:
+			default:
+				return nil, dns.RcodeRefused
+			}
+
+		case dns.ClassNONE:
+			// Deletes a single record.
+			if hdr.Ttl != 0 {
+				return nil, dns.RcodeFormatError
+			}
+			switch x := rr.(type) {
+			case *dns.SRV:
+				op.serviceID = dnsUpdateServiceID(service, x.Target, x.Port)
+			case *dns.A, *dns.AAAA:
+				op.serviceID = dnsUpdateServiceID(service, updateAddress(rr).String(), 0)
+			default:
+				return nil, dns.RcodeRefused
+			}
+
+		default:
+			return nil, dns.RcodeFormatError
+		}
+		ops = append(ops, op)
+	}
+	return ops, dns.RcodeSuccess
+}
+
+// updateServiceName returns the service a record of the service zone is
+// for. Records are named either <service>.service.<domain> or, as in RFC
+// 2782 lookups, _<service>._<protocol>.service.<domain>.
+func updateServiceName(zone, name string) (string, bool) {
+	labels := dns.SplitDomainName(strings.TrimSuffix(name, "."+zone))
+	switch {
+	case name == zone:
+		return "", false
+	case len(labels) == 1:
+		return labels[0], true
+	case len(labels) == 2 && strings.HasPrefix(labels[0], "_") && strings.HasPrefix(labels[1], "_"):
+		return labels[0][1:], labels[0] != "_"
+	default:
+		return "", false
+	}
+}
+
+// dnsUpdateServiceID returns the ID of the service added by a record, which
+// is derived from the target or address and port of the record so that
+// adding the same record again refreshes the service.
+func dnsUpdateServiceID(service, target string, port uint16) string {
+	id := service + "-" + strings.ToLower(strings.TrimSuffix(target, "."))
+	if port != 0 {
+		id += "-" + strconv.Itoa(int(port))
+	}
+	return id
+}
+
+func (d *DNSServer) updateNodeService(service, id, address string, port int, rrtype uint16) *structs.NodeService {
+	return &structs.NodeService{
+		ID:      id,
+		Service: service,
+		Address: address,
+		Port:    port,
+		Meta: map[string]string{
+			structs.MetaExternalSource: dnsUpdateExternalSource,
+			dnsUpdateMetaType:          dns.TypeToString[rrtype],
+		},
+	}
+}
+
+// checkUpdateService checks that the service of an update can be registered,
+// without registering it.
+func (d *DNSServer) checkUpdateService(cfg *dnsConfig, op dnsUpdateOp, entMeta *acl.EnterpriseMeta) error {
+	existing := d.agent.State.Service(structs.NewServiceID(op.add.ID, entMeta))
+	if existing != nil && existing.Meta[structs.MetaExternalSource] != dnsUpdateExternalSource {
+		return fmt.Errorf("service %q was not registered through a DNS update", op.add.ID)
+	}
+	svc := updateService(op, entMeta)
+	return d.agent.validateService(&svc, []*structs.CheckType{updateCheckType(cfg, op)})
+}
+
+// addUpdateService registers the service of an update with a TTL check, or
+// refreshes the check when the service is already registered.
+func (d *DNSServer) addUpdateService(cfg *dnsConfig, op dnsUpdateOp, token string, entMeta *acl.EnterpriseMeta) error {
+	existing := d.agent.State.Service(structs.NewServiceID(op.add.ID, entMeta))
+	if existing != nil && existing.Address == op.add.Address && existing.Port == op.add.Port {
+		chkType := updateCheckType(cfg, op)
+		err := d.agent.updateTTLCheck(structs.NewCheckID(chkType.CheckID, entMeta), api.HealthPassing, "Refreshed by a DNS update")
+		if err == nil {
+			return nil
+		}
+		// The check is gone, register the service again.
+	}
+
+	svc := updateService(op, entMeta)
+	d.logger.Info("registering service from DNS update", "service", op.add.ID)
+	return d.agent.AddService(AddServiceRequest{
+		Service:  &svc,
+		chkTypes: []*structs.CheckType{updateCheckType(cfg, op)},
+		persist:  true,
+		token:    token,
+		Source:   ConfigSourceRemote,
+	})
+}
+
+// updateService returns the service to register for an update.
+func updateService(op dnsUpdateOp, entMeta *acl.EnterpriseMeta) structs.NodeService {
+	svc := *op.add
+	svc.EnterpriseMeta = *entMeta
+	return svc
+}
+
+// updateCheckType returns the TTL check of the service of an update.
+func updateCheckType(cfg *dnsConfig, op dnsUpdateOp) *structs.CheckType {
+	return &structs.CheckType{
+		CheckID:                        types.CheckID("service:" + op.add.ID),
+		Name:                           "DNS update TTL",
+		Notes:                          "Refreshed by DNS updates of the service records",
+		TTL:                            op.ttl,
+		Status:                         api.HealthPassing,
+		DeregisterCriticalServiceAfter: cfg.UpdateDeregisterCriticalServiceAfter,
+	}
+}
+
+// removeUpdateServices deregisters the services of an update deletion.
+func (d *DNSServer) removeUpdateServices(op dnsUpdateOp, entMeta *acl.EnterpriseMeta) error {
+	for sid, svc := range d.agent.State.Services(entMeta) {
+		if svc.Service != op.service || svc.Meta[structs.MetaExternalSource] != dnsUpdateExternalSource {
+			continue
+		}
+		if op.serviceID != "" && svc.ID != op.serviceID {
+			continue
+		}
+		if op.rrtype != 0 && svc.Meta[dnsUpdateMetaType] != dns.TypeToString[op.rrtype] {
+			continue
+		}
+		d.logger.Info("deregistering service from DNS update", "service", svc.ID)
+		if err := d.agent.RemoveService(sid); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateAddress returns the address of an A or AAAA record.
+func updateAddress(rr dns.RR) net.IP {
+	switch x := rr.(type) {
+	case *dns.A:
+		return x.A
+	case *dns.AAAA:
+		return x.AAAA
+	}
+	return nil
+}
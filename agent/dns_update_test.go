@@ -0,0 +1,241 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package agent
+
+import (
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+const dnsUpdateTestSecret = "c2VjcmV0LXRzaWcta2V5LWZvci10ZXN0cw=="
+
+// sendUpdate sends an UPDATE message for the service zone with the records
+// to insert and delete, signed with the key when it is not empty.
+func sendUpdate(t *testing.T, addr, key string, insert, remove []dns.RR, removeSets []dns.RR) *dns.Msg {
+	t.Helper()
+	m := new(dns.Msg)
+	m.SetUpdate("service.consul.")
+	if len(insert) > 0 {
+		m.Insert(insert)
+	}
+	if len(remove) > 0 {
+		m.Remove(remove)
+	}
+	if len(removeSets) > 0 {
+		m.RemoveRRset(removeSets)
+	}
+	c := new(dns.Client)
+	if key != "" {
+		m.SetTsig(key, dns.HmacSHA256, 300, time.Now().Unix())
+		c.TsigSecret = map[string]string{key: dnsUpdateTestSecret}
+	}
+	in, _, err := c.Exchange(m, addr)
+	require.NoError(t, err)
+	return in
+}
+
+func mustRR(t *testing.T, s string) dns.RR {
+	t.Helper()
+	rr, err := dns.NewRR(s)
+	require.NoError(t, err)
+	return rr
+}
+
+func TestDNS_Update(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := NewTestAgent(t, `
+		dns_config {
+			dynamic_updates {
+				tsig_keys = [
+					{
+						name = "appliances"
+						secret = "`+dnsUpdateTestSecret+`"
+					}
+				]
+			}
+		}
+	`)
+	defer a.Shutdown()
+	testrpc.WaitForLeader(t, a.RPC, "dc1")
+
+	service := func(id string) *structs.NodeService {
+		return a.State.Service(structs.NewServiceID(id, nil))
+	}
+
+	t.Run("unsigned", func(t *testing.T) {
+		in := sendUpdate(t, a.DNSAddr(), "", []dns.RR{mustRR(t, "printer.service.consul. 60 IN A 10.0.0.9")}, nil, nil)
+		require.Equal(t, dns.RcodeNotAuth, in.Rcode)
+		require.Nil(t, service("printer-10.0.0.9"))
+	})
+
+	t.Run("outside the zone", func(t *testing.T) {
+		in := sendUpdate(t, a.DNSAddr(), "appliances.", []dns.RR{mustRR(t, "printer.node.consul. 60 IN A 10.0.0.9")}, nil, nil)
+		require.Equal(t, dns.RcodeNotZone, in.Rcode)
+	})
+
+	t.Run("add SRV with address", func(t *testing.T) {
+		in := sendUpdate(t, a.DNSAddr(), "appliances.", []dns.RR{
+			mustRR(t, "_printer._tcp.service.consul. 120 IN SRV 0 0 631 ps1.example.com."),
+			mustRR(t, "ps1.example.com. 120 IN A 10.0.0.7"),
+		}, nil, nil)
+		require.Equal(t, dns.RcodeSuccess, in.Rcode)
+		require.NotNil(t, in.IsTsig(), "response is not signed")
+
+		svc := service("printer-ps1.example.com-631")
+		require.NotNil(t, svc)
+		require.Equal(t, "printer", svc.Service)
+		require.Equal(t, "10.0.0.7", svc.Address)
+		require.Equal(t, 631, svc.Port)
+		require.Equal(t, dnsUpdateExternalSource, svc.Meta[structs.MetaExternalSource])
+
+		checks := a.State.ChecksForService(structs.NewServiceID(svc.ID, nil), false)
+		require.Len(t, checks, 1)
+		for _, c := range checks {
+			require.Equal(t, "passing", c.Status)
+		}
+		// The address record is not an instance of its own.
+		require.Len(t, a.State.Services(nil), 1)
+	})
+
+	t.Run("add A", func(t *testing.T) {
+		in := sendUpdate(t, a.DNSAddr(), "appliances.", []dns.RR{
+			mustRR(t, "printer.service.consul. 60 IN A 10.0.0.9"),
+		}, nil, nil)
+		require.Equal(t, dns.RcodeSuccess, in.Rcode)
+		svc := service("printer-10.0.0.9")
+		require.NotNil(t, svc)
+		require.Equal(t, "10.0.0.9", svc.Address)
+		require.Zero(t, svc.Port)
+	})
+
+	t.Run("refresh", func(t *testing.T) {
+		in := sendUpdate(t, a.DNSAddr(), "appliances.", []dns.RR{
+			mustRR(t, "printer.service.consul. 60 IN A 10.0.0.9"),
+		}, nil, nil)
+		require.Equal(t, dns.RcodeSuccess, in.Rcode)
+		require.NotNil(t, service("printer-10.0.0.9"))
+	})
+
+	t.Run("delete record", func(t *testing.T) {
+		in := sendUpdate(t, a.DNSAddr(), "appliances.", nil, []dns.RR{
+			mustRR(t, "printer.service.consul. 0 IN A 10.0.0.9"),
+		}, nil)
+		require.Equal(t, dns.RcodeSuccess, in.Rcode)
+		require.Nil(t, service("printer-10.0.0.9"))
+		require.NotNil(t, service("printer-ps1.example.com-631"))
+	})
+
+	t.Run("delete record set", func(t *testing.T) {
+		in := sendUpdate(t, a.DNSAddr(), "appliances.", nil, nil, []dns.RR{
+			mustRR(t, "_printer._tcp.service.consul. 0 IN SRV 0 0 0 ."),
+		})
+		require.Equal(t, dns.RcodeSuccess, in.Rcode)
+		require.Nil(t, service("printer-ps1.example.com-631"))
+	})
+
+	t.Run("services not added by updates are kept", func(t *testing.T) {
+		require.NoError(t, a.AddService(AddServiceRequest{
+			Service: &structs.NodeService{ID: "printer-local", Service: "printer", Port: 9100},
+			Source:  ConfigSourceLocal,
+		}))
+		in := sendUpdate(t, a.DNSAddr(), "appliances.", []dns.RR{
+			mustRR(t, "printer.service.consul. 60 IN A 10.0.0.10"),
+		}, nil, nil)
+		require.Equal(t, dns.RcodeSuccess, in.Rcode)
+
+		in = sendUpdate(t, a.DNSAddr(), "appliances.", nil, nil, []dns.RR{
+			mustRR(t, "printer.service.consul. 0 IN ANY"),
+		})
+		require.Equal(t, dns.RcodeSuccess, in.Rcode)
+		require.Nil(t, service("printer-10.0.0.10"))
+		require.NotNil(t, service("printer-local"))
+	})
+
+	t.Run("rejected updates apply no changes", func(t *testing.T) {
+		require.NoError(t, a.AddService(AddServiceRequest{
+			Service: &structs.NodeService{ID: "printer-10.0.0.12", Service: "printer"},
+			Source:  ConfigSourceLocal,
+		}))
+		in := sendUpdate(t, a.DNSAddr(), "appliances.", []dns.RR{
+			mustRR(t, "printer.service.consul. 60 IN A 10.0.0.11"),
+			mustRR(t, "printer.service.consul. 60 IN A 10.0.0.12"),
+		}, nil, nil)
+		require.Equal(t, dns.RcodeRefused, in.Rcode)
+		require.Nil(t, service("printer-10.0.0.11"))
+		require.Empty(t, service("printer-10.0.0.12").Meta[structs.MetaExternalSource])
+	})
+}
+
+func TestDNS_Update_ACL(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := NewTestAgent(t, `
+		primary_datacenter = "dc1"
+		acl {
+			enabled = true
+			default_policy = "deny"
+			tokens {
+				initial_management = "root"
+			}
+		}
+		dns_config {
+			dynamic_updates {
+				tsig_keys = [
+					{
+						name = "anonymous"
+						secret = "`+dnsUpdateTestSecret+`"
+						token = "anonymous"
+					},
+					{
+						name = "management"
+						secret = "`+dnsUpdateTestSecret+`"
+						token = "root"
+					}
+				]
+			}
+		}
+	`)
+	defer a.Shutdown()
+	testrpc.WaitForLeader(t, a.RPC, "dc1")
+
+	add := []dns.RR{mustRR(t, "printer.service.consul. 60 IN A 10.0.0.9")}
+
+	in := sendUpdate(t, a.DNSAddr(), "anonymous.", add, nil, nil)
+	require.Equal(t, dns.RcodeRefused, in.Rcode)
+	require.Nil(t, a.State.Service(structs.NewServiceID("printer-10.0.0.9", nil)))
+
+	in = sendUpdate(t, a.DNSAddr(), "management.", add, nil, nil)
+	require.Equal(t, dns.RcodeSuccess, in.Rcode)
+	require.NotNil(t, a.State.Service(structs.NewServiceID("printer-10.0.0.9", nil)))
+}
+
+func TestUpdateServiceName(t *testing.T) {
+	cases := map[string]string{
+		"web.service.consul.":          "web",
+		"_web._tcp.service.consul.":    "web",
+		"service.consul.":              "",
+		"a.b.service.consul.":          "",
+		"_._tcp.service.consul.":       "",
+		"web._tcp.service.consul.":     "",
+		"_web._tcp.dc1.service.consul": "",
+	}
+	for name, expected := range cases {
+		service, ok := updateServiceName("service.consul.", name)
+		require.Equal(t, expected != "", ok, name)
+		require.Equal(t, expected, service, name)
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package agent
+
+import (
+	"crypto/tls"
+	"net"
+	"strings"
+
+	"github.com/miekg/dns"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/config"
+)
+
+// dnsView answers the queries of the clients in its networks, or presenting
+// one of its client certificate names over DNS over TLS, with its own token
+// and default partition and namespace.
+type dnsView struct {
+	Name            string
+	SourceCIDRs     []*net.IPNet
+	ClientCertNames []string
+	Token           string
+	EnterpriseMeta  acl.EnterpriseMeta
+}
+
+// getDNSViews builds the views of the config. Views without a partition
+// default to the partition of the agent.
+func getDNSViews(conf *config.RuntimeConfig) []dnsView {
+	if len(conf.DNSViews) == 0 {
+		return nil
+	}
+	out := make([]dnsView, len(conf.DNSViews))
+	for i, v := range conf.DNSViews {
+		partition := v.Partition
+		if partition == "" {
+			partition = conf.PartitionOrDefault()
+		}
+		out[i] = dnsView{
+			Name:            v.Name,
+			SourceCIDRs:     v.SourceCIDRs,
+			ClientCertNames: v.ClientCertNames,
+			Token:           v.Token,
+			EnterpriseMeta:  acl.NewEnterpriseMetaWithPartition(partition, v.Namespace),
+		}
+	}
+	return out
+}
+
+// matches returns whether the client address is in one of the networks of
+// the view, or one of the names of the verified client certificate is one of
+// the client certificate names of the view.
+func (v *dnsView) matches(ip net.IP, state *tls.ConnectionState) bool {
+	if ip != nil {
+		for _, n := range v.SourceCIDRs {
+			if n.Contains(ip) {
+				return true
+			}
+		}
+	}
+	if state == nil || len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return false
+	}
+	cert := state.VerifiedChains[0][0]
+	names := append([]string{cert.Subject.CommonName}, cert.DNSNames...)
+	for _, u := range cert.URIs {
+		names = append(names, u.String())
+	}
+	for _, want := range v.ClientCertNames {
+		for _, name := range names {
+			if name != "" && strings.EqualFold(want, name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// viewFor returns the first view matching the client of a request, or nil
+// when it is not in any view.
+func (cfg *dnsConfig) viewFor(resp dns.ResponseWriter) *dnsView {
+	if len(cfg.Views) == 0 {
+		return nil
+	}
+	ip, _ := addrIPPort(resp.RemoteAddr())
+	var state *tls.ConnectionState
+	if cs, ok := resp.(dns.ConnectionStater); ok {
+		state = cs.ConnectionState()
+	}
+	for i := range cfg.Views {
+		if cfg.Views[i].matches(ip, state) {
+			return &cfg.Views[i]
+		}
+	}
+	return nil
+}
+
+// requestConfig returns the config to answer a request with. Requests from
+// the clients of a view are answered with a copy of the config scoped to it.
+func (d *DNSServer) requestConfig(resp dns.ResponseWriter) *dnsConfig {
+	cfg := d.config.Load().(*dnsConfig)
+	view := cfg.viewFor(resp)
+	if view == nil {
+		return cfg
+	}
+	scoped := *cfg
+	scoped.view = view
+	return &scoped
+}
+
+// token returns the token to read the catalog with for a request.
+func (d *DNSServer) token(cfg *dnsConfig) string {
+	if cfg.view != nil {
+		return cfg.view.Token
+	}
+	return d.agent.tokens.UserToken()
+}
+
+// enterpriseMeta returns the partition and namespace of the queries that do
+// not name them.
+func (d *DNSServer) enterpriseMeta(cfg *dnsConfig) *acl.EnterpriseMeta {
+	if cfg.view != nil {
+		return &cfg.view.EnterpriseMeta
+	}
+	return &d.defaultEnterpriseMeta
+}
@@ -0,0 +1,230 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package agent
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net"
+	"net/url"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/config"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/agent/token"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+func TestDNS_ServiceLookup_View(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	tests := []struct {
+		name    string
+		cidr    string
+		results int
+	}{
+		{"client in view", "127.0.0.0/8", 1},
+		{"client outside view", "10.0.0.0/8", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := NewTestAgent(t, `
+				primary_datacenter = "dc1"
+
+				acl {
+					enabled = true
+					default_policy = "deny"
+					down_policy = "deny"
+
+					tokens {
+						initial_management = "root"
+						default = "anonymous"
+					}
+				}
+
+				dns_config {
+					views = [
+						{
+							name = "operators"
+							source_cidrs = ["`+tt.cidr+`"]
+							token = "root"
+						}
+					]
+				}
+			`)
+			defer a.Shutdown()
+			testrpc.WaitForLeader(t, a.RPC, "dc1")
+
+			args := &structs.RegisterRequest{
+				Datacenter: "dc1",
+				Node:       "foo",
+				Address:    "127.0.0.1",
+				Service: &structs.NodeService{
+					Service: "foo",
+					Port:    12345,
+				},
+				WriteRequest: structs.WriteRequest{Token: "root"},
+			}
+			var out struct{}
+			require.NoError(t, a.RPC(context.Background(), "Catalog.Register", args, &out))
+
+			m := new(dns.Msg)
+			m.SetQuestion("foo.service.consul.", dns.TypeA)
+			in, _, err := new(dns.Client).Exchange(m, a.DNSAddr())
+			require.NoError(t, err)
+			require.Len(t, in.Answer, tt.results)
+		})
+	}
+}
+
+func TestDNS_ZoneTransfer_View(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	tests := []struct {
+		name  string
+		cidr  string
+		found bool
+	}{
+		{"client in view", "127.0.0.0/8", true},
+		{"client outside view", "10.0.0.0/8", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := NewTestAgent(t, `
+				primary_datacenter = "dc1"
+
+				acl {
+					enabled = true
+					default_policy = "deny"
+					down_policy = "deny"
+
+					tokens {
+						initial_management = "root"
+						default = "anonymous"
+					}
+				}
+
+				dns_config {
+					zone_transfer {
+						allowed_cidrs = ["127.0.0.0/8"]
+					}
+					views = [
+						{
+							name = "operators"
+							source_cidrs = ["`+tt.cidr+`"]
+							token = "root"
+						}
+					]
+				}
+			`)
+			defer a.Shutdown()
+			testrpc.WaitForLeader(t, a.RPC, "dc1")
+
+			args := &structs.RegisterRequest{
+				Datacenter: "dc1",
+				Node:       "foo",
+				Address:    "127.0.0.1",
+				Service: &structs.NodeService{
+					Service: "db",
+					Port:    12345,
+				},
+				WriteRequest: structs.WriteRequest{Token: "root"},
+			}
+			var out struct{}
+			require.NoError(t, a.RPC(context.Background(), "Catalog.Register", args, &out))
+
+			m := new(dns.Msg)
+			m.SetAxfr("consul.")
+			var found bool
+			for _, rr := range zoneTransfer(t, a.DNSAddr(), m, nil) {
+				if rr.Header().Name == "db.service.consul." {
+					found = true
+				}
+			}
+			require.Equal(t, tt.found, found)
+		})
+	}
+}
+
+func TestDNSConfig_viewFor(t *testing.T) {
+	_, dmz, err := net.ParseCIDR("192.0.2.0/24")
+	require.NoError(t, err)
+
+	rt := &config.RuntimeConfig{DNSViews: []config.DNSView{
+		{Name: "dmz", SourceCIDRs: []*net.IPNet{dmz}, Token: "dmz-token"},
+		{Name: "operators", ClientCertNames: []string{"Ops.example.com", "spiffe://example.org/ops"}, Token: "ops-token"},
+	}}
+	cfg, err := GetDNSConfig(rt)
+	require.NoError(t, err)
+
+	verified := func(cert *x509.Certificate) *tls.ConnectionState {
+		return &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}
+	}
+	spiffe, err := url.Parse("spiffe://example.org/ops")
+	require.NoError(t, err)
+
+	cases := map[string]struct {
+		addr  string
+		state *tls.ConnectionState
+		view  string
+	}{
+		"network": {
+			addr: "192.0.2.10:5353",
+			view: "dmz",
+		},
+		"no match": {
+			addr: "198.51.100.1:5353",
+		},
+		"common name": {
+			addr:  "198.51.100.1:5353",
+			state: verified(&x509.Certificate{Subject: pkix.Name{CommonName: "ops.example.com"}}),
+			view:  "operators",
+		},
+		"URI SAN": {
+			addr:  "198.51.100.1:5353",
+			state: verified(&x509.Certificate{URIs: []*url.URL{spiffe}}),
+			view:  "operators",
+		},
+		"unverified certificate": {
+			addr: "198.51.100.1:5353",
+			state: &tls.ConnectionState{PeerCertificates: []*x509.Certificate{
+				{Subject: pkix.Name{CommonName: "ops.example.com"}},
+			}},
+		},
+		"first matching view": {
+			addr:  "192.0.2.10:5353",
+			state: verified(&x509.Certificate{DNSNames: []string{"ops.example.com"}}),
+			view:  "dmz",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			w := &dohResponseWriter{remoteAddr: tcpAddrFromString(tc.addr), tlsState: tc.state}
+			view := cfg.viewFor(w)
+			if tc.view == "" {
+				require.Nil(t, view)
+				return
+			}
+			require.NotNil(t, view)
+			require.Equal(t, tc.view, view.Name)
+		})
+	}
+
+	d := &DNSServer{agent: &Agent{tokens: new(token.Store)}}
+	w := &dohResponseWriter{remoteAddr: tcpAddrFromString("192.0.2.10:5353")}
+	d.config.Store(cfg)
+	require.Equal(t, "dmz-token", d.token(d.requestConfig(w)))
+	require.Same(t, cfg, d.requestConfig(&dohResponseWriter{remoteAddr: tcpAddrFromString("198.51.100.1:5353")}))
+}
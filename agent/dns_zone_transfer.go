@@ -0,0 +1,397 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package agent
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+
+	agentdns "github.com/hashicorp/consul/agent/dns"
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+const (
+	// zoneTransferChunkSize is the number of records sent in each message of
+	// a zone transfer.
+	zoneTransferChunkSize = 100
+
+	// dnsTSIGFudge is the allowed clock skew in seconds for TSIG signed
+	// responses.
+	dnsTSIGFudge = 300
+)
+
+// zoneSnapshot is the catalog data a zone transfer is built from.
+type zoneSnapshot struct {
+	// Index is the highest raft index of the catalog data and is used as the
+	// SOA serial of the zone.
+	Index    uint64
+	Nodes    structs.Nodes
+	Services structs.CheckServiceNodes
+}
+
+// tsigSecrets returns the TSIG secrets of zone transfers and dynamic updates
+// in the form expected by dns.Server.
+func tsigSecrets(cfg *dnsConfig) map[string]string {
+	if len(cfg.ZoneTransferTSIGKeys) == 0 && len(cfg.UpdateKeys) == 0 {
+		return nil
+	}
+	secrets := make(map[string]string, len(cfg.ZoneTransferTSIGKeys)+len(cfg.UpdateKeys))
+	for _, key := range cfg.ZoneTransferTSIGKeys {
+		secrets[key.Name] = key.Secret
+	}
+	for _, key := range cfg.UpdateKeys {
+		secrets[key.Name] = key.Secret
+	}
+	return secrets
+}
+
+// handleZoneTransfer answers AXFR and IXFR requests for the Consul domain.
+// IXFR is answered with the full zone unless the client is already at the
+// current serial, since no history of catalog changes is kept.
+func (d *DNSServer) handleZoneTransfer(cfg *dnsConfig, network string, resp dns.ResponseWriter, req *dns.Msg) {
+	q := req.Question[0]
+
+	m := new(dns.Msg)
+	m.SetReply(req)
+	m.Authoritative = true
+
+	zone := d.getResponseDomain(q.Name)
+	if !strings.EqualFold(q.Name, zone) {
+		m.SetRcode(req, dns.RcodeNotAuth)
+		d.writeZoneTransferMsg(resp, req, m)
+		return
+	}
+
+	if rcode := d.zoneTransferAuthorized(cfg, resp, req); rcode != dns.RcodeSuccess {
+		d.logger.Warn("zone transfer denied",
+			"client", resp.RemoteAddr().String(),
+			"rcode", dns.RcodeToString[rcode],
+		)
+		m.SetRcode(req, rcode)
+		d.writeZoneTransferMsg(resp, req, m)
+		return
+	}
+
+	if q.Qtype == dns.TypeAXFR && network != "tcp" {
+		// AXFR is only defined over TCP.
+		m.SetRcode(req, dns.RcodeFormatError)
+		d.writeZoneTransferMsg(resp, req, m)
+		return
+	}
+
+	snap, err := d.zoneSnapshot(cfg)
+	if err != nil {
+		d.logger.Warn("zone transfer failed", "error", err)
+		m.SetRcode(req, dns.RcodeServerFailure)
+		d.writeZoneTransferMsg(resp, req, m)
+		return
+	}
+
+	soa := d.soa(cfg, zone)
+	soa.Serial = uint32(snap.Index)
+
+	if q.Qtype == dns.TypeIXFR {
+		upToDate := false
+		for _, rr := range req.Ns {
+			if s, ok := rr.(*dns.SOA); ok && s.Serial == soa.Serial {
+				upToDate = true
+			}
+		}
+
+		// A single SOA tells the client either that it is current or, over
+		// UDP, that it should retry over TCP.
+		if upToDate || network != "tcp" {
+			m.Answer = []dns.RR{soa}
+			d.writeZoneTransferMsg(resp, req, m)
+			return
+		}
+	}
+
+	records := []dns.RR{soa}
+	records = append(records, d.zoneRecords(cfg, zone, snap)...)
+	records = append(records, soa)
+
+	ch := make(chan *dns.Envelope)
+	errCh := make(chan error, 1)
+	tr := new(dns.Transfer)
+	go func() {
+		errCh <- tr.Out(resp, req, ch)
+	}()
+
+	for len(records) > 0 {
+		n := zoneTransferChunkSize
+		if n > len(records) {
+			n = len(records)
+		}
+		select {
+		case ch <- &dns.Envelope{RR: records[:n]}:
+			records = records[n:]
+		case err := <-errCh:
+			d.logger.Warn("zone transfer failed", "client", resp.RemoteAddr().String(), "error", err)
+			return
+		}
+	}
+	close(ch)
+
+	if err := <-errCh; err != nil {
+		d.logger.Warn("zone transfer failed", "client", resp.RemoteAddr().String(), "error", err)
+		return
+	}
+	d.logger.Debug("zone transfer completed",
+		"zone", zone,
+		"serial", soa.Serial,
+		"client", resp.RemoteAddr().String(),
+	)
+}
+
+// zoneTransferAuthorized checks the client address against the allowed
+// networks and, when TSIG keys are configured, the request signature. It
+// returns the rcode to answer with when the transfer is not allowed.
+func (d *DNSServer) zoneTransferAuthorized(cfg *dnsConfig, resp dns.ResponseWriter, req *dns.Msg) int {
+	if !zoneTransferClientAllowed(cfg, resp.RemoteAddr()) {
+		return dns.RcodeRefused
+	}
+
+	if len(cfg.ZoneTransferTSIGKeys) == 0 {
+		return dns.RcodeSuccess
+	}
+
+	tsig := req.IsTsig()
+	if tsig == nil || resp.TsigStatus() != nil {
+		return dns.RcodeNotAuth
+	}
+	for _, key := range cfg.ZoneTransferTSIGKeys {
+		if strings.EqualFold(key.Name, tsig.Hdr.Name) &&
+			strings.EqualFold(key.Algorithm, strings.TrimSuffix(tsig.Algorithm, ".")) {
+			return dns.RcodeSuccess
+		}
+	}
+	return dns.RcodeNotAuth
+}
+
+// zoneTransferClientAllowed reports whether the client address is in one of
+// the networks allowed to transfer the zone.
+func zoneTransferClientAllowed(cfg *dnsConfig, remote net.Addr) bool {
+	var ip net.IP
+	switch addr := remote.(type) {
+	case *net.TCPAddr:
+		ip = addr.IP
+	case *net.UDPAddr:
+		ip = addr.IP
+	}
+	if ip == nil {
+		return false
+	}
+
+	for _, n := range cfg.ZoneTransferAllowedCIDRs {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// writeZoneTransferMsg writes a single message response to a zone transfer
+// request, signing it if the request was signed.
+func (d *DNSServer) writeZoneTransferMsg(resp dns.ResponseWriter, req, m *dns.Msg) {
+	if tsig := req.IsTsig(); tsig != nil && resp.TsigStatus() == nil {
+		m.SetTsig(tsig.Hdr.Name, tsig.Algorithm, dnsTSIGFudge, time.Now().Unix())
+	}
+	if err := resp.WriteMsg(m); err != nil {
+		d.logger.Warn("failed to respond", "error", err)
+	}
+}
+
+// zoneSerial returns the SOA serial of the zone, which is derived from the
+// raft index of the catalog.
+func (d *DNSServer) zoneSerial(cfg *dnsConfig) (uint32, error) {
+	snap, err := d.zoneSnapshot(cfg)
+	if err != nil {
+		return 0, err
+	}
+	return uint32(snap.Index), nil
+}
+
+// zoneSnapshot reads the nodes and service instances of the local
+// datacenter that the request's view can see.
+func (d *DNSServer) zoneSnapshot(cfg *dnsConfig) (*zoneSnapshot, error) {
+	opts := structs.QueryOptions{
+		Token:      d.token(cfg),
+		AllowStale: cfg.AllowStale,
+	}
+
+	nodesReq := structs.DCSpecificRequest{
+		Datacenter:     cfg.Datacenter,
+		QueryOptions:   opts,
+		EnterpriseMeta: *d.enterpriseMeta(cfg),
+	}
+	var nodes structs.IndexedNodes
+	if err := d.agent.RPC(context.Background(), "Catalog.ListNodes", &nodesReq, &nodes); err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	dumpReq := structs.ServiceDumpRequest{
+		Datacenter:     cfg.Datacenter,
+		QueryOptions:   opts,
+		EnterpriseMeta: *d.enterpriseMeta(cfg),
+	}
+	var dump structs.IndexedNodesWithGateways
+	if err := d.agent.RPC(context.Background(), "Internal.ServiceDump", &dumpReq, &dump); err != nil {
+		return nil, fmt.Errorf("failed to dump services: %w", err)
+	}
+
+	snap := &zoneSnapshot{
+		Index:    nodes.Index,
+		Nodes:    nodes.Nodes,
+		Services: dump.Nodes.Filter(cfg.OnlyPassing),
+	}
+	if dump.Index > snap.Index {
+		snap.Index = dump.Index
+	}
+	return snap, nil
+}
+
+// zoneRecords builds the node and service records of the zone, excluding
+// the SOA. Names are included both with and without the datacenter label.
+func (d *DNSServer) zoneRecords(cfg *dnsConfig, zone string, snap *zoneSnapshot) []dns.RR {
+	var records []dns.RR
+	seen := make(map[string]struct{})
+	add := func(rrs ...dns.RR) {
+		for _, rr := range rrs {
+			if rr == nil || !dns.IsSubDomain(zone, rr.Header().Name) {
+				continue
+			}
+			key := strings.ToLower(rr.String())
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			records = append(records, rr)
+		}
+	}
+
+	ns, glue := d.nameservers(zone, cfg, maxRecursionLevelDefault)
+	add(ns...)
+	add(glue...)
+
+	dc := cfg.Datacenter
+	accept := TranslateAddressAcceptDomain | TranslateAddressAcceptAny
+
+	for _, n := range snap.Nodes {
+		if agentdns.InvalidNameRe.MatchString(n.Node) {
+			continue
+		}
+		addr := d.agent.TranslateAddress(n.Datacenter, n.Address, n.TaggedAddresses, accept)
+		for _, name := range []string{
+			fmt.Sprintf("%s.node.%s.%s", n.Node, dc, zone),
+			fmt.Sprintf("%s.node.%s", n.Node, zone),
+		} {
+			add(zoneAddressRecord(name, addr, cfg.NodeTTL))
+			if cfg.NodeMetaTXT {
+				add(d.generateMeta(name, n, cfg.NodeTTL)...)
+			}
+		}
+	}
+
+	services := make(map[string]structs.CheckServiceNodes)
+	for _, csn := range snap.Services {
+		name := strings.ToLower(csn.Service.Service)
+		if agentdns.InvalidNameRe.MatchString(name) {
+			continue
+		}
+		services[name] = append(services[name], csn)
+	}
+	names := make([]string, 0, len(services))
+	for name := range services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, service := range names {
+		ttl, _ := cfg.GetTTLForService(service)
+		for _, csn := range services[service] {
+			lookup := serviceLookup{
+				Datacenter:     dc,
+				Service:        service,
+				EnterpriseMeta: csn.Service.EnterpriseMeta,
+			}
+			serviceAddr := d.agent.TranslateServiceAddress(dc, csn.Service.Address, csn.Service.TaggedAddresses, accept)
+			nodeAddr := d.agent.TranslateAddress(csn.Node.Datacenter, csn.Node.Address, csn.Node.TaggedAddresses, accept)
+			port := d.agent.TranslateServicePort(dc, csn.Service.Port, csn.Service.TaggedAddresses)
+
+			addr := serviceAddr
+			if addr == "" {
+				addr = nodeAddr
+			}
+			ip := net.ParseIP(addr)
+
+			for _, name := range []string{
+				fmt.Sprintf("%s.service.%s.%s", service, dc, zone),
+				fmt.Sprintf("%s.service.%s", service, zone),
+			} {
+				// Point at the node record when the service uses the node
+				// address, like regular SRV lookups do.
+				var target string
+				switch {
+				case ip == nil:
+					target = dns.Fqdn(addr)
+				case serviceAddr == "" && nodeAddr == csn.Node.Address:
+					target = nodeCanonicalDNSName(lookup, csn.Node.Node, zone)
+				default:
+					target = d.encodeIPAsFqdn(name, lookup, ip)
+					add(zoneAddressRecord(target, addr, ttl))
+				}
+
+				// A hostname can only be served as a CNAME, which cannot
+				// share the name with the other instances' records.
+				if ip != nil {
+					add(zoneAddressRecord(name, addr, ttl))
+				}
+				add(&dns.SRV{
+					Hdr: dns.RR_Header{
+						Name:   name,
+						Rrtype: dns.TypeSRV,
+						Class:  dns.ClassINET,
+						Ttl:    uint32(ttl / time.Second),
+					},
+					Priority: 1,
+					Weight:   uint16(findWeight(csn)),
+					Port:     uint16(port),
+					Target:   target,
+				})
+			}
+		}
+	}
+
+	return records
+}
+
+// zoneAddressRecord returns an A or AAAA record for an IP address or a
+// CNAME record for a hostname.
+func zoneAddressRecord(name, addr string, ttl time.Duration) dns.RR {
+	if addr == "" {
+		return nil
+	}
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return &dns.CNAME{
+			Hdr: dns.RR_Header{
+				Name:   name,
+				Rrtype: dns.TypeCNAME,
+				Class:  dns.ClassINET,
+				Ttl:    uint32(ttl / time.Second),
+			},
+			Target: dns.Fqdn(addr),
+		}
+	}
+	rr := makeARecord(dns.TypeANY, ip, ttl)
+	rr.Header().Name = name
+	return rr
+}
@@ -0,0 +1,242 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package agent
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+func zoneTransfer(t *testing.T, addr string, m *dns.Msg, secrets map[string]string) []dns.RR {
+	t.Helper()
+
+	tr := &dns.Transfer{TsigSecret: secrets}
+	ch, err := tr.In(m, addr)
+	require.NoError(t, err)
+
+	var records []dns.RR
+	for env := range ch {
+		require.NoError(t, env.Error)
+		records = append(records, env.RR...)
+	}
+	return records
+}
+
+func TestDNS_ZoneTransfer(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := NewTestAgent(t, `
+		dns_config {
+			zone_transfer {
+				allowed_cidrs = ["127.0.0.0/8"]
+			}
+		}
+	`)
+	defer a.Shutdown()
+	testrpc.WaitForLeader(t, a.RPC, "dc1")
+
+	args := &structs.RegisterRequest{
+		Datacenter: "dc1",
+		Node:       "foo",
+		Address:    "127.0.0.1",
+		Service: &structs.NodeService{
+			Service: "db",
+			Address: "127.0.0.2",
+			Port:    12345,
+		},
+	}
+	var out struct{}
+	require.NoError(t, a.RPC(context.Background(), "Catalog.Register", args, &out))
+
+	m := new(dns.Msg)
+	m.SetAxfr("consul.")
+	records := zoneTransfer(t, a.DNSAddr(), m, nil)
+	require.GreaterOrEqual(t, len(records), 2)
+
+	first, ok := records[0].(*dns.SOA)
+	require.True(t, ok, "first record is not a SOA record")
+	last, ok := records[len(records)-1].(*dns.SOA)
+	require.True(t, ok, "last record is not a SOA record")
+	require.Equal(t, first.Serial, last.Serial)
+	require.NotZero(t, first.Serial)
+
+	byName := make(map[string][]dns.RR)
+	for _, rr := range records[1 : len(records)-1] {
+		byName[rr.Header().Name] = append(byName[rr.Header().Name], rr)
+	}
+
+	for _, name := range []string{"foo.node.dc1.consul.", "foo.node.consul."} {
+		require.Len(t, byName[name], 1, name)
+		aRec, ok := byName[name][0].(*dns.A)
+		require.True(t, ok, "%s is not an A record", name)
+		require.Equal(t, "127.0.0.1", aRec.A.String())
+	}
+
+	for _, name := range []string{"db.service.dc1.consul.", "db.service.consul."} {
+		var srv *dns.SRV
+		var aRec *dns.A
+		for _, rr := range byName[name] {
+			switch x := rr.(type) {
+			case *dns.SRV:
+				srv = x
+			case *dns.A:
+				aRec = x
+			}
+		}
+		require.NotNil(t, srv, "%s has no SRV record", name)
+		require.Equal(t, uint16(12345), srv.Port)
+		require.Equal(t, "7f000002.addr.dc1.consul.", srv.Target)
+		require.NotNil(t, aRec, "%s has no A record", name)
+		require.Equal(t, "127.0.0.2", aRec.A.String())
+	}
+	require.Len(t, byName["7f000002.addr.dc1.consul."], 1)
+
+	// The SOA served for regular queries carries the same serial.
+	m = new(dns.Msg)
+	m.SetQuestion("consul.", dns.TypeSOA)
+	in, _, err := new(dns.Client).Exchange(m, a.DNSAddr())
+	require.NoError(t, err)
+	require.Len(t, in.Answer, 1)
+	require.Equal(t, first.Serial, in.Answer[0].(*dns.SOA).Serial)
+
+	// An IXFR from the current serial only returns the SOA.
+	m = new(dns.Msg)
+	m.SetIxfr("consul.", first.Serial, "ns.consul.", "hostmaster.consul.")
+	records = zoneTransfer(t, a.DNSAddr(), m, nil)
+	require.Len(t, records, 1)
+	require.Equal(t, first.Serial, records[0].(*dns.SOA).Serial)
+
+	// Catalog changes bump the serial and an IXFR from an older serial
+	// returns the full zone.
+	args.Node = "bar"
+	args.Address = "127.0.0.3"
+	args.Service = nil
+	require.NoError(t, a.RPC(context.Background(), "Catalog.Register", args, &out))
+
+	records = zoneTransfer(t, a.DNSAddr(), m, nil)
+	require.Greater(t, len(records), 2)
+	require.Greater(t, records[0].(*dns.SOA).Serial, first.Serial)
+	var found bool
+	for _, rr := range records {
+		if rr.Header().Name == "bar.node.dc1.consul." {
+			found = true
+		}
+	}
+	require.True(t, found, "bar node missing from the transfer")
+
+	// AXFR is not allowed over UDP.
+	m = new(dns.Msg)
+	m.SetAxfr("consul.")
+	in, _, err = new(dns.Client).Exchange(m, a.DNSAddr())
+	require.NoError(t, err)
+	require.Equal(t, dns.RcodeFormatError, in.Rcode)
+
+	// Transfers are only served for the zone apex.
+	m = new(dns.Msg)
+	m.SetAxfr("service.consul.")
+	in, _, err = (&dns.Client{Net: "tcp"}).Exchange(m, a.DNSAddr())
+	require.NoError(t, err)
+	require.Equal(t, dns.RcodeNotAuth, in.Rcode)
+}
+
+func TestDNS_ZoneTransfer_Denied(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	cases := map[string]string{
+		"disabled": "",
+		"not allowed": `
+			dns_config {
+				zone_transfer {
+					allowed_cidrs = ["10.0.0.0/8"]
+				}
+			}
+		`,
+	}
+	for name, hcl := range cases {
+		t.Run(name, func(t *testing.T) {
+			a := NewTestAgent(t, hcl)
+			defer a.Shutdown()
+			testrpc.WaitForLeader(t, a.RPC, "dc1")
+
+			m := new(dns.Msg)
+			m.SetAxfr("consul.")
+			in, _, err := (&dns.Client{Net: "tcp"}).Exchange(m, a.DNSAddr())
+			require.NoError(t, err)
+			require.Equal(t, dns.RcodeRefused, in.Rcode)
+			require.Empty(t, in.Answer)
+
+			// The SOA serial isn't read from the catalog for clients that
+			// can't transfer the zone.
+			before := time.Now()
+			m = new(dns.Msg)
+			m.SetQuestion("consul.", dns.TypeSOA)
+			in, _, err = new(dns.Client).Exchange(m, a.DNSAddr())
+			require.NoError(t, err)
+			require.Len(t, in.Answer, 1)
+			require.GreaterOrEqual(t, in.Answer[0].(*dns.SOA).Serial, uint32(before.Unix()))
+		})
+	}
+}
+
+func TestDNS_ZoneTransfer_TSIG(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	const secret = "c2VjcmV0LXRzaWcta2V5LWZvci10ZXN0cw=="
+	a := NewTestAgent(t, `
+		dns_config {
+			zone_transfer {
+				allowed_cidrs = ["127.0.0.0/8"]
+				tsig_keys = [
+					{
+						name = "transfer"
+						secret = "`+secret+`"
+					}
+				]
+			}
+		}
+	`)
+	defer a.Shutdown()
+	testrpc.WaitForLeader(t, a.RPC, "dc1")
+
+	// Unsigned requests are rejected.
+	m := new(dns.Msg)
+	m.SetAxfr("consul.")
+	in, _, err := (&dns.Client{Net: "tcp"}).Exchange(m, a.DNSAddr())
+	require.NoError(t, err)
+	require.Equal(t, dns.RcodeNotAuth, in.Rcode)
+
+	// Requests signed with an unknown key are rejected.
+	m = new(dns.Msg)
+	m.SetAxfr("consul.")
+	m.SetTsig("other.", dns.HmacSHA256, 300, time.Now().Unix())
+	c := &dns.Client{Net: "tcp", TsigSecret: map[string]string{"other.": secret}}
+	in, _, err = c.Exchange(m, a.DNSAddr())
+	require.NoError(t, err)
+	require.Equal(t, dns.RcodeNotAuth, in.Rcode)
+
+	// Signed requests get a signed transfer.
+	m = new(dns.Msg)
+	m.SetAxfr("consul.")
+	m.SetTsig("transfer.", dns.HmacSHA256, 300, time.Now().Unix())
+	records := zoneTransfer(t, a.DNSAddr(), m, map[string]string{"transfer.": secret})
+	require.GreaterOrEqual(t, len(records), 2)
+	_, ok := records[0].(*dns.SOA)
+	require.True(t, ok, "first record is not a SOA record")
+}
@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package extauthz
+
+import (
+	"errors"
+	"fmt"
+
+	envoy_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_http_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_resource_v3 "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/envoyextensions/extensioncommon"
+)
+
+// extAuthz is a built-in Envoy extension that patches HTTP filter chains to
+// authorize requests with an external authorization service.
+type extAuthz struct {
+	name   string
+	config *extAuthzConfig
+}
+
+var (
+	_ extensioncommon.BasicExtension  = (*extAuthz)(nil)
+	_ extensioncommon.ClusterInserter = (*extAuthz)(nil)
+)
+
+// Constructor follows a specific function signature required for the extension registration.
+func Constructor(ext api.EnvoyExtension) (extensioncommon.EnvoyExtender, error) {
+	a, err := construct(ext)
+	if err != nil {
+		return nil, err
+	}
+	return &extensioncommon.BasicEnvoyExtender{
+		Extension: &a,
+	}, nil
+}
+
+func construct(ext api.EnvoyExtension) (extAuthz, error) {
+	a := extAuthz{name: ext.Name}
+
+	if a.name != api.BuiltinExtAuthzExtension {
+		return a, fmt.Errorf("expected extension name %q but got %q", api.BuiltinExtAuthzExtension, a.name)
+	}
+
+	if err := a.fromArguments(ext.Arguments); err != nil {
+		return a, err
+	}
+
+	return a, nil
+}
+
+func (a *extAuthz) fromArguments(args map[string]any) error {
+	var err error
+	a.config, err = newExtAuthzConfig(args)
+	if err != nil {
+		return fmt.Errorf("error decoding extension arguments: %w", err)
+	}
+	return a.config.validate()
+}
+
+// CanApply indicates if the extension can be applied to the given extension configuration.
+// Inbound configurations apply to the public listener of the local service, and outbound
+// configurations apply to the listeners of the upstream service the extension is configured for.
+func (a *extAuthz) CanApply(config *extensioncommon.RuntimeConfig) bool {
+	return config.Kind == a.config.ProxyType && a.matchesListenerDirection(config)
+}
+
+func (a *extAuthz) matchesListenerDirection(config *extensioncommon.RuntimeConfig) bool {
+	return (config.IsUpstream() && a.config.ListenerType == "outbound") ||
+		(!config.IsUpstream() && a.config.ListenerType == "inbound")
+}
+
+// InsertClusters adds the cluster of an authorization service with a static URI.
+func (a *extAuthz) InsertClusters(config *extensioncommon.RuntimeConfig) ([]*envoy_cluster_v3.Cluster, error) {
+	t := a.config.target()
+	if t.URI == "" {
+		return nil, nil
+	}
+	name, err := t.clusterName(config)
+	if err != nil {
+		return nil, err
+	}
+	c, err := t.cluster(name, a.config.GrpcService != nil)
+	if err != nil {
+		return nil, err
+	}
+	return []*envoy_cluster_v3.Cluster{c}, nil
+}
+
+// PatchRoute does nothing for the ext-authz extension.
+func (a *extAuthz) PatchRoute(_ *extensioncommon.RuntimeConfig, r *envoy_route_v3.RouteConfiguration) (*envoy_route_v3.RouteConfiguration, bool, error) {
+	return r, false, nil
+}
+
+// PatchCluster does nothing for the ext-authz extension.
+func (a *extAuthz) PatchCluster(_ *extensioncommon.RuntimeConfig, c *envoy_cluster_v3.Cluster) (*envoy_cluster_v3.Cluster, bool, error) {
+	return c, false, nil
+}
+
+// PatchFilter adds an ext_authz filter to the HTTP filter chain.
+// TCP listeners are not patched.
+func (a *extAuthz) PatchFilter(cfg *extensioncommon.RuntimeConfig, filter *envoy_listener_v3.Filter) (*envoy_listener_v3.Filter, bool, error) {
+	if filter.Name != "envoy.filters.network.http_connection_manager" {
+		return filter, false, nil
+	}
+	if typedConfig := filter.GetTypedConfig(); typedConfig == nil {
+		return filter, false, errors.New("failed to get typed config for http filter")
+	}
+
+	httpConnMgr := envoy_resource_v3.GetHTTPConnectionManager(filter)
+	if httpConnMgr == nil {
+		return filter, false, errors.New("failed to get HTTP connection manager")
+	}
+
+	authzConfig, err := a.config.envoyFilterConfig(cfg)
+	if err != nil {
+		return filter, false, fmt.Errorf("failed to encode Envoy ext_authz configuration: %w", err)
+	}
+
+	extHttpFilter, err := extensioncommon.MakeEnvoyHTTPFilter("envoy.filters.http.ext_authz", authzConfig)
+	if err != nil {
+		return filter, false, err
+	}
+
+	var (
+		changedFilters = make([]*envoy_http_v3.HttpFilter, 0, len(httpConnMgr.HttpFilters)+1)
+		changed        bool
+	)
+
+	// Insert the filter before `envoy.filters.http.router`, so that requests
+	// are authorized after intentions are checked by the RBAC filter.
+	for _, httpFilter := range httpConnMgr.HttpFilters {
+		if httpFilter.Name == "envoy.filters.http.router" {
+			changedFilters = append(changedFilters, extHttpFilter)
+			changed = true
+		}
+		changedFilters = append(changedFilters, httpFilter)
+	}
+	if !changed {
+		return filter, false, nil
+	}
+	httpConnMgr.HttpFilters = changedFilters
+
+	newFilter, err := extensioncommon.MakeFilter("envoy.filters.network.http_connection_manager", httpConnMgr)
+	if err != nil {
+		return filter, false, errors.New("error making new filter")
+	}
+
+	return newFilter, true, nil
+}
@@ -0,0 +1,373 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package extauthz
+
+import (
+	"testing"
+	"time"
+
+	envoy_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_ext_authz_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_authz/v3"
+	envoy_http_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/envoyextensions/extensioncommon"
+	"github.com/hashicorp/consul/proto/private/prototest"
+)
+
+func TestConstructor(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		name   string
+		args   map[string]any
+		errStr string
+	}{
+		"wrong name": {
+			name:   "builtin/other",
+			args:   map[string]any{"GrpcService": map[string]any{"Target": map[string]any{"URI": "127.0.0.1:9191"}}},
+			errStr: `expected extension name "builtin/ext-authz" but got "builtin/other"`,
+		},
+		"no service": {
+			args:   map[string]any{},
+			errStr: "exactly one of GrpcService or HttpService is required",
+		},
+		"both services": {
+			args: map[string]any{
+				"GrpcService": map[string]any{"Target": map[string]any{"URI": "127.0.0.1:9191"}},
+				"HttpService": map[string]any{"Target": map[string]any{"URI": "127.0.0.1:9191"}},
+			},
+			errStr: "exactly one of GrpcService or HttpService is required",
+		},
+		"invalid listener type": {
+			args: map[string]any{
+				"ListenerType": "sideways",
+				"GrpcService":  map[string]any{"Target": map[string]any{"URI": "127.0.0.1:9191"}},
+			},
+			errStr: `unsupported ListenerType "sideways"`,
+		},
+		"invalid proxy type": {
+			args: map[string]any{
+				"ProxyType":   "terminating-gateway",
+				"GrpcService": map[string]any{"Target": map[string]any{"URI": "127.0.0.1:9191"}},
+			},
+			errStr: `unsupported ProxyType "terminating-gateway"`,
+		},
+		"both targets": {
+			args: map[string]any{
+				"GrpcService": map[string]any{"Target": map[string]any{
+					"URI":     "127.0.0.1:9191",
+					"Service": map[string]any{"Name": "authz"},
+				}},
+			},
+			errStr: "Target must provide exactly one of Service or URI",
+		},
+		"no target": {
+			args:   map[string]any{"HttpService": map[string]any{}},
+			errStr: "Target must provide exactly one of Service or URI",
+		},
+		"missing port": {
+			args:   map[string]any{"GrpcService": map[string]any{"Target": map[string]any{"URI": "authz.example.com"}}},
+			errStr: "expected a host and port",
+		},
+		"https scheme": {
+			args:   map[string]any{"HttpService": map[string]any{"Target": map[string]any{"URI": "https://authz.example.com:443"}}},
+			errStr: `unsupported scheme "https"`,
+		},
+		"invalid timeout": {
+			args: map[string]any{"GrpcService": map[string]any{"Target": map[string]any{
+				"Service": map[string]any{"Name": "authz"},
+				"Timeout": "soon",
+			}}},
+			errStr: `failed to parse Target.Timeout "soon"`,
+		},
+		"invalid status on error": {
+			args: map[string]any{
+				"StatusOnError": 42,
+				"GrpcService":   map[string]any{"Target": map[string]any{"URI": "127.0.0.1:9191"}},
+			},
+			errStr: "StatusOnError 42 is not a valid HTTP status",
+		},
+		"header without key": {
+			args: map[string]any{"HttpService": map[string]any{
+				"Target":       map[string]any{"URI": "127.0.0.1:9191"},
+				"HeadersToAdd": []map[string]any{{"Value": "v"}},
+			}},
+			errStr: "header 0 is missing a Key",
+		},
+		"valid grpc service": {
+			args: map[string]any{"GrpcService": map[string]any{"Target": map[string]any{
+				"Service": map[string]any{"Name": "authz"},
+				"Timeout": "250ms",
+			}}},
+		},
+		"valid http service": {
+			args: map[string]any{
+				"ListenerType": "outbound",
+				"HttpService": map[string]any{
+					"Target":         map[string]any{"URI": "http://authz.example.com:8080"},
+					"AllowedHeaders": []string{"x-user"},
+				},
+			},
+		},
+	}
+
+	for name, c := range cases {
+		c := c
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			extName := api.BuiltinExtAuthzExtension
+			if c.name != "" {
+				extName = c.name
+			}
+			_, err := Constructor(api.EnvoyExtension{Name: extName, Arguments: c.args})
+			if c.errStr == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), c.errStr)
+			}
+		})
+	}
+}
+
+func TestExtAuthz(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		args      map[string]any
+		required  bool
+		upstream  bool
+		canApply  bool
+		expFilter *envoy_ext_authz_v3.ExtAuthz
+		expErr    string
+		// expCluster is the name of the cluster that is inserted, if any.
+		expCluster string
+	}{
+		"grpc upstream service": {
+			args: map[string]any{"GrpcService": map[string]any{
+				"Target":          map[string]any{"Service": map[string]any{"Name": "authz"}, "Timeout": "250ms"},
+				"Authority":       "authz.internal",
+				"InitialMetadata": []map[string]any{{"Key": "x-source", "Value": "web"}},
+			}},
+			required: true,
+			canApply: true,
+			expFilter: &envoy_ext_authz_v3.ExtAuthz{
+				TransportApiVersion: envoy_core_v3.ApiVersion_V3,
+				Services: &envoy_ext_authz_v3.ExtAuthz_GrpcService{
+					GrpcService: &envoy_core_v3.GrpcService{
+						TargetSpecifier: &envoy_core_v3.GrpcService_EnvoyGrpc_{
+							EnvoyGrpc: &envoy_core_v3.GrpcService_EnvoyGrpc{
+								ClusterName: "authz.default.dc1.internal.domain.consul",
+								Authority:   "authz.internal",
+							},
+						},
+						Timeout:         durationpb.New(250 * time.Millisecond),
+						InitialMetadata: []*envoy_core_v3.HeaderValue{{Key: "x-source", Value: "web"}},
+					},
+				},
+			},
+		},
+		"http static uri": {
+			args: map[string]any{
+				"StatusOnError":    503,
+				"FailureModeAllow": true,
+				"HttpService": map[string]any{
+					"Target":         map[string]any{"URI": "authz.example.com:8080"},
+					"PathPrefix":     "/check",
+					"AllowedHeaders": []string{"x-user"},
+					"HeadersToAdd":   []map[string]any{{"Key": "x-source", "Value": "web"}},
+				},
+			},
+			canApply: true,
+			expFilter: &envoy_ext_authz_v3.ExtAuthz{
+				TransportApiVersion: envoy_core_v3.ApiVersion_V3,
+				FailureModeAllow:    true,
+				StatusOnError:       &envoy_type_v3.HttpStatus{Code: envoy_type_v3.StatusCode_ServiceUnavailable},
+				Services: &envoy_ext_authz_v3.ExtAuthz_HttpService{
+					HttpService: &envoy_ext_authz_v3.HttpService{
+						ServerUri: &envoy_core_v3.HttpUri{
+							Uri:              "http://authz.example.com:8080",
+							HttpUpstreamType: &envoy_core_v3.HttpUri_Cluster{Cluster: "local_ext_authz_authz.example.com_8080"},
+							Timeout:          durationpb.New(time.Second),
+						},
+						PathPrefix: "/check",
+						AuthorizationRequest: &envoy_ext_authz_v3.AuthorizationRequest{
+							AllowedHeaders: &envoy_matcher_v3.ListStringMatcher{
+								Patterns: []*envoy_matcher_v3.StringMatcher{{
+									MatchPattern: &envoy_matcher_v3.StringMatcher_Exact{Exact: "x-user"},
+									IgnoreCase:   true,
+								}},
+							},
+							HeadersToAdd: []*envoy_core_v3.HeaderValue{{Key: "x-source", Value: "web"}},
+						},
+					},
+				},
+			},
+			expCluster: "local_ext_authz_authz.example.com_8080",
+		},
+		"outbound applies to upstreams": {
+			args: map[string]any{
+				"ListenerType": "outbound",
+				"GrpcService":  map[string]any{"Target": map[string]any{"URI": "127.0.0.1:9191"}},
+			},
+			upstream: true,
+			canApply: true,
+			expFilter: &envoy_ext_authz_v3.ExtAuthz{
+				TransportApiVersion: envoy_core_v3.ApiVersion_V3,
+				Services: &envoy_ext_authz_v3.ExtAuthz_GrpcService{
+					GrpcService: &envoy_core_v3.GrpcService{
+						TargetSpecifier: &envoy_core_v3.GrpcService_EnvoyGrpc_{
+							EnvoyGrpc: &envoy_core_v3.GrpcService_EnvoyGrpc{ClusterName: "local_ext_authz_127.0.0.1_9191"},
+						},
+						Timeout: durationpb.New(time.Second),
+					},
+				},
+			},
+			expCluster: "local_ext_authz_127.0.0.1_9191",
+		},
+		"inbound does not apply to upstreams": {
+			args:     map[string]any{"GrpcService": map[string]any{"Target": map[string]any{"URI": "127.0.0.1:9191"}}},
+			upstream: true,
+		},
+		"outbound does not apply to inbound": {
+			args: map[string]any{
+				"ListenerType": "outbound",
+				"GrpcService":  map[string]any{"Target": map[string]any{"URI": "127.0.0.1:9191"}},
+			},
+		},
+		"service is not an upstream": {
+			args:     map[string]any{"GrpcService": map[string]any{"Target": map[string]any{"Service": map[string]any{"Name": "other"}}}},
+			canApply: true,
+			expErr:   `no upstream found for authorization service "other"`,
+		},
+	}
+
+	for name, c := range cases {
+		c := c
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			rtCfg := makeTestRuntimeConfig(c.upstream)
+			rtCfg.EnvoyExtension = api.EnvoyExtension{
+				Name:      api.BuiltinExtAuthzExtension,
+				Required:  c.required,
+				Arguments: c.args,
+			}
+
+			a, err := construct(rtCfg.EnvoyExtension)
+			require.NoError(t, err)
+			require.Equal(t, c.canApply, a.CanApply(rtCfg))
+			if !c.canApply {
+				return
+			}
+
+			obsFilter, patched, err := a.PatchFilter(rtCfg, makeHttpConMgr(t, makeTestHttpFilters()))
+			if c.expErr != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), c.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, patched)
+
+			expFilter := makeHttpConMgr(t, []*envoy_http_v3.HttpFilter{
+				{Name: "one"},
+				{
+					Name:       "envoy.filters.http.ext_authz",
+					ConfigType: &envoy_http_v3.HttpFilter_TypedConfig{TypedConfig: makeAny(t, c.expFilter)},
+				},
+				{Name: "envoy.filters.http.router"},
+			})
+			prototest.AssertDeepEqual(t, expFilter, obsFilter)
+
+			clusters, err := a.InsertClusters(rtCfg)
+			require.NoError(t, err)
+			if c.expCluster == "" {
+				require.Empty(t, clusters)
+				return
+			}
+			require.Len(t, clusters, 1)
+			require.Equal(t, c.expCluster, clusters[0].Name)
+			require.Equal(t, c.expCluster, clusters[0].LoadAssignment.ClusterName)
+			_, http2 := clusters[0].TypedExtensionProtocolOptions["envoy.extensions.upstreams.http.v3.HttpProtocolOptions"]
+			require.Equal(t, a.config.GrpcService != nil, http2)
+		})
+	}
+}
+
+func TestExtAuthz_ClusterType(t *testing.T) {
+	t.Parallel()
+	for uri, exp := range map[string]envoy_cluster_v3.Cluster_DiscoveryType{
+		"127.0.0.1:9191":         envoy_cluster_v3.Cluster_STATIC,
+		"[::1]:9191":             envoy_cluster_v3.Cluster_STATIC,
+		"authz.example.com:9191": envoy_cluster_v3.Cluster_STRICT_DNS,
+	} {
+		a, err := construct(api.EnvoyExtension{
+			Name:      api.BuiltinExtAuthzExtension,
+			Arguments: map[string]any{"GrpcService": map[string]any{"Target": map[string]any{"URI": uri}}},
+		})
+		require.NoError(t, err)
+		clusters, err := a.InsertClusters(makeTestRuntimeConfig(false))
+		require.NoError(t, err)
+		require.Len(t, clusters, 1)
+		require.Equal(t, exp, clusters[0].GetType(), uri)
+	}
+}
+
+func makeAny(t *testing.T, m proto.Message) *anypb.Any {
+	t.Helper()
+	v, err := anypb.New(m)
+	require.NoError(t, err)
+	return v
+}
+
+func makeHttpConMgr(t *testing.T, filters []*envoy_http_v3.HttpFilter) *envoy_listener_v3.Filter {
+	t.Helper()
+	return &envoy_listener_v3.Filter{
+		Name: "envoy.filters.network.http_connection_manager",
+		ConfigType: &envoy_listener_v3.Filter_TypedConfig{
+			TypedConfig: makeAny(t, &envoy_http_v3.HttpConnectionManager{
+				HttpFilters: filters,
+			}),
+		},
+	}
+}
+
+func makeTestHttpFilters() []*envoy_http_v3.HttpFilter {
+	return []*envoy_http_v3.HttpFilter{
+		{Name: "one"},
+		{Name: "envoy.filters.http.router"},
+	}
+}
+
+func makeTestRuntimeConfig(upstream bool) *extensioncommon.RuntimeConfig {
+	authz := api.CompoundServiceName{Name: "authz", Namespace: "default", Partition: "default"}
+	upstreams := map[api.CompoundServiceName]*extensioncommon.UpstreamData{
+		authz: {
+			SNI:     map[string]struct{}{"authz.default.dc1.internal.domain.consul": {}},
+			EnvoyID: "authz",
+		},
+		{Name: "db", Namespace: "default", Partition: "default"}: {
+			SNI:     map[string]struct{}{"db.default.dc1.internal.domain.consul": {}},
+			EnvoyID: "db",
+		},
+	}
+	rtCfg := &extensioncommon.RuntimeConfig{
+		Kind:        api.ServiceKindConnectProxy,
+		ServiceName: api.CompoundServiceName{Name: "web", Namespace: "default", Partition: "default"},
+	}
+	if upstream {
+		rtCfg.ServiceName = api.CompoundServiceName{Name: "db", Namespace: "default", Partition: "default"}
+		rtCfg.Upstreams = upstreams
+	} else {
+		rtCfg.LocalUpstreams = upstreams
+	}
+	return rtCfg
+}
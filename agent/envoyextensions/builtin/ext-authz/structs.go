@@ -0,0 +1,397 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package extauthz
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	envoy_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoy_ext_authz_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_authz/v3"
+	envoy_upstreams_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
+	envoy_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/hashicorp/go-multierror"
+	"github.com/mitchellh/mapstructure"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/envoyextensions/extensioncommon"
+)
+
+const (
+	// defaultTimeout is the time Envoy waits for the authorization service
+	// when no Timeout is configured.
+	defaultTimeout = time.Second
+
+	// localClusterPrefix prefixes the names of the clusters added for
+	// authorization services with a static URI.
+	localClusterPrefix = "local_ext_authz_"
+)
+
+// extAuthzConfig defines the configuration for an external authorization
+// Envoy extension.
+type extAuthzConfig struct {
+	// ProxyType identifies the type of Envoy proxy that this extension applies to.
+	// The extension will only be configured for proxies that match this type and
+	// will be ignored for all other proxy types.
+	ProxyType api.ServiceKind
+	// ListenerType identifies the listener type the filter will be applied to,
+	// "inbound" or "outbound".
+	ListenerType string
+	// GrpcService configures an authorization service implementing the
+	// envoy.service.auth.v3.Authorization gRPC service.
+	GrpcService *grpcService
+	// HttpService configures an authorization service over plain HTTP.
+	HttpService *httpService
+	// StatusOnError is the HTTP status returned to the client when the
+	// authorization service cannot be reached and failures are not allowed.
+	// Envoy returns 403 by default.
+	StatusOnError int
+	// FailureModeAllow lets requests through when the authorization
+	// service cannot be reached or returns an error. Requests are denied
+	// by default.
+	FailureModeAllow bool
+}
+
+// grpcService defines an authorization service called over gRPC.
+type grpcService struct {
+	// Target is the location of the authorization service.
+	Target target
+	// Authority is the :authority header of the requests to the service.
+	// It defaults to the name of the cluster the service is reached through.
+	Authority string
+	// InitialMetadata holds the headers sent along with each check request.
+	InitialMetadata []headerValue
+}
+
+// httpService defines an authorization service called over HTTP.
+type httpService struct {
+	// Target is the location of the authorization service.
+	Target target
+	// PathPrefix is prepended to the path of the original request when
+	// calling the service.
+	PathPrefix string
+	// AllowedHeaders lists the headers of the original request that are
+	// sent to the service in addition to Host, Method, Path, Content-Length
+	// and Authorization.
+	AllowedHeaders []string
+	// HeadersToAdd holds the headers added to each authorization request.
+	HeadersToAdd []headerValue
+}
+
+// target defines where an authorization service is reached, either through
+// an upstream of the proxy or at a static URI.
+type target struct {
+	// Service is an upstream service of the proxy.
+	Service api.CompoundServiceName
+	// URI is the address of a service outside of the mesh, as "host:port"
+	// or "http://host:port".
+	URI string
+	// Timeout is the maximum duration of an authorization request.
+	Timeout string
+
+	timeout time.Duration
+	host    string
+	port    int
+}
+
+// headerValue is a header name and value.
+type headerValue struct {
+	Key   string
+	Value string
+}
+
+// newExtAuthzConfig decodes the extension arguments into a configuration.
+func newExtAuthzConfig(args map[string]any) (*extAuthzConfig, error) {
+	cfg := &extAuthzConfig{}
+	if err := mapstructure.Decode(args, cfg); err != nil {
+		return cfg, err
+	}
+	cfg.normalize()
+	return cfg, nil
+}
+
+func (c *extAuthzConfig) normalize() {
+	if c.ProxyType == "" {
+		c.ProxyType = api.ServiceKindConnectProxy
+	}
+	if c.ListenerType == "" {
+		c.ListenerType = "inbound"
+	}
+	if t := c.target(); t != nil {
+		if t.Service.Name != "" {
+			t.Service.Namespace = acl.NamespaceOrDefault(t.Service.Namespace)
+			t.Service.Partition = acl.PartitionOrDefault(t.Service.Partition)
+		}
+		t.timeout = defaultTimeout
+	}
+}
+
+// target returns the target of the configured service, or nil when there is
+// none.
+func (c *extAuthzConfig) target() *target {
+	switch {
+	case c.GrpcService != nil:
+		return &c.GrpcService.Target
+	case c.HttpService != nil:
+		return &c.HttpService.Target
+	}
+	return nil
+}
+
+// validate ensures the configuration is valid or it returns an error.
+// This method must be called before using the configuration.
+func (c *extAuthzConfig) validate() error {
+	var resultErr error
+	if c.ProxyType != api.ServiceKindConnectProxy {
+		resultErr = multierror.Append(resultErr, fmt.Errorf("unsupported ProxyType %q, only %q is supported", c.ProxyType, api.ServiceKindConnectProxy))
+	}
+	if c.ListenerType != "inbound" && c.ListenerType != "outbound" {
+		resultErr = multierror.Append(resultErr, fmt.Errorf(`unsupported ListenerType %q, expected "inbound" or "outbound"`, c.ListenerType))
+	}
+	if (c.GrpcService == nil) == (c.HttpService == nil) {
+		return multierror.Append(resultErr, fmt.Errorf("exactly one of GrpcService or HttpService is required"))
+	}
+	if c.StatusOnError != 0 && (c.StatusOnError < 100 || c.StatusOnError > 599) {
+		resultErr = multierror.Append(resultErr, fmt.Errorf("StatusOnError %d is not a valid HTTP status", c.StatusOnError))
+	}
+
+	headers := c.HttpService.headersToAdd()
+	if c.GrpcService != nil {
+		headers = c.GrpcService.InitialMetadata
+	}
+	for i, h := range headers {
+		if h.Key == "" {
+			resultErr = multierror.Append(resultErr, fmt.Errorf("header %d is missing a Key", i))
+		}
+	}
+
+	if err := c.target().validate(c.GrpcService != nil); err != nil {
+		resultErr = multierror.Append(resultErr, err)
+	}
+	return resultErr
+}
+
+func (s *httpService) headersToAdd() []headerValue {
+	if s == nil {
+		return nil
+	}
+	return s.HeadersToAdd
+}
+
+func (t *target) validate(grpc bool) error {
+	var resultErr error
+	if (t.Service.Name == "") == (t.URI == "") {
+		return fmt.Errorf("Target must provide exactly one of Service or URI")
+	}
+
+	if t.Timeout != "" {
+		d, err := time.ParseDuration(t.Timeout)
+		switch {
+		case err != nil:
+			resultErr = multierror.Append(resultErr, fmt.Errorf("failed to parse Target.Timeout %q as a duration: %w", t.Timeout, err))
+		case d <= 0:
+			resultErr = multierror.Append(resultErr, fmt.Errorf("Target.Timeout %q must be greater than zero", t.Timeout))
+		default:
+			t.timeout = d
+		}
+	}
+
+	if t.URI == "" {
+		return resultErr
+	}
+	hostPort := t.URI
+	if strings.Contains(t.URI, "://") {
+		u, err := url.Parse(t.URI)
+		if err != nil {
+			return multierror.Append(resultErr, fmt.Errorf("invalid Target.URI: %w", err))
+		}
+		if u.Scheme != "http" {
+			return multierror.Append(resultErr, fmt.Errorf("unsupported scheme %q in Target.URI, expected \"http\"", u.Scheme))
+		}
+		if grpc && u.Path != "" {
+			resultErr = multierror.Append(resultErr, fmt.Errorf("Target.URI of a GrpcService must not have a path"))
+		}
+		hostPort = u.Host
+	}
+	host, port, err := net.SplitHostPort(hostPort)
+	if err != nil {
+		return multierror.Append(resultErr, fmt.Errorf("invalid Target.URI %q, expected a host and port: %w", t.URI, err))
+	}
+	t.host = host
+	t.port, err = strconv.Atoi(port)
+	if err != nil || t.port < 1 || t.port > 65535 {
+		resultErr = multierror.Append(resultErr, fmt.Errorf("invalid port %q in Target.URI", port))
+	}
+	return resultErr
+}
+
+// clusterName returns the name of the cluster the target is reached
+// through.
+func (t *target) clusterName(rtCfg *extensioncommon.RuntimeConfig) (string, error) {
+	if t.URI != "" {
+		return fmt.Sprintf("%s%s_%d", localClusterPrefix, t.host, t.port), nil
+	}
+
+	// The service must be an upstream of the proxy, so that Envoy has a
+	// cluster for it. Upstreams have one SNI per data center or peer they are
+	// reached in, and the first one is picked so the cluster is stable.
+	for _, upstreams := range []map[api.CompoundServiceName]*extensioncommon.UpstreamData{rtCfg.LocalUpstreams, rtCfg.Upstreams} {
+		upstream, ok := upstreams[t.Service]
+		if !ok || len(upstream.SNI) == 0 {
+			continue
+		}
+		snis := make([]string, 0, len(upstream.SNI))
+		for sni := range upstream.SNI {
+			snis = append(snis, sni)
+		}
+		sort.Strings(snis)
+		return snis[0], nil
+	}
+	return "", fmt.Errorf("no upstream found for authorization service %q", t.Service.Name)
+}
+
+// cluster returns the cluster for a target with a static URI.
+func (t *target) cluster(name string, grpc bool) (*envoy_cluster_v3.Cluster, error) {
+	discoveryType := envoy_cluster_v3.Cluster_STRICT_DNS
+	if net.ParseIP(t.host) != nil {
+		discoveryType = envoy_cluster_v3.Cluster_STATIC
+	}
+
+	c := &envoy_cluster_v3.Cluster{
+		Name:                 name,
+		ClusterDiscoveryType: &envoy_cluster_v3.Cluster_Type{Type: discoveryType},
+		ConnectTimeout:       durationpb.New(t.timeout),
+		LoadAssignment: &envoy_endpoint_v3.ClusterLoadAssignment{
+			ClusterName: name,
+			Endpoints: []*envoy_endpoint_v3.LocalityLbEndpoints{{
+				LbEndpoints: []*envoy_endpoint_v3.LbEndpoint{{
+					HostIdentifier: &envoy_endpoint_v3.LbEndpoint_Endpoint{
+						Endpoint: &envoy_endpoint_v3.Endpoint{
+							Address: &envoy_core_v3.Address{
+								Address: &envoy_core_v3.Address_SocketAddress{
+									SocketAddress: &envoy_core_v3.SocketAddress{
+										Address:       t.host,
+										PortSpecifier: &envoy_core_v3.SocketAddress_PortValue{PortValue: uint32(t.port)},
+									},
+								},
+							},
+						},
+					},
+				}},
+			}},
+		},
+	}
+
+	if grpc {
+		opts, err := anypb.New(&envoy_upstreams_v3.HttpProtocolOptions{
+			UpstreamProtocolOptions: &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig_{
+				ExplicitHttpConfig: &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig{
+					ProtocolConfig: &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig_Http2ProtocolOptions{
+						Http2ProtocolOptions: &envoy_core_v3.Http2ProtocolOptions{},
+					},
+				},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		c.TypedExtensionProtocolOptions = map[string]*anypb.Any{
+			"envoy.extensions.upstreams.http.v3.HttpProtocolOptions": opts,
+		}
+	}
+	return c, nil
+}
+
+// envoyFilterConfig returns the configuration of the Envoy ext_authz HTTP
+// filter.
+func (c *extAuthzConfig) envoyFilterConfig(rtCfg *extensioncommon.RuntimeConfig) (*envoy_ext_authz_v3.ExtAuthz, error) {
+	t := c.target()
+	clusterName, err := t.clusterName(rtCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &envoy_ext_authz_v3.ExtAuthz{
+		TransportApiVersion: envoy_core_v3.ApiVersion_V3,
+		FailureModeAllow:    c.FailureModeAllow,
+	}
+	if c.StatusOnError != 0 {
+		cfg.StatusOnError = &envoy_type_v3.HttpStatus{Code: envoy_type_v3.StatusCode(c.StatusOnError)}
+	}
+
+	if s := c.GrpcService; s != nil {
+		cfg.Services = &envoy_ext_authz_v3.ExtAuthz_GrpcService{
+			GrpcService: &envoy_core_v3.GrpcService{
+				TargetSpecifier: &envoy_core_v3.GrpcService_EnvoyGrpc_{
+					EnvoyGrpc: &envoy_core_v3.GrpcService_EnvoyGrpc{
+						ClusterName: clusterName,
+						Authority:   s.Authority,
+					},
+				},
+				Timeout:         durationpb.New(t.timeout),
+				InitialMetadata: envoyHeaderValues(s.InitialMetadata),
+			},
+		}
+		return cfg, nil
+	}
+
+	s := c.HttpService
+	uri := t.URI
+	if uri == "" {
+		uri = "http://" + t.Service.Name
+	} else if !strings.Contains(uri, "://") {
+		uri = "http://" + uri
+	}
+	var allowedHeaders *envoy_matcher_v3.ListStringMatcher
+	if len(s.AllowedHeaders) > 0 {
+		allowedHeaders = &envoy_matcher_v3.ListStringMatcher{}
+		for _, h := range s.AllowedHeaders {
+			allowedHeaders.Patterns = append(allowedHeaders.Patterns, &envoy_matcher_v3.StringMatcher{
+				MatchPattern: &envoy_matcher_v3.StringMatcher_Exact{Exact: h},
+				IgnoreCase:   true,
+			})
+		}
+	}
+	var authzRequest *envoy_ext_authz_v3.AuthorizationRequest
+	if allowedHeaders != nil || len(s.HeadersToAdd) > 0 {
+		authzRequest = &envoy_ext_authz_v3.AuthorizationRequest{
+			AllowedHeaders: allowedHeaders,
+			HeadersToAdd:   envoyHeaderValues(s.HeadersToAdd),
+		}
+	}
+	cfg.Services = &envoy_ext_authz_v3.ExtAuthz_HttpService{
+		HttpService: &envoy_ext_authz_v3.HttpService{
+			ServerUri: &envoy_core_v3.HttpUri{
+				Uri:              uri,
+				HttpUpstreamType: &envoy_core_v3.HttpUri_Cluster{Cluster: clusterName},
+				Timeout:          durationpb.New(t.timeout),
+			},
+			PathPrefix:           s.PathPrefix,
+			AuthorizationRequest: authzRequest,
+		},
+	}
+	return cfg, nil
+}
+
+func envoyHeaderValues(headers []headerValue) []*envoy_core_v3.HeaderValue {
+	if len(headers) == 0 {
+		return nil
+	}
+	values := make([]*envoy_core_v3.HeaderValue, 0, len(headers))
+	for _, h := range headers {
+		values = append(values, &envoy_core_v3.HeaderValue{Key: h.Key, Value: h.Value})
+	}
+	return values
+}
@@ -80,9 +80,11 @@ func (s *Server) GetEnvoyBootstrapParams(ctx context.Context, req *pbdataplane.G
 
 	// Inspect access logging
 	// This is non-essential, and don't want to return an error unless there is a more serious issue
+	// Logs sent to a service over gRPC are skipped, because the cluster of the
+	// service is not part of the bootstrap configuration.
 	var accessLogs []string
-	if ns != nil && ns.Proxy.AccessLogs.Enabled {
-		envoyLoggers, err := accesslogs.MakeAccessLogs(&ns.Proxy.AccessLogs, false)
+	if ns != nil && ns.Proxy.AccessLogs.Enabled && !ns.Proxy.AccessLogs.Type.IsGRPC() {
+		envoyLoggers, err := accesslogs.MakeAccessLogs(&ns.Proxy.AccessLogs, false, "")
 		if err != nil {
 			logger.Warn("Error creating the envoy access log config", "error", err)
 		}
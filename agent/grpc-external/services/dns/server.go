@@ -83,10 +83,10 @@ func (b *BufferResponseWriter) Close() error {
 	return nil
 }
 
-// TsigStatus returns the status of the Tsig.
+// TsigStatus returns the status of the Tsig. TSIG signatures are not
+// verified for gRPC queries, so signed queries are never trusted.
 func (b *BufferResponseWriter) TsigStatus() error {
-	// TSIG doesn't apply to this response writer.
-	return nil
+	return fmt.Errorf("TSIG is not supported for DNS over gRPC")
 }
 
 // TsigTimersOnly sets the tsig timers only boolean.
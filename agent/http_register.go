@@ -65,6 +65,8 @@ func init() {
 	registerEndpoint("/v1/catalog/node/", []string{"GET"}, (*HTTPHandlers).CatalogNodeServices)
 	registerEndpoint("/v1/catalog/node-services/", []string{"GET"}, (*HTTPHandlers).CatalogNodeServiceList)
 	registerEndpoint("/v1/catalog/gateway-services/", []string{"GET"}, (*HTTPHandlers).CatalogGatewayServices)
+	registerEndpoint("/v1/catalog/maintenance", []string{"GET", "PUT"}, (*HTTPHandlers).CatalogMaintenanceWindows)
+	registerEndpoint("/v1/catalog/maintenance/", []string{"DELETE"}, (*HTTPHandlers).CatalogMaintenanceWindowDelete)
 	registerEndpoint("/v1/config/", []string{"GET", "DELETE"}, (*HTTPHandlers).Config)
 	registerEndpoint("/v1/config", []string{"PUT"}, (*HTTPHandlers).ConfigApply)
 	registerEndpoint("/v1/connect/ca/configuration", []string{"GET", "PUT"}, (*HTTPHandlers).ConnectCAConfiguration)
@@ -105,6 +107,8 @@ func init() {
 	registerEndpoint("/v1/operator/raft/transfer-leader", []string{"POST"}, (*HTTPHandlers).OperatorRaftTransferLeader)
 	registerEndpoint("/v1/operator/raft/peer", []string{"DELETE"}, (*HTTPHandlers).OperatorRaftPeer)
 	registerEndpoint("/v1/operator/keyring", []string{"GET", "POST", "PUT", "DELETE"}, (*HTTPHandlers).OperatorKeyringEndpoint)
+	registerEndpoint("/v1/operator/dnssec/keys", []string{"GET", "DELETE"}, (*HTTPHandlers).OperatorDNSSECKeys)
+	registerEndpoint("/v1/operator/dnssec/rotate", []string{"PUT"}, (*HTTPHandlers).OperatorDNSSECRotate)
 	registerEndpoint("/v1/operator/usage", []string{"GET"}, (*HTTPHandlers).OperatorUsage)
 	registerEndpoint("/v1/operator/autopilot/configuration", []string{"GET", "PUT"}, (*HTTPHandlers).OperatorAutopilotConfiguration)
 	registerEndpoint("/v1/operator/autopilot/health", []string{"GET"}, (*HTTPHandlers).OperatorServerHealth)
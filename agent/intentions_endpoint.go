@@ -6,6 +6,7 @@ package agent
 import (
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
 
 	"github.com/hashicorp/consul/acl"
@@ -256,6 +257,11 @@ func (s *HTTPHandlers) IntentionCheck(resp http.ResponseWriter, req *http.Reques
 	args.Check.DestinationNS = parsed.ns
 	args.Check.DestinationName = parsed.name
 
+	// An optional HTTP request to evaluate against L7 permissions
+	if err := parseIntentionCheckHTTPRequest(q, args.Check); err != nil {
+		return nil, err
+	}
+
 	var reply structs.IntentionQueryCheckResponse
 	if err := s.agent.RPC(req.Context(), "Intention.Check", args, &reply); err != nil {
 		return nil, err
@@ -264,6 +270,34 @@ func (s *HTTPHandlers) IntentionCheck(resp http.ResponseWriter, req *http.Reques
 	return &reply, nil
 }
 
+// parseIntentionCheckHTTPRequest parses the optional http-method, http-path
+// and http-header query parameters of an intention check. The HTTP request is
+// only set if any of them are present.
+func parseIntentionCheckHTTPRequest(q url.Values, check *structs.IntentionQueryCheck) error {
+	httpReq := &structs.IntentionCheckHTTPRequest{
+		Method: q.Get("http-method"),
+		Path:   q.Get("http-path"),
+	}
+	for _, hdr := range q["http-header"] {
+		// Pseudo-headers such as :authority start with a colon.
+		name, value, ok := strings.Cut(strings.TrimPrefix(hdr, ":"), ":")
+		if !ok || strings.TrimSpace(name) == "" {
+			return fmt.Errorf("http-header %q must be in the form 'name:value'", hdr)
+		}
+		if strings.HasPrefix(hdr, ":") {
+			name = ":" + name
+		}
+		if httpReq.Header == nil {
+			httpReq.Header = make(map[string]string)
+		}
+		httpReq.Header[strings.TrimSpace(name)] = strings.TrimSpace(value)
+	}
+	if httpReq.Method != "" || httpReq.Path != "" || httpReq.Header != nil {
+		check.HTTP = httpReq
+	}
+	return nil
+}
+
 // IntentionExact handles the endpoint for /v1/connect/intentions/exact
 func (s *HTTPHandlers) IntentionExact(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
 	switch req.Method {
@@ -203,6 +203,10 @@ type State struct {
 	checks       map[structs.CheckID]*CheckState
 	checkAliases map[structs.ServiceID]map[structs.CheckID]chan<- struct{}
 
+	// serviceWeights tracks the weights reported by the checks of services,
+	// along with the weights the services were registered with.
+	serviceWeights map[structs.ServiceID]*reportedWeights
+
 	// metadata tracks the node metadata fields
 	metadata map[string]string
 
@@ -229,6 +233,7 @@ func NewState(c Config, logger hclog.Logger, tokens *token.Store) *State {
 		services:            make(map[structs.ServiceID]*ServiceState),
 		checks:              make(map[structs.CheckID]*CheckState),
 		checkAliases:        make(map[structs.ServiceID]map[structs.CheckID]chan<- struct{}),
+		serviceWeights:      make(map[structs.ServiceID]*reportedWeights),
 		metadata:            make(map[string]string),
 		tokens:              tokens,
 		notifyHandlers:      make(map[chan<- struct{}]struct{}),
@@ -291,6 +296,12 @@ func (l *State) addServiceLocked(service *structs.NodeService, token string, isL
 		return fmt.Errorf("cannot add service ID %q to node in partition %q", service.CompoundServiceID(), l.config.Partition)
 	}
 
+	// Keep applying the weights reported by the checks of the service.
+	if rw := l.serviceWeights[service.CompoundServiceID()]; rw != nil {
+		rw.registered = service.Weights
+		service.Weights = rw.weights()
+	}
+
 	l.setServiceStateLocked(&ServiceState{
 		Service:          service,
 		Token:            token,
@@ -367,6 +378,7 @@ func (l *State) removeServiceLocked(id structs.ServiceID) error {
 		close(s.WatchCh)
 		s.WatchCh = nil
 	}
+	delete(l.serviceWeights, id)
 
 	l.notifyIfAliased(id)
 	l.TriggerSyncChanges()
@@ -457,6 +469,97 @@ func (l *State) ServiceState(id structs.ServiceID) *ServiceState {
 	return s.Clone()
 }
 
+// reportedWeights holds the passing weights reported by the checks of a
+// service and the weights the service was registered with.
+type reportedWeights struct {
+	registered *structs.Weights
+	checks     map[structs.CheckID]int
+}
+
+// weights returns the weights of the service. If several checks report a
+// weight, the lowest one overrides the registered passing weight. Without
+// any, the registered weights apply.
+func (rw *reportedWeights) weights() *structs.Weights {
+	if len(rw.checks) == 0 {
+		return rw.registered
+	}
+
+	weights := structs.Weights{Warning: 1}
+	if rw.registered != nil {
+		weights.Warning = rw.registered.Warning
+	}
+	for _, weight := range rw.checks {
+		if weights.Passing == 0 || weight < weights.Passing {
+			weights.Passing = weight
+		}
+	}
+	return &weights
+}
+
+// UpdateServiceWeight records the passing weight reported by one of the
+// checks of a service, and overrides the passing weight of the service with
+// it. A weight of 0 means that the check no longer reports one. Once none of
+// its checks do, the weights the service was registered with are restored.
+// The updated service is synced to the servers so that DNS and Connect
+// proxies pick up the new weight.
+func (l *State) UpdateServiceWeight(id structs.ServiceID, checkID structs.CheckID, weight int) {
+	l.Lock()
+	defer l.Unlock()
+
+	s := l.services[id]
+	if s == nil || s.Deleted {
+		return
+	}
+
+	rw := l.serviceWeights[id]
+	if weight > 0 {
+		if rw == nil {
+			rw = &reportedWeights{
+				registered: s.Service.Weights,
+				checks:     make(map[structs.CheckID]int),
+			}
+			l.serviceWeights[id] = rw
+		}
+		rw.checks[checkID] = weight
+	} else {
+		if rw == nil {
+			return
+		}
+		if _, ok := rw.checks[checkID]; !ok {
+			return
+		}
+		delete(rw.checks, checkID)
+	}
+	l.applyServiceWeightsLocked(id)
+}
+
+// applyServiceWeightsLocked updates the weights of a service with those
+// reported by its checks.
+func (l *State) applyServiceWeightsLocked(id structs.ServiceID) {
+	s := l.services[id]
+	rw := l.serviceWeights[id]
+	if s == nil || s.Deleted || rw == nil {
+		return
+	}
+
+	weights := rw.weights()
+	if len(rw.checks) == 0 {
+		delete(l.serviceWeights, id)
+	}
+	if reflect.DeepEqual(weights, s.Service.Weights) {
+		return
+	}
+
+	// The stored service must not be modified, so update a copy.
+	svc := *s.Service
+	svc.Weights = weights
+	l.setServiceStateLocked(&ServiceState{
+		Service:          &svc,
+		Token:            s.Token,
+		IsLocallyDefined: s.IsLocallyDefined,
+	})
+}
+
 // SetServiceState is used to overwrite a raw service state with the given
 // state. This method is safe to be called concurrently but should only be used
 // during testing. You should most likely call AddService instead.
@@ -656,6 +759,20 @@ func (l *State) removeCheckLocked(id structs.CheckID) error {
 	// If this is a check for an aliased service, then notify the waiters.
 	l.notifyIfAliased(c.Check.CompoundServiceID())
 
+	// Release the checks that depend on this one. This has to happen
+	// before the check is marked as deleted, as deleted checks are no
+	// longer found as a dependency.
+	l.updateDependentChecksLocked(id, false)
+
+	// Stop applying the weight the check reported.
+	sid := c.Check.CompoundServiceID()
+	if rw := l.serviceWeights[sid]; rw != nil {
+		if _, ok := rw.checks[id]; ok {
+			delete(rw.checks, id)
+			l.applyServiceWeightsLocked(sid)
+		}
+	}
+
 	// To remove the check on the server we need the token.
 	// Therefore, we mark the service as deleted and keep the
 	// entry around until it is actually removed.
@@ -680,6 +797,14 @@ func (l *State) UpdateCheck(id structs.CheckID, status, output string) {
 		output = ""
 	}
 
+	// A check that is not passing while the check it depends on is critical
+	// is suppressed, so that a single failure does not cascade into alerts
+	// for every check on the node.
+	suppressed := status != api.HealthPassing && l.dependencyCriticalLocked(c.Check)
+	if suppressed {
+		output = suppressedOutput(c.Check.DependsOn, output)
+	}
+
 	// Update the critical time tracking (this doesn't cause a server updates
 	// so we can always keep this up to date).
 	if status == api.HealthCritical {
@@ -691,7 +816,7 @@ func (l *State) UpdateCheck(id structs.CheckID, status, output string) {
 	}
 
 	// Do nothing if update is idempotent
-	if c.Check.Status == status && c.Check.Output == output {
+	if c.Check.Status == status && c.Check.Output == output && c.Check.Suppressed == suppressed {
 		return
 	}
 
@@ -711,7 +836,7 @@ func (l *State) UpdateCheck(id structs.CheckID, status, output string) {
 	// frequent updates of output. Instead, we update the output internally,
 	// and periodically do a write-back to the servers. If there is a status
 	// change we do the write immediately.
-	if l.config.CheckUpdateInterval > 0 && c.Check.Status == status {
+	if l.config.CheckUpdateInterval > 0 && c.Check.Status == status && c.Check.Suppressed == suppressed {
 		c.Check.Output = output
 		if c.DeferCheck == nil {
 			d := l.config.CheckUpdateInterval
@@ -738,13 +863,78 @@ func (l *State) UpdateCheck(id structs.CheckID, status, output string) {
 	// If this is a check for an aliased service, then notify the waiters.
 	l.notifyIfAliased(c.Check.CompoundServiceID())
 
+	// Checks that depend on this one are suppressed or released when it
+	// enters or leaves the critical state.
+	if (status == api.HealthCritical) != (c.Check.Status == api.HealthCritical) {
+		l.updateDependentChecksLocked(id, status == api.HealthCritical)
+	}
+
 	// Update status and mark out of sync
 	c.Check.Status = status
 	c.Check.Output = output
+	c.Check.Suppressed = suppressed
 	c.InSync = false
 	l.TriggerSyncChanges()
 }
 
+// suppressedOutput returns the output of a check that is suppressed because
+// the check it depends on is critical.
+func suppressedOutput(dependsOn types.CheckID, output string) string {
+	return fmt.Sprintf("Suppressed: dependency check %q is critical\n", dependsOn) + output
+}
+
+// dependencyLocked returns the state of the check that the given check depends
+// on, or nil if it has no dependency or the dependency is not registered. The
+// dependency is looked up in the namespace of the check first and then in
+// the default namespace of the agent, where node checks are registered.
+func (l *State) dependencyLocked(check *structs.HealthCheck) *CheckState {
+	if check.DependsOn == "" {
+		return nil
+	}
+	for _, entMeta := range []*acl.EnterpriseMeta{&check.EnterpriseMeta, &l.agentEnterpriseMeta} {
+		if c := l.checks[structs.NewCheckID(check.DependsOn, entMeta)]; c != nil && !c.Deleted {
+			return c
+		}
+	}
+	return nil
+}
+
+// dependencyCriticalLocked returns true if the check that the given check
+// depends on is critical.
+func (l *State) dependencyCriticalLocked(check *structs.HealthCheck) bool {
+	dep := l.dependencyLocked(check)
+	return dep != nil && dep.Check.Status == api.HealthCritical
+}
+
+// updateDependentChecksLocked suppresses the non-passing checks that depend on
+// the check with the given ID when it becomes critical, and releases them
+// when it stops being critical or is removed.
+func (l *State) updateDependentChecksLocked(id structs.CheckID, critical bool) {
+	for checkID, c := range l.checks {
+		if c.Deleted || c.Check.DependsOn == "" {
+			continue
+		}
+		if dep := l.dependencyLocked(c.Check); dep == nil || dep.Check.CompoundCheckID() != id {
+			continue
+		}
+
+		suppressed := critical && c.Check.Status != api.HealthPassing
+		if c.Check.Suppressed == suppressed {
+			continue
+		}
+
+		c = c.Clone()
+		if suppressed {
+			c.Check.Output = suppressedOutput(c.Check.DependsOn, c.Check.Output)
+		} else {
+			c.Check.Output = strings.TrimPrefix(c.Check.Output, suppressedOutput(c.Check.DependsOn, ""))
+		}
+		c.Check.Suppressed = suppressed
+		c.InSync = false
+		l.checks[checkID] = c
+	}
+}
+
 // Check returns the locally registered check that the
 // agent is aware of and are being kept in sync with the server
 func (l *State) Check(id structs.CheckID) *structs.HealthCheck {
@@ -2473,6 +2473,138 @@ func TestState_Notify(t *testing.T) {
 }
 
 // Test that alias check is updated after AddCheck, UpdateCheck, and RemoveCheck for the same service id
+func TestState_UpdateServiceWeight(t *testing.T) {
+	t.Parallel()
+
+	state := local.NewState(local.Config{}, hclog.NewNullLogger(), &token.Store{})
+	state.TriggerSyncChanges = func() {}
+
+	registered := &structs.Weights{Passing: 10, Warning: 2}
+	err := state.AddServiceWithChecks(&structs.NodeService{
+		Service: "web",
+		Weights: registered,
+	}, []*structs.HealthCheck{
+		{CheckID: "load", ServiceID: "web"},
+		{CheckID: "latency", ServiceID: "web"},
+	}, "fake-token-web", false)
+	require.NoError(t, err)
+
+	sid := structs.NewServiceID("web", nil)
+	load := structs.NewCheckID("load", nil)
+	latency := structs.NewCheckID("latency", nil)
+	ws := state.ServiceState(sid)
+	ws.InSync = true
+	state.SetServiceState(ws)
+
+	state.UpdateServiceWeight(sid, load, 3)
+	s := state.ServiceState(sid)
+	require.Equal(t, &structs.Weights{Passing: 3, Warning: 2}, s.Service.Weights)
+	require.False(t, s.InSync)
+	require.Equal(t, "fake-token-web", s.Token)
+
+	// Reporting the same weight again is a no-op.
+	s.InSync = true
+	state.SetServiceState(s)
+	state.UpdateServiceWeight(sid, load, 3)
+	require.True(t, state.ServiceState(sid).InSync)
+
+	// The lowest reported weight applies.
+	state.UpdateServiceWeight(sid, latency, 2)
+	require.Equal(t, &structs.Weights{Passing: 2, Warning: 2}, state.Service(sid).Weights)
+	state.UpdateServiceWeight(sid, latency, 5)
+	require.Equal(t, &structs.Weights{Passing: 3, Warning: 2}, state.Service(sid).Weights)
+
+	// Reported weights survive the service being registered again, which
+	// updates the registered weights.
+	registered = &structs.Weights{Passing: 8, Warning: 1}
+	require.NoError(t, state.AddServiceWithChecks(&structs.NodeService{
+		Service: "web",
+		Weights: registered,
+	}, nil, "fake-token-web", false))
+	require.Equal(t, &structs.Weights{Passing: 3, Warning: 1}, state.Service(sid).Weights)
+
+	// The registered weights are restored once no check reports a weight.
+	state.UpdateServiceWeight(sid, load, 0)
+	require.Equal(t, &structs.Weights{Passing: 5, Warning: 1}, state.Service(sid).Weights)
+	require.NoError(t, state.RemoveCheck(latency))
+	require.Equal(t, registered, state.Service(sid).Weights)
+
+	// Unknown services are ignored.
+	state.UpdateServiceWeight(structs.NewServiceID("api", nil), load, 3)
+	require.Nil(t, state.ServiceState(structs.NewServiceID("api", nil)))
+}
+
+func TestState_CheckDependencies(t *testing.T) {
+	t.Parallel()
+
+	state := local.NewState(local.Config{}, hclog.NewNullLogger(), &token.Store{})
+	state.TriggerSyncChanges = func() {}
+
+	require.NoError(t, state.AddServiceWithChecks(&structs.NodeService{Service: "web"}, nil, "", false))
+	require.NoError(t, state.AddCheck(&structs.HealthCheck{
+		CheckID: "network",
+		Name:    "network",
+		Status:  api.HealthPassing,
+	}, "", false))
+	require.NoError(t, state.AddCheck(&structs.HealthCheck{
+		CheckID:   "web",
+		Name:      "web",
+		ServiceID: "web",
+		Status:    api.HealthPassing,
+		DependsOn: "network",
+	}, "", false))
+
+	networkID := structs.NewCheckID("network", nil)
+	webID := structs.NewCheckID("web", nil)
+
+	// A failing check is not suppressed while its dependency is healthy.
+	state.UpdateCheck(webID, api.HealthCritical, "connection refused")
+	web := state.Check(webID)
+	require.False(t, web.Suppressed)
+	require.Equal(t, "connection refused", web.Output)
+
+	// The dependency going critical suppresses the failing dependent.
+	state.UpdateCheck(networkID, api.HealthCritical, "no route to host")
+	web = state.Check(webID)
+	require.True(t, web.Suppressed)
+	require.Equal(t, api.HealthCritical, web.Status)
+	require.Equal(t, "Suppressed: dependency check \"network\" is critical\nconnection refused", web.Output)
+	require.False(t, state.CheckState(webID).InSync)
+
+	// Further updates of the dependent stay suppressed.
+	state.UpdateCheck(webID, api.HealthWarning, "timeout")
+	web = state.Check(webID)
+	require.True(t, web.Suppressed)
+	require.Equal(t, "Suppressed: dependency check \"network\" is critical\ntimeout", web.Output)
+
+	// A passing dependent is never suppressed.
+	state.UpdateCheck(webID, api.HealthPassing, "ok")
+	require.False(t, state.Check(webID).Suppressed)
+	state.UpdateCheck(webID, api.HealthCritical, "connection refused")
+	require.True(t, state.Check(webID).Suppressed)
+
+	// The dependency recovering releases the dependent.
+	state.UpdateCheck(networkID, api.HealthPassing, "")
+	web = state.Check(webID)
+	require.False(t, web.Suppressed)
+	require.Equal(t, api.HealthCritical, web.Status)
+	require.Equal(t, "connection refused", web.Output)
+
+	// Removing a critical dependency releases the dependent too.
+	state.UpdateCheck(networkID, api.HealthCritical, "no route to host")
+	require.True(t, state.Check(webID).Suppressed)
+	require.NoError(t, state.RemoveCheck(networkID))
+	web = state.Check(webID)
+	require.False(t, web.Suppressed)
+	require.Equal(t, api.HealthCritical, web.Status)
+	require.Equal(t, "connection refused", web.Output)
+	require.False(t, state.CheckState(webID).InSync)
+
+	// Without the dependency, failures are no longer suppressed.
+	state.UpdateCheck(webID, api.HealthWarning, "timeout")
+	require.False(t, state.Check(webID).Suppressed)
+}
+
 func TestAliasNotifications_local(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package agent
+
+import (
+	"strings"
+	"time"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/lib"
+	"github.com/hashicorp/consul/lib/retry"
+)
+
+// scheduledMaintenanceNotesPrefix starts the notes of every maintenance check
+// added for a maintenance window. It tells them apart from maintenance
+// enabled by an operator, including after the agent restarts with the checks
+// it persisted.
+const scheduledMaintenanceNotesPrefix = "Scheduled maintenance window "
+
+// maintenanceWindowSyncInterval is how often the open maintenance windows are
+// applied again, to re-enable maintenance mode that was disabled while a
+// window is open.
+const maintenanceWindowSyncInterval = 1 * time.Minute
+
+// enforceMaintenanceWindows watches the scheduled maintenance windows for this
+// node and enables or disables node and service maintenance mode as windows
+// open and close. Windows are also applied again whenever local services
+// change and every maintenanceWindowSyncInterval. It runs until the agent is
+// shut down.
+func (a *Agent) enforceMaintenanceWindows() {
+	windowsCh := make(chan structs.MaintenanceWindows, 1)
+	go a.watchMaintenanceWindows(windowsCh)
+
+	servicesCh := make(chan struct{}, 1)
+	a.State.Notify(servicesCh)
+	defer a.State.StopNotify(servicesCh)
+
+	ticker := time.NewTicker(maintenanceWindowSyncInterval)
+	defer ticker.Stop()
+
+	var (
+		windows structs.MaintenanceWindows
+		timer   <-chan time.Time
+	)
+	for {
+		select {
+		case windows = <-windowsCh:
+		case <-timer:
+		case <-servicesCh:
+		case <-ticker.C:
+		case <-a.shutdownCh:
+			return
+		}
+
+		now := time.Now()
+		timer = nil
+		if next := a.applyMaintenanceWindows(windows, now); !next.IsZero() {
+			timer = time.After(next.Sub(now))
+		}
+	}
+}
+
+// watchMaintenanceWindows performs a blocking query for the maintenance
+// windows of this node and sends every changed result on windowsCh.
+func (a *Agent) watchMaintenanceWindows(windowsCh chan<- structs.MaintenanceWindows) {
+	ctx := &lib.StopChannelContext{StopCh: a.shutdownCh}
+	waiter := &retry.Waiter{
+		MinFailures: 1,
+		MinWait:     1 * time.Second,
+		MaxWait:     1 * time.Minute,
+		Jitter:      retry.NewJitter(10),
+	}
+
+	var index uint64
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+
+		req := structs.NodeSpecificRequest{
+			Datacenter:     a.config.Datacenter,
+			Node:           a.config.NodeName,
+			EnterpriseMeta: *a.AgentEnterpriseMeta(),
+			QueryOptions: structs.QueryOptions{
+				Token:         a.tokens.AgentToken(),
+				MinQueryIndex: index,
+				AllowStale:    true,
+			},
+		}
+		var out structs.IndexedMaintenanceWindows
+		if err := a.RPC(ctx, "Catalog.MaintenanceWindowList", &req, &out); err != nil {
+			if acl.IsErrPermissionDenied(err) {
+				accessorID := a.aclAccessorID(req.Token)
+				a.logger.Warn("Maintenance window lookup blocked by ACLs", "accessorID", acl.AliasIfAnonymousToken(accessorID))
+			} else {
+				a.logger.Debug("Maintenance window lookup failed", "error", err)
+			}
+			if err := waiter.Wait(ctx); err != nil {
+				return
+			}
+			continue
+		}
+
+		// An unchanged index means the query returned without blocking, which
+		// the servers do while shutting down. Back off instead of spinning.
+		if out.Index == index {
+			if err := waiter.Wait(ctx); err != nil {
+				return
+			}
+			continue
+		}
+		waiter.Reset()
+		index = out.Index
+		// Ensure the next query always blocks, even if the table is empty.
+		if index < 1 {
+			index = 1
+		}
+
+		select {
+		case windowsCh <- out.Windows:
+		case <-a.shutdownCh:
+			return
+		}
+	}
+}
+
+// applyMaintenanceWindows enables maintenance mode for every window open at
+// now, and disables maintenance previously enabled by the scheduler whose
+// windows have closed. It returns the next time at which any window opens or
+// closes, or a zero time if none will.
+func (a *Agent) applyMaintenanceWindows(windows structs.MaintenanceWindows, now time.Time) time.Time {
+	var next time.Time
+	active := make(map[structs.CheckID]*structs.MaintenanceWindow)
+	for _, w := range windows {
+		if t := w.NextTransition(now); !t.IsZero() && (next.IsZero() || t.Before(next)) {
+			next = t
+		}
+		if !w.ActiveAt(now) {
+			continue
+		}
+		if w.ServiceID == "" {
+			active[structs.NodeMaintCheckID] = w
+		} else {
+			active[serviceMaintCheckID(structs.NewServiceID(w.ServiceID, &w.EnterpriseMeta))] = w
+		}
+	}
+
+	for checkID, w := range active {
+		// Maintenance is already enabled, either for this window or by
+		// someone else who is left to disable it.
+		if a.State.Check(checkID) != nil {
+			continue
+		}
+
+		notes := scheduledMaintenanceNotesPrefix + w.ID
+		if w.Reason != "" {
+			notes += ": " + w.Reason
+		}
+		if w.ServiceID == "" {
+			a.EnableNodeMaintenance(notes, a.tokens.AgentToken())
+			continue
+		}
+		sid := structs.NewServiceID(w.ServiceID, &w.EnterpriseMeta)
+		if err := a.EnableServiceMaintenance(sid, notes, a.State.ServiceToken(sid)); err != nil {
+			a.logger.Warn("Failed to enable scheduled service maintenance",
+				"service", sid.String(),
+				"window", w.ID,
+				"error", err,
+			)
+		}
+	}
+
+	for checkID, check := range a.State.AllChecks() {
+		if _, ok := active[checkID]; ok || !isScheduledMaintenanceCheck(check) {
+			continue
+		}
+
+		if checkID == structs.NodeMaintCheckID {
+			a.DisableNodeMaintenance()
+			continue
+		}
+		sid := structs.NewServiceID(strings.TrimPrefix(string(checkID.ID), structs.ServiceMaintPrefix), &checkID.EnterpriseMeta)
+		if err := a.DisableServiceMaintenance(sid); err != nil {
+			a.logger.Debug("Failed to disable scheduled service maintenance",
+				"service", sid.String(),
+				"error", err,
+			)
+		}
+	}
+
+	return next
+}
+
+// isScheduledMaintenanceCheck reports whether the check is a maintenance check
+// added for a maintenance window.
+func isScheduledMaintenanceCheck(check *structs.HealthCheck) bool {
+	if check.Type != "maintenance" {
+		return false
+	}
+	if check.CheckID != structs.NodeMaint && !strings.HasPrefix(string(check.CheckID), structs.ServiceMaintPrefix) {
+		return false
+	}
+	return strings.HasPrefix(check.Notes, scheduledMaintenanceNotesPrefix)
+}
@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package agent
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/sdk/testutil/retry"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+func TestAgent_applyMaintenanceWindows(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+
+	require.NoError(t, a.addServiceFromSource(&structs.NodeService{ID: "redis", Service: "redis"}, nil, false, "", ConfigSourceLocal))
+
+	start := time.Date(2023, 6, 4, 2, 0, 0, 0, time.UTC)
+	windows := structs.MaintenanceWindows{
+		{ID: "node", Node: a.config.NodeName, Start: start, Duration: time.Hour, Reason: "patching"},
+		{ID: "svc", Node: a.config.NodeName, ServiceID: "redis", Start: start.Add(30 * time.Minute), Duration: time.Hour},
+	}
+	svcCheckID := serviceMaintCheckID(structs.NewServiceID("redis", nil))
+
+	// Before any window opens nothing is in maintenance.
+	next := a.applyMaintenanceWindows(windows, start.Add(-time.Minute))
+	require.Equal(t, start, next)
+	require.Nil(t, a.State.Check(structs.NodeMaintCheckID))
+
+	// The node window opens.
+	next = a.applyMaintenanceWindows(windows, start)
+	require.Equal(t, start.Add(30*time.Minute), next)
+	chk := a.State.Check(structs.NodeMaintCheckID)
+	require.NotNil(t, chk)
+	require.Equal(t, "Scheduled maintenance window node: patching", chk.Notes)
+	require.Nil(t, a.State.Check(svcCheckID))
+
+	// Both windows are open.
+	next = a.applyMaintenanceWindows(windows, start.Add(45*time.Minute))
+	require.Equal(t, start.Add(time.Hour), next)
+	chk = a.State.Check(svcCheckID)
+	require.NotNil(t, chk)
+	require.Equal(t, "Scheduled maintenance window svc", chk.Notes)
+
+	// The node window closes.
+	a.applyMaintenanceWindows(windows, start.Add(time.Hour))
+	require.Nil(t, a.State.Check(structs.NodeMaintCheckID))
+	require.NotNil(t, a.State.Check(svcCheckID))
+
+	// Maintenance enabled by an operator is left alone.
+	a.EnableNodeMaintenance("manual", "")
+	a.applyMaintenanceWindows(windows, start)
+	a.applyMaintenanceWindows(windows, start.Add(2*time.Hour))
+	chk = a.State.Check(structs.NodeMaintCheckID)
+	require.NotNil(t, chk)
+	require.Equal(t, "manual", chk.Notes)
+	require.Nil(t, a.State.Check(svcCheckID))
+	a.DisableNodeMaintenance()
+
+	// Maintenance enabled for a window before the agent restarted is
+	// disabled once the window closes or is deleted.
+	a.EnableNodeMaintenance("Scheduled maintenance window old: patching", "")
+	a.applyMaintenanceWindows(nil, start)
+	require.Nil(t, a.State.Check(structs.NodeMaintCheckID))
+}
+
+func TestAgent_enforceMaintenanceWindows(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+
+	// The window of a service that is not registered yet is created before
+	// the node window, so it has been received once the node is in
+	// maintenance.
+	svcReq := structs.MaintenanceWindowRequest{
+		Datacenter: "dc1",
+		Op:         structs.MaintenanceWindowUpsert,
+		Window: &structs.MaintenanceWindow{
+			Node:      a.config.NodeName,
+			ServiceID: "redis",
+			Start:     time.Now().Add(-time.Minute),
+			Duration:  time.Hour,
+		},
+	}
+	var svcID string
+	require.NoError(t, a.RPC(context.Background(), "Catalog.MaintenanceWindowApply", &svcReq, &svcID))
+
+	req := structs.MaintenanceWindowRequest{
+		Datacenter: "dc1",
+		Op:         structs.MaintenanceWindowUpsert,
+		Window: &structs.MaintenanceWindow{
+			Node:     a.config.NodeName,
+			Start:    time.Now().Add(-time.Minute),
+			Duration: time.Hour,
+		},
+	}
+	var id string
+	require.NoError(t, a.RPC(context.Background(), "Catalog.MaintenanceWindowApply", &req, &id))
+
+	retry.Run(t, func(r *retry.R) {
+		if a.State.Check(structs.NodeMaintCheckID) == nil {
+			r.Fatal("node is not in maintenance")
+		}
+	})
+
+	// A service registered while its window is open is put into maintenance
+	// straight away.
+	require.NoError(t, a.addServiceFromSource(&structs.NodeService{ID: "redis", Service: "redis"}, nil, false, "", ConfigSourceLocal))
+
+	svcCheckID := serviceMaintCheckID(structs.NewServiceID("redis", nil))
+	retry.Run(t, func(r *retry.R) {
+		if a.State.Check(svcCheckID) == nil {
+			r.Fatal("service is not in maintenance")
+		}
+	})
+
+	req.Op = structs.MaintenanceWindowDelete
+	req.Window = &structs.MaintenanceWindow{ID: id}
+	require.NoError(t, a.RPC(context.Background(), "Catalog.MaintenanceWindowApply", &req, &id))
+
+	retry.Run(t, func(r *retry.R) {
+		if a.State.Check(structs.NodeMaintCheckID) != nil {
+			r.Fatal("node is still in maintenance")
+		}
+	})
+}
@@ -454,3 +454,73 @@ func autopilotToAPIServer(srv *autopilot.ServerState) api.AutopilotServer {
 
 	return apiSrv
 }
+
+// OperatorDNSSECKeys lists the DNSSEC keys or removes an inactive key.
+// Private keys are never returned.
+func (s *HTTPHandlers) OperatorDNSSECKeys(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	if req.Method == "DELETE" {
+		var args structs.DNSSECKeyRequest
+		s.parseDC(req, &args.Datacenter)
+		s.parseToken(req, &args.Token)
+
+		keyTag, err := strconv.ParseUint(req.URL.Query().Get("key-tag"), 10, 16)
+		if err != nil {
+			return nil, HTTPError{StatusCode: http.StatusBadRequest, Reason: "Must specify ?key-tag with the tag of the key to remove"}
+		}
+		args.KeyTag = uint16(keyTag)
+
+		var reply struct{}
+		if err := s.agent.RPC(req.Context(), "Operator.DNSSECKeyringRemove", &args, &reply); err != nil {
+			return nil, err
+		}
+		return true, nil
+	}
+
+	var args structs.DCSpecificRequest
+	if done := s.parse(resp, req, &args.Datacenter, &args.QueryOptions); done {
+		return nil, nil
+	}
+
+	var reply structs.IndexedDNSSECKeys
+	defer setMeta(resp, &reply.QueryMeta)
+	if err := s.agent.RPC(req.Context(), "Operator.DNSSECKeyringGet", &args, &reply); err != nil {
+		return nil, err
+	}
+
+	out := make([]*api.DNSSECKey, 0, len(reply.Keys))
+	for _, key := range reply.Keys {
+		out = append(out, &api.DNSSECKey{
+			KeyTag:       key.KeyTag,
+			Algorithm:    key.Algorithm,
+			Flags:        key.Flags,
+			PublicKey:    key.PublicKey,
+			Active:       key.Active,
+			CreateTime:   key.CreateTime,
+			ActivateTime: key.ActivateTime,
+		})
+	}
+	return out, nil
+}
+
+// OperatorDNSSECRotate generates a new DNSSEC key that becomes active once it
+// has been published long enough.
+func (s *HTTPHandlers) OperatorDNSSECRotate(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	var args structs.DNSSECKeyRequest
+	s.parseDC(req, &args.Datacenter)
+	s.parseToken(req, &args.Token)
+
+	var reply structs.DNSSECKey
+	if err := s.agent.RPC(req.Context(), "Operator.DNSSECKeyringRotate", &args, &reply); err != nil {
+		return nil, err
+	}
+
+	return &api.DNSSECKey{
+		KeyTag:       reply.KeyTag,
+		Algorithm:    reply.Algorithm,
+		Flags:        reply.Flags,
+		PublicKey:    reply.PublicKey,
+		Active:       reply.Active,
+		CreateTime:   reply.CreateTime,
+		ActivateTime: reply.ActivateTime,
+	}, nil
+}
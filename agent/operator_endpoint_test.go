@@ -802,3 +802,34 @@ func TestAutopilotStateToAPIConversion(t *testing.T) {
 
 	require.Equal(t, &expected, autopilotToAPIState(&input))
 }
+
+func TestOperator_DNSSECKeys(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+	testrpc.WaitForLeader(t, a.RPC, "dc1")
+
+	req, _ := http.NewRequest("PUT", "/v1/operator/dnssec/rotate", nil)
+	resp := httptest.NewRecorder()
+	obj, err := a.srv.OperatorDNSSECRotate(resp, req)
+	require.NoError(t, err)
+	key := obj.(*api.DNSSECKey)
+	require.True(t, key.Active)
+
+	req, _ = http.NewRequest("GET", "/v1/operator/dnssec/keys", nil)
+	resp = httptest.NewRecorder()
+	obj, err = a.srv.OperatorDNSSECKeys(resp, req)
+	require.NoError(t, err)
+	require.Equal(t, []*api.DNSSECKey{key}, obj)
+	require.NotEmpty(t, resp.Header().Get("X-Consul-Index"))
+
+	req, _ = http.NewRequest("DELETE", "/v1/operator/dnssec/keys?key-tag=nope", nil)
+	resp = httptest.NewRecorder()
+	_, err = a.srv.OperatorDNSSECKeys(resp, req)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "key-tag")
+}
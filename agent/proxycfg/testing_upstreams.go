@@ -254,6 +254,8 @@ func setupTestVariationConfigEntriesAndSnapshot(
 	case "chain-and-splitter":
 	case "grpc-router":
 	case "chain-and-router":
+	case "router-with-fault-injection":
+	case "router-with-mirror":
 	case "lb-resolver":
 	case "register-to-terminating-gateway":
 	default:
@@ -873,6 +875,118 @@ func setupTestVariationDiscoveryChain(
 				},
 			},
 		)
+	case "router-with-fault-injection":
+		entries = append(entries,
+			&structs.ProxyConfigEntry{
+				Kind:           structs.ProxyDefaults,
+				Name:           structs.ProxyConfigGlobal,
+				EnterpriseMeta: entMeta,
+				Config: map[string]interface{}{
+					"protocol": "http",
+				},
+			},
+			&structs.ServiceRouterConfigEntry{
+				Kind:           structs.ServiceRouter,
+				Name:           "db",
+				EnterpriseMeta: entMeta,
+				Routes: []structs.ServiceRoute{
+					{
+						Match: httpMatch(&structs.ServiceRouteHTTPMatch{
+							PathPrefix: "/delay",
+						}),
+						Destination: &structs.ServiceRouteDestination{
+							FaultInjection: &structs.ServiceRouteFaultInjection{
+								Delay: &structs.ServiceRouteFaultDelay{
+									Duration:   500 * time.Millisecond,
+									Percentage: 12.5,
+								},
+							},
+						},
+					},
+					{
+						Match: httpMatch(&structs.ServiceRouteHTTPMatch{
+							PathPrefix: "/abort",
+						}),
+						Destination: &structs.ServiceRouteDestination{
+							FaultInjection: &structs.ServiceRouteFaultInjection{
+								Delay: &structs.ServiceRouteFaultDelay{
+									Duration:   time.Second,
+									Percentage: 100,
+								},
+								Abort: &structs.ServiceRouteFaultAbort{
+									HTTPStatus: 503,
+									Percentage: 50,
+								},
+								Header: []structs.ServiceRouteHTTPMatchHeader{
+									{Name: "x-chaos", Exact: "true"},
+								},
+							},
+						},
+					},
+				},
+			},
+		)
+	case "router-with-mirror":
+		entries = append(entries,
+			&structs.ProxyConfigEntry{
+				Kind:           structs.ProxyDefaults,
+				Name:           structs.ProxyConfigGlobal,
+				EnterpriseMeta: entMeta,
+				Config: map[string]interface{}{
+					"protocol": "http",
+				},
+			},
+			&structs.ServiceResolverConfigEntry{
+				Kind:           structs.ServiceResolver,
+				Name:           "db",
+				EnterpriseMeta: entMeta,
+				Subsets: map[string]structs.ServiceResolverSubset{
+					"v1": {Filter: "Service.Meta.version == v1"},
+					"v2": {Filter: "Service.Meta.version == v2"},
+				},
+			},
+			&structs.ServiceSplitterConfigEntry{
+				Kind:           structs.ServiceSplitter,
+				Name:           "db",
+				EnterpriseMeta: entMeta,
+				Splits: []structs.ServiceSplit{
+					{
+						Weight:        90,
+						ServiceSubset: "v1",
+						Mirror: &structs.MirrorPolicy{
+							Service: "shadow",
+						},
+					},
+					{
+						Weight:        10,
+						ServiceSubset: "v2",
+						Mirror: &structs.MirrorPolicy{
+							Service:    "shadow",
+							Percentage: 50,
+						},
+					},
+				},
+			},
+			&structs.ServiceRouterConfigEntry{
+				Kind:           structs.ServiceRouter,
+				Name:           "db",
+				EnterpriseMeta: entMeta,
+				Routes: []structs.ServiceRoute{
+					{
+						Match: httpMatch(&structs.ServiceRouteHTTPMatch{
+							PathPrefix: "/v1",
+						}),
+						Destination: &structs.ServiceRouteDestination{
+							ServiceSubset: "v1",
+							Mirror: &structs.MirrorPolicy{
+								ServiceSubset: "v2",
+								Percentage:    12.5,
+							},
+						},
+					},
+				},
+			},
+		)
 	case "lb-resolver":
 		entries = append(entries,
 			&structs.ProxyConfigEntry{
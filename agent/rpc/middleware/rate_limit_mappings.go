@@ -45,17 +45,19 @@ var rpcRateLimitSpecs = map[string]rate.OperationSpec{
 
 	"AutoEncrypt.Sign": {Type: rate.OperationTypeWrite, Category: rate.OperationCategoryAutoConfig},
 
-	"Catalog.Deregister":          {Type: rate.OperationTypeWrite, Category: rate.OperationCategoryCatalog},
-	"Catalog.GatewayServices":     {Type: rate.OperationTypeRead, Category: rate.OperationCategoryCatalog},
-	"Catalog.ListDatacenters":     {Type: rate.OperationTypeRead, Category: rate.OperationCategoryCatalog},
-	"Catalog.ListNodes":           {Type: rate.OperationTypeRead, Category: rate.OperationCategoryCatalog},
-	"Catalog.ListServices":        {Type: rate.OperationTypeRead, Category: rate.OperationCategoryCatalog},
-	"Catalog.NodeServiceList":     {Type: rate.OperationTypeRead, Category: rate.OperationCategoryCatalog},
-	"Catalog.NodeServices":        {Type: rate.OperationTypeRead, Category: rate.OperationCategoryCatalog},
-	"Catalog.Register":            {Type: rate.OperationTypeWrite, Category: rate.OperationCategoryCatalog},
-	"Catalog.ServiceList":         {Type: rate.OperationTypeRead, Category: rate.OperationCategoryCatalog},
-	"Catalog.ServiceNodes":        {Type: rate.OperationTypeRead, Category: rate.OperationCategoryCatalog},
-	"Catalog.VirtualIPForService": {Type: rate.OperationTypeRead, Category: rate.OperationCategoryCatalog},
+	"Catalog.Deregister":             {Type: rate.OperationTypeWrite, Category: rate.OperationCategoryCatalog},
+	"Catalog.GatewayServices":        {Type: rate.OperationTypeRead, Category: rate.OperationCategoryCatalog},
+	"Catalog.ListDatacenters":        {Type: rate.OperationTypeRead, Category: rate.OperationCategoryCatalog},
+	"Catalog.ListNodes":              {Type: rate.OperationTypeRead, Category: rate.OperationCategoryCatalog},
+	"Catalog.ListServices":           {Type: rate.OperationTypeRead, Category: rate.OperationCategoryCatalog},
+	"Catalog.MaintenanceWindowApply": {Type: rate.OperationTypeWrite, Category: rate.OperationCategoryCatalog},
+	"Catalog.MaintenanceWindowList":  {Type: rate.OperationTypeRead, Category: rate.OperationCategoryCatalog},
+	"Catalog.NodeServiceList":        {Type: rate.OperationTypeRead, Category: rate.OperationCategoryCatalog},
+	"Catalog.NodeServices":           {Type: rate.OperationTypeRead, Category: rate.OperationCategoryCatalog},
+	"Catalog.Register":               {Type: rate.OperationTypeWrite, Category: rate.OperationCategoryCatalog},
+	"Catalog.ServiceList":            {Type: rate.OperationTypeRead, Category: rate.OperationCategoryCatalog},
+	"Catalog.ServiceNodes":           {Type: rate.OperationTypeRead, Category: rate.OperationCategoryCatalog},
+	"Catalog.VirtualIPForService":    {Type: rate.OperationTypeRead, Category: rate.OperationCategoryCatalog},
 
 	"ConfigEntry.Apply":                {Type: rate.OperationTypeWrite, Category: rate.OperationCategoryConfigEntry},
 	"ConfigEntry.Delete":               {Type: rate.OperationTypeWrite, Category: rate.OperationCategoryConfigEntry},
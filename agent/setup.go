@@ -18,6 +18,7 @@ import (
 
 	autoconf "github.com/hashicorp/consul/agent/auto-config"
 	"github.com/hashicorp/consul/agent/cache"
+	"github.com/hashicorp/consul/agent/checks"
 	"github.com/hashicorp/consul/agent/config"
 	"github.com/hashicorp/consul/agent/consul"
 	"github.com/hashicorp/consul/agent/consul/fsm"
@@ -350,6 +351,7 @@ func getPrometheusDefs(cfg *config.RuntimeConfig, isServer bool) ([]prometheus.G
 
 	var counters = [][]prometheus.CounterDefinition{
 		CatalogCounters,
+		DNSCounters,
 		cache.Counters,
 		consul.ACLCounters,
 		consul.CatalogCounters,
@@ -423,6 +425,7 @@ func getPrometheusDefs(cfg *config.RuntimeConfig, isServer bool) ([]prometheus.G
 
 	var summaries = [][]prometheus.SummaryDefinition{
 		HTTPSummaries,
+		checks.SchedulerSummaries,
 		consul.ACLSummaries,
 		consul.ACLEndpointSummaries,
 		consul.CatalogSummaries,
@@ -95,6 +95,9 @@ func (f *Filter) Filter(subject any) {
 	case *structs.IndexedSessions:
 		v.QueryMeta.ResultsFilteredByACLs = f.filterSessions(&v.Sessions)
 
+	case *structs.IndexedMaintenanceWindows:
+		v.QueryMeta.ResultsFilteredByACLs = f.filterMaintenanceWindows(&v.Windows)
+
 	case *structs.IndexedPreparedQueries:
 		v.QueryMeta.ResultsFilteredByACLs = f.filterPreparedQueries(&v.Queries)
 
@@ -405,6 +408,28 @@ func (f *Filter) filterSessions(sessions *structs.Sessions) bool {
 	return removed
 }
 
+// filterMaintenanceWindows is used to filter maintenance windows based on
+// ACL rules for the node they apply to. Returns true if any elements were
+// removed.
+func (f *Filter) filterMaintenanceWindows(windows *structs.MaintenanceWindows) bool {
+	w := *windows
+	var authzContext acl.AuthorizerContext
+	var removed bool
+
+	for i := 0; i < len(w); i++ {
+		w[i].FillAuthzContext(&authzContext)
+		if f.allowNode(w[i].Node, &authzContext) {
+			continue
+		}
+		f.logger.Debug("dropping maintenance window from result due to ACLs", "window", w[i].ID)
+		removed = true
+		w = append(w[:i], w[i+1:]...)
+		i--
+	}
+	*windows = w
+	return removed
+}
+
 // filterCoordinates is used to filter nodes in a coordinate dump based on ACL
 // rules. Returns true if any elements were removed.
 func (f *Filter) filterCoordinates(coords *structs.Coordinates) bool {
@@ -47,11 +47,15 @@ type CheckDefinition struct {
 	TLSSkipVerify                  bool
 	AliasNode                      string
 	AliasService                   string
+	AliasDatacenter                string
+	AliasPeer                      string
 	Timeout                        time.Duration
 	TTL                            time.Duration
 	SuccessBeforePassing           int
 	FailuresBeforeWarning          int
 	FailuresBeforeCritical         int
+	DynamicWeight                  bool
+	DependsOn                      types.CheckID
 	DeregisterCriticalServiceAfter time.Duration
 	OutputMaxSize                  int
 
@@ -80,6 +84,8 @@ func (t *CheckDefinition) UnmarshalJSON(data []byte) (err error) {
 		ServiceIDSnake                      string      `json:"service_id"`
 		H2PingUseTLSSnake                   bool        `json:"h2ping_use_tls"`
 		DisableRedirectsSnake               bool        `json:"disable_redirects"`
+		DynamicWeightSnake                  bool        `json:"dynamic_weight"`
+		DependsOnSnake                      string      `json:"depends_on"`
 
 		*Alias
 	}{
@@ -128,6 +134,12 @@ func (t *CheckDefinition) UnmarshalJSON(data []byte) (err error) {
 	if aux.DisableRedirectsSnake {
 		t.DisableRedirects = aux.DisableRedirectsSnake
 	}
+	if aux.DynamicWeightSnake {
+		t.DynamicWeight = aux.DynamicWeightSnake
+	}
+	if aux.DependsOnSnake != "" {
+		t.DependsOn = types.CheckID(aux.DependsOnSnake)
+	}
 
 	if (aux.H2PING != "" && !aux.H2PingUseTLSSnake) || (aux.H2PING == "" && aux.H2PingUseTLSSnake) {
 		t.H2PingUseTLS = aux.H2PingUseTLSSnake
@@ -188,6 +200,7 @@ func (c *CheckDefinition) HealthCheck(node string) *HealthCheck {
 		ServiceID:      c.ServiceID,
 		Interval:       c.Interval.String(),
 		Timeout:        c.Timeout.String(),
+		DependsOn:      c.DependsOn,
 		EnterpriseMeta: c.EnterpriseMeta,
 	}
 	if c.Status != "" {
@@ -209,6 +222,8 @@ func (c *CheckDefinition) CheckType() *CheckType {
 		ScriptArgs:                     c.ScriptArgs,
 		AliasNode:                      c.AliasNode,
 		AliasService:                   c.AliasService,
+		AliasDatacenter:                c.AliasDatacenter,
+		AliasPeer:                      c.AliasPeer,
 		HTTP:                           c.HTTP,
 		H2PING:                         c.H2PING,
 		H2PingUseTLS:                   c.H2PingUseTLS,
@@ -232,6 +247,8 @@ func (c *CheckDefinition) CheckType() *CheckType {
 		SuccessBeforePassing:           c.SuccessBeforePassing,
 		FailuresBeforeWarning:          c.FailuresBeforeWarning,
 		FailuresBeforeCritical:         c.FailuresBeforeCritical,
+		DynamicWeight:                  c.DynamicWeight,
+		DependsOn:                      c.DependsOn,
 		DeregisterCriticalServiceAfter: c.DeregisterCriticalServiceAfter,
 	}
 }
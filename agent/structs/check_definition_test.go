@@ -94,7 +94,11 @@ func TestCheckDefinitionToCheckType(t *testing.T) {
 		DockerContainerID:              "abc123",
 		Shell:                          "/bin/ksh",
 		OSService:                      "myco-svctype-svcname-001",
+		AliasNode:                      "remote",
+		AliasDatacenter:                "dc2",
 		TLSSkipVerify:                  true,
+		DynamicWeight:                  true,
+		DependsOn:                      "network",
 		Timeout:                        2 * time.Second,
 		TTL:                            3 * time.Second,
 		DeregisterCriticalServiceAfter: 4 * time.Second,
@@ -113,7 +117,11 @@ func TestCheckDefinitionToCheckType(t *testing.T) {
 		DockerContainerID:              "abc123",
 		Shell:                          "/bin/ksh",
 		OSService:                      "myco-svctype-svcname-001",
+		AliasNode:                      "remote",
+		AliasDatacenter:                "dc2",
 		TLSSkipVerify:                  true,
+		DynamicWeight:                  true,
+		DependsOn:                      "network",
 		Timeout:                        2 * time.Second,
 		TTL:                            3 * time.Second,
 		DeregisterCriticalServiceAfter: 4 * time.Second,
@@ -46,6 +46,8 @@ type CheckType struct {
 	Interval               time.Duration
 	AliasNode              string
 	AliasService           string
+	AliasDatacenter        string
+	AliasPeer              string
 	DockerContainerID      string
 	Shell                  string
 	GRPC                   string
@@ -58,6 +60,8 @@ type CheckType struct {
 	SuccessBeforePassing   int
 	FailuresBeforeWarning  int
 	FailuresBeforeCritical int
+	DynamicWeight          bool
+	DependsOn              types.CheckID
 
 	// Definition fields used when exposing checks through a proxy
 	ProxyHTTP string
@@ -198,6 +202,15 @@ func (c *CheckType) Validate() error {
 	if c.IsAlias() && c.TTL > 0 {
 		return fmt.Errorf("TTL must be not be set for Alias checks")
 	}
+	if (c.AliasDatacenter != "" || c.AliasPeer != "") && c.AliasNode == "" {
+		return fmt.Errorf("AliasNode must be set when AliasDatacenter or AliasPeer is specified")
+	}
+	if c.AliasDatacenter != "" && c.AliasPeer != "" {
+		return fmt.Errorf("AliasDatacenter and AliasPeer cannot both be specified")
+	}
+	if c.DynamicWeight && !c.IsScript() && !c.IsHTTP() && !c.IsTTL() {
+		return fmt.Errorf("DynamicWeight is only supported for Script, HTTP and TTL checks")
+	}
 	if !intervalCheck && !c.IsAlias() && c.TTL <= 0 {
 		return fmt.Errorf("TTL must be > 0 for TTL checks")
 	}
@@ -166,6 +166,7 @@ type ServiceConfigEntry struct {
 	LocalRequestTimeoutMs     int                    `json:",omitempty" alias:"local_request_timeout_ms"`
 	BalanceInboundConnections string                 `json:",omitempty" alias:"balance_inbound_connections"`
 	EnvoyExtensions           EnvoyExtensions        `json:",omitempty" alias:"envoy_extensions"`
+	Tracing                   *TracingConfig         `json:",omitempty"`
 
 	Meta               map[string]string `json:",omitempty"`
 	acl.EnterpriseMeta `hcl:",squash" mapstructure:",squash"`
@@ -294,6 +295,10 @@ func (e *ServiceConfigEntry) Validate() error {
 		return err
 	}
 
+	if err := e.Tracing.Validate(); err != nil {
+		validationErr = multierror.Append(validationErr, err)
+	}
+
 	return validationErr
 }
 
@@ -406,6 +411,7 @@ type ProxyConfigEntry struct {
 	EnvoyExtensions      EnvoyExtensions                      `json:",omitempty" alias:"envoy_extensions"`
 	FailoverPolicy       *ServiceResolverFailoverPolicy       `json:",omitempty" alias:"failover_policy"`
 	PrioritizeByLocality *ServiceResolverPrioritizeByLocality `json:",omitempty" alias:"prioritize_by_locality"`
+	Tracing              *TracingConfig                       `json:",omitempty"`
 
 	Meta               map[string]string `json:",omitempty"`
 	acl.EnterpriseMeta `hcl:",squash" mapstructure:",squash"`
@@ -464,6 +470,10 @@ func (e *ProxyConfigEntry) Validate() error {
 		return err
 	}
 
+	if err := e.Tracing.Validate(); err != nil {
+		return err
+	}
+
 	if err := validateConfigEntryMeta(e.Meta); err != nil {
 		return err
 	}
@@ -1214,6 +1224,7 @@ type ServiceConfigResponse struct {
 	Mode             ProxyMode              `json:",omitempty"`
 	Destination      DestinationConfig      `json:",omitempty"`
 	AccessLogs       AccessLogsConfig       `json:",omitempty"`
+	Tracing          *TracingConfig         `json:",omitempty"`
 	Meta             map[string]string      `json:",omitempty"`
 	EnvoyExtensions  []EnvoyExtension       `json:",omitempty"`
 	QueryMeta
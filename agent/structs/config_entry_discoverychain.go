@@ -170,27 +170,8 @@ func (e *ServiceRouterConfigEntry) Validate() error {
 			}
 
 			for j, hdr := range route.Match.HTTP.Header {
-				if hdr.Name == "" {
-					return fmt.Errorf("Route[%d] Header[%d] missing required Name field", i, j)
-				}
-				hdrParts := 0
-				if hdr.Present {
-					hdrParts++
-				}
-				if hdr.Exact != "" {
-					hdrParts++
-				}
-				if hdr.Regex != "" {
-					hdrParts++
-				}
-				if hdr.Prefix != "" {
-					hdrParts++
-				}
-				if hdr.Suffix != "" {
-					hdrParts++
-				}
-				if hdrParts != 1 {
-					return fmt.Errorf("Route[%d] Header[%d] should only contain one of Present, Exact, Prefix, Suffix, or Regex", i, j)
+				if err := validateServiceRouteHTTPMatchHeader(hdr); err != nil {
+					return fmt.Errorf("Route[%d] Header[%d] %v", i, j, err)
 				}
 			}
 
@@ -238,12 +219,110 @@ func (e *ServiceRouterConfigEntry) Validate() error {
 					return fmt.Errorf("Route[%d] contains an invalid retry condition: %q", i, r)
 				}
 			}
+
+			if fi := route.Destination.FaultInjection; fi != nil {
+				if err := fi.validate(); err != nil {
+					return fmt.Errorf("Route[%d] FaultInjection %v", i, err)
+				}
+			}
+
+			if m := route.Destination.Mirror; m != nil {
+				if err := m.validate(); err != nil {
+					return fmt.Errorf("Route[%d] Mirror %v", i, err)
+				}
+			}
 		}
 	}
 
 	return nil
 }
 
+func validateServiceRouteHTTPMatchHeader(hdr ServiceRouteHTTPMatchHeader) error {
+	if hdr.Name == "" {
+		return fmt.Errorf("missing required Name field")
+	}
+	hdrParts := 0
+	if hdr.Present {
+		hdrParts++
+	}
+	if hdr.Exact != "" {
+		hdrParts++
+	}
+	if hdr.Regex != "" {
+		hdrParts++
+	}
+	if hdr.Prefix != "" {
+		hdrParts++
+	}
+	if hdr.Suffix != "" {
+		hdrParts++
+	}
+	if hdrParts != 1 {
+		return fmt.Errorf("should only contain one of Present, Exact, Prefix, Suffix, or Regex")
+	}
+	return nil
+}
+
+func (f *ServiceRouteFaultInjection) validate() error {
+	if f.Delay == nil && f.Abort == nil {
+		return fmt.Errorf("must specify at least one of Delay or Abort")
+	}
+
+	if f.Delay != nil {
+		if f.Delay.Duration <= 0 {
+			return fmt.Errorf("Delay Duration must be greater than 0")
+		}
+		if err := validateFaultPercentage(f.Delay.Percentage); err != nil {
+			return fmt.Errorf("Delay %v", err)
+		}
+	}
+
+	if f.Abort != nil {
+		switch {
+		case f.Abort.HTTPStatus != 0 && f.Abort.GRPCStatus != 0:
+			return fmt.Errorf("Abort should only contain one of HTTPStatus or GRPCStatus")
+		case f.Abort.HTTPStatus != 0:
+			if f.Abort.HTTPStatus < 200 || f.Abort.HTTPStatus >= 600 {
+				return fmt.Errorf("Abort HTTPStatus must be between 200 and 599, got %d", f.Abort.HTTPStatus)
+			}
+		case f.Abort.GRPCStatus != 0:
+			// 0 is OK, which is not a fault, and 16 is the highest status code.
+			if f.Abort.GRPCStatus > 16 {
+				return fmt.Errorf("Abort GRPCStatus must be between 1 and 16, got %d", f.Abort.GRPCStatus)
+			}
+		default:
+			return fmt.Errorf("Abort must specify one of HTTPStatus or GRPCStatus")
+		}
+		if err := validateFaultPercentage(f.Abort.Percentage); err != nil {
+			return fmt.Errorf("Abort %v", err)
+		}
+	}
+
+	for j, hdr := range f.Header {
+		if err := validateServiceRouteHTTPMatchHeader(hdr); err != nil {
+			return fmt.Errorf("Header[%d] %v", j, err)
+		}
+	}
+	return nil
+}
+
+func validatePercentage(p float32) error {
+	if p < 0 || p > 100 {
+		return fmt.Errorf("Percentage must be between 0 and 100, got %v", p)
+	}
+	return nil
+}
+
+// validateFaultPercentage requires the percentage of requests to inject a
+// fault into to be set explicitly, so that a missing value never faults
+// every request.
+func validateFaultPercentage(p float32) error {
+	if p <= 0 || p > 100 {
+		return fmt.Errorf("Percentage must be greater than 0 and at most 100, got %v", p)
+	}
+	return nil
+}
+
 func isValidHTTPMethod(method string) bool {
 	switch method {
 	case http.MethodGet,
@@ -310,6 +389,10 @@ func (e *ServiceRouterConfigEntry) ListRelatedServices() []ServiceID {
 			if destID != svcID {
 				found[destID] = struct{}{}
 			}
+
+			if mirrorID, ok := route.Destination.Mirror.relatedService(e.Name, &e.EnterpriseMeta); ok && mirrorID != svcID {
+				found[mirrorID] = struct{}{}
+			}
 		}
 	}
 
@@ -454,6 +537,15 @@ type ServiceRouteDestination struct {
 	// Allow HTTP header manipulation to be configured.
 	RequestHeaders  *HTTPHeaderModifiers `json:",omitempty" alias:"request_headers"`
 	ResponseHeaders *HTTPHeaderModifiers `json:",omitempty" alias:"response_headers"`
+
+	// FaultInjection allows for delays and errors to be injected into the
+	// requests that match the route, to test the resilience of the
+	// downstream services.
+	FaultInjection *ServiceRouteFaultInjection `json:",omitempty" alias:"fault_injection"`
+
+	// Mirror sends a copy of the requests that match the route to another
+	// service.
+	Mirror *MirrorPolicy `json:",omitempty"`
 }
 
 func (e *ServiceRouteDestination) MarshalJSON() ([]byte, error) {
@@ -508,6 +600,82 @@ func (d *ServiceRouteDestination) HasRetryFeatures() bool {
 	return d.NumRetries > 0 || d.RetryOnConnectFailure || len(d.RetryOnStatusCodes) > 0 || len(d.RetryOn) > 0
 }
 
+// ServiceRouteFaultInjection describes the faults to inject into the requests
+// that match a route. At least one of Delay or Abort must be set.
+type ServiceRouteFaultInjection struct {
+	// Delay delays the requests before they are proxied to the destination.
+	Delay *ServiceRouteFaultDelay `json:",omitempty"`
+
+	// Abort responds to the requests with an error rather than proxying them
+	// to the destination.
+	Abort *ServiceRouteFaultAbort `json:",omitempty"`
+
+	// Header restricts the faults to the requests with matching headers. If
+	// empty the faults apply to every request that matches the route.
+	Header []ServiceRouteHTTPMatchHeader `json:",omitempty"`
+}
+
+// ServiceRouteFaultDelay is a fixed delay added to a percentage of requests.
+type ServiceRouteFaultDelay struct {
+	// Duration is the fixed amount of time to delay the requests by.
+	Duration time.Duration `json:",omitempty"`
+
+	// Percentage is the percentage of requests to delay, with a resolution of
+	// 0.01%. It must be greater than zero.
+	Percentage float32 `json:",omitempty"`
+}
+
+func (d *ServiceRouteFaultDelay) MarshalJSON() ([]byte, error) {
+	type Alias ServiceRouteFaultDelay
+	exported := &struct {
+		Duration string `json:",omitempty"`
+		*Alias
+	}{
+		Duration: d.Duration.String(),
+		Alias:    (*Alias)(d),
+	}
+	if d.Duration == 0 {
+		exported.Duration = ""
+	}
+
+	return json.Marshal(exported)
+}
+
+func (d *ServiceRouteFaultDelay) UnmarshalJSON(data []byte) error {
+	type Alias ServiceRouteFaultDelay
+	aux := &struct {
+		Duration string
+		*Alias
+	}{
+		Alias: (*Alias)(d),
+	}
+	if err := lib.UnmarshalJSON(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if aux.Duration != "" {
+		if d.Duration, err = time.ParseDuration(aux.Duration); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ServiceRouteFaultAbort responds to a percentage of requests with an error.
+// Exactly one of HTTPStatus or GRPCStatus must be set.
+type ServiceRouteFaultAbort struct {
+	// HTTPStatus is the HTTP status code to respond with.
+	HTTPStatus uint32 `json:",omitempty" alias:"http_status"`
+
+	// GRPCStatus is the gRPC status code to respond with. It can only be used
+	// with services that use the grpc protocol.
+	GRPCStatus uint32 `json:",omitempty" alias:"grpc_status"`
+
+	// Percentage is the percentage of requests to abort, with a resolution of
+	// 0.01%. It must be greater than zero.
+	Percentage float32 `json:",omitempty"`
+}
+
 // ServiceSplitterConfigEntry defines how incoming requests are split across
 // different subsets of a single service (like during staged canary rollouts),
 // or perhaps across different services (like during a v2 rewrite or other type
@@ -605,6 +773,7 @@ func (e *ServiceSplitterConfigEntry) Validate() error {
 
 	copyAsKey := func(s ServiceSplit) ServiceSplit {
 		s.Weight = 0
+		s.Mirror = nil
 		return s
 	}
 
@@ -624,6 +793,14 @@ func (e *ServiceSplitterConfigEntry) Validate() error {
 		found[splitKey] = struct{}{}
 	}
 
+	for i, split := range e.Splits {
+		if split.Mirror != nil {
+			if err := split.Mirror.validate(); err != nil {
+				return fmt.Errorf("Splits[%d] Mirror %v", i, err)
+			}
+		}
+	}
+
 	sumScaled := 0
 	for _, split := range e.Splits {
 		sumScaled += scaleWeight(split.Weight)
@@ -678,6 +855,10 @@ func (e *ServiceSplitterConfigEntry) ListRelatedServices() []ServiceID {
 		if splitID != svcID {
 			found[splitID] = struct{}{}
 		}
+
+		if mirrorID, ok := split.Mirror.relatedService(e.Name, &e.EnterpriseMeta); ok && mirrorID != svcID {
+			found[mirrorID] = struct{}{}
+		}
 	}
 
 	if len(found) == 0 {
@@ -738,6 +919,12 @@ type ServiceSplit struct {
 	// Allow HTTP header manipulation to be configured.
 	RequestHeaders  *HTTPHeaderModifiers `json:",omitempty" alias:"request_headers"`
 	ResponseHeaders *HTTPHeaderModifiers `json:",omitempty" alias:"response_headers"`
+
+	// Mirror sends a copy of a share of the requests to another service. The
+	// share is the Weight of the split scaled by the mirror Percentage, and
+	// is picked from all requests of the route rather than only those sent
+	// to this split, since Envoy can only mirror whole routes.
+	Mirror *MirrorPolicy `json:",omitempty"`
 }
 
 // MergeParent is called by the discovery chain compiler when a split directs to
@@ -768,6 +955,7 @@ func (s *ServiceSplit) MergeParent(parent *ServiceSplit) (*ServiceSplit, error)
 
 	if s == nil {
 		copy = *parent
+		copy.Mirror = parent.Mirror.clone()
 		copy.RequestHeaders, err = parent.RequestHeaders.Clone()
 		if err != nil {
 			return nil, err
@@ -805,9 +993,73 @@ func (s *ServiceSplit) MergeParent(parent *ServiceSplit) (*ServiceSplit, error)
 	if err != nil {
 		return nil, err
 	}
+
+	// Mirror the requests as the parent does _unless_ it's overridden by us.
+	if copy.Mirror == nil && parent != nil {
+		copy.Mirror = parent.Mirror.clone()
+	}
 	return &copy, nil
 }
 
+// MirrorPolicy sends a copy of a percentage of requests to another service,
+// such as a new version of a service, without affecting the responses to the
+// requests. The responses of the mirror are ignored.
+type MirrorPolicy struct {
+	// Service is the service to mirror the requests to. If empty the service
+	// of the config entry is used.
+	Service string `json:",omitempty"`
+
+	// ServiceSubset is a named subset of the given service to mirror the
+	// requests to instead of the one defined as that service's DefaultSubset.
+	ServiceSubset string `json:",omitempty" alias:"service_subset"`
+
+	// Namespace is the namespace to resolve the service from instead of the
+	// current namespace. If empty the current namespace is assumed.
+	Namespace string `json:",omitempty"`
+
+	// Partition is the partition to resolve the service from instead of the
+	// current partition. If empty the current partition is assumed.
+	Partition string `json:",omitempty"`
+
+	// Peer is the name of the cluster peer to resolve the service from
+	// instead of the current one.
+	Peer string `json:",omitempty"`
+
+	// Percentage is the percentage of requests to mirror, with a resolution
+	// of 0.01%. If zero every request is mirrored.
+	Percentage float32 `json:",omitempty"`
+}
+
+func (m *MirrorPolicy) clone() *MirrorPolicy {
+	if m == nil {
+		return nil
+	}
+	cp := *m
+	return &cp
+}
+
+// relatedService returns the service that the requests are mirrored to, unless
+// the service is on a cluster peer.
+func (m *MirrorPolicy) relatedService(name string, entMeta *acl.EnterpriseMeta) (ServiceID, bool) {
+	// We can't know about related services on cluster peers.
+	if m == nil || m.Peer != "" {
+		return ServiceID{}, false
+	}
+	return NewServiceID(defaultIfEmpty(m.Service, name), m.GetEnterpriseMeta(entMeta)), true
+}
+
+func (m *MirrorPolicy) validate() error {
+	switch {
+	case m.Peer != "" && m.Service == "":
+		return fmt.Errorf("Peer defined without Service")
+	case m.Peer != "" && m.ServiceSubset != "":
+		return fmt.Errorf("Peer cannot be set with ServiceSubset")
+	case m.Peer != "" && m.Partition != "":
+		return fmt.Errorf("Partition cannot be set with Peer")
+	}
+	return validatePercentage(m.Percentage)
+}
+
 // ServiceResolverConfigEntry defines which instances of a service should
 // satisfy discovery requests for a given named service.
 //
@@ -236,6 +236,28 @@ func TestConfigEntries_ListRelatedServices_AndACLs(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "splitter: mirror",
+			entry: &ServiceSplitterConfigEntry{
+				Kind: ServiceSplitter,
+				Name: "test",
+				Splits: []ServiceSplit{
+					{Weight: 100, Service: "a", Mirror: &MirrorPolicy{Service: "shadow"}},
+				},
+			},
+			expectServices: []ServiceID{NewServiceID("a", nil), NewServiceID("shadow", nil)},
+			expectACLs: []testACL{
+				defaultDenyCase,
+				readTestCase,
+				writeTestCaseDenied,
+				{
+					name:       "can write test (with a:read and shadow:read)",
+					authorizer: newServiceACL(t, []string{"a", "shadow"}, []string{"test"}),
+					canRead:    true,
+					canWrite:   true,
+				},
+			},
+		},
 		{
 			name: "router: self",
 			entry: &ServiceRouterConfigEntry{
@@ -286,6 +308,38 @@ func TestConfigEntries_ListRelatedServices_AndACLs(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "router: mirror",
+			entry: &ServiceRouterConfigEntry{
+				Kind: ServiceRouter,
+				Name: "test",
+				Routes: []ServiceRoute{
+					{
+						Destination: &ServiceRouteDestination{
+							Service: "foo",
+							Mirror:  &MirrorPolicy{Service: "shadow"},
+						},
+					},
+					{
+						Destination: &ServiceRouteDestination{
+							Mirror: &MirrorPolicy{Service: "remote", Peer: "cluster-01"},
+						},
+					},
+				},
+			},
+			expectServices: []ServiceID{NewServiceID("foo", nil), NewServiceID("shadow", nil), NewServiceID("test", nil)},
+			expectACLs: []testACL{
+				defaultDenyCase,
+				readTestCase,
+				writeTestCaseDenied,
+				{
+					name:       "can write test (with foo:read and shadow:read)",
+					authorizer: newServiceACL(t, []string{"foo", "shadow"}, []string{"test"}),
+					canRead:    true,
+					canWrite:   true,
+				},
+			},
+		},
 		{
 			name:  "ingress-gateway",
 			entry: &IngressGatewayConfigEntry{Name: "test"},
@@ -2127,6 +2181,27 @@ func TestServiceSplitterConfigEntry(t *testing.T) {
 			},
 			validateErr: "split destination occurs more than once",
 		},
+		{
+			name: "splits with the same mirror",
+			entry: makesplitter(
+				ServiceSplit{Weight: 50, ServiceSubset: "v1", Mirror: &MirrorPolicy{Service: "shadow"}},
+				ServiceSplit{Weight: 50, ServiceSubset: "v2", Mirror: &MirrorPolicy{Service: "shadow"}},
+			),
+		},
+		{
+			name: "split with mirror with invalid percentage",
+			entry: makesplitter(
+				ServiceSplit{Weight: 100, Mirror: &MirrorPolicy{Service: "shadow", Percentage: -1}},
+			),
+			validateErr: "Splits[0] Mirror Percentage must be between 0 and 100, got -1",
+		},
+		{
+			name: "split with mirror to peer without service",
+			entry: makesplitter(
+				ServiceSplit{Weight: 100, Mirror: &MirrorPolicy{Peer: "cluster-01"}},
+			),
+			validateErr: "Splits[0] Mirror Peer defined without Service",
+		},
 	} {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
@@ -2733,6 +2808,180 @@ func TestServiceRouterConfigEntry(t *testing.T) {
 			}),
 			validateErr: "contains an invalid retry condition: \"invalid-retry-condition\"",
 		},
+		////////////////
+		{
+			name: "route with fault injection",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					FaultInjection: &ServiceRouteFaultInjection{
+						Delay: &ServiceRouteFaultDelay{Duration: time.Second, Percentage: 10},
+						Abort: &ServiceRouteFaultAbort{HTTPStatus: 503, Percentage: 0.5},
+						Header: []ServiceRouteHTTPMatchHeader{
+							{Name: "x-chaos", Present: true},
+						},
+					},
+				},
+			}),
+		},
+		{
+			name: "route with empty fault injection",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					FaultInjection: &ServiceRouteFaultInjection{},
+				},
+			}),
+			validateErr: "Route[0] FaultInjection must specify at least one of Delay or Abort",
+		},
+		{
+			name: "route with fault injection without delay duration",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					FaultInjection: &ServiceRouteFaultInjection{
+						Delay: &ServiceRouteFaultDelay{Percentage: 10},
+					},
+				},
+			}),
+			validateErr: "Route[0] FaultInjection Delay Duration must be greater than 0",
+		},
+		{
+			name: "route with fault injection with invalid delay percentage",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					FaultInjection: &ServiceRouteFaultInjection{
+						Delay: &ServiceRouteFaultDelay{Duration: time.Second, Percentage: 101},
+					},
+				},
+			}),
+			validateErr: "Route[0] FaultInjection Delay Percentage must be greater than 0 and at most 100, got 101",
+		},
+		{
+			name: "route with fault injection without delay percentage",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					FaultInjection: &ServiceRouteFaultInjection{
+						Delay: &ServiceRouteFaultDelay{Duration: time.Second},
+					},
+				},
+			}),
+			validateErr: "Route[0] FaultInjection Delay Percentage must be greater than 0 and at most 100, got 0",
+		},
+		{
+			name: "route with fault injection without abort percentage",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					FaultInjection: &ServiceRouteFaultInjection{
+						Abort: &ServiceRouteFaultAbort{HTTPStatus: 503},
+					},
+				},
+			}),
+			validateErr: "Route[0] FaultInjection Abort Percentage must be greater than 0 and at most 100, got 0",
+		},
+		{
+			name: "route with fault injection without abort status",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					FaultInjection: &ServiceRouteFaultInjection{
+						Abort: &ServiceRouteFaultAbort{Percentage: 10},
+					},
+				},
+			}),
+			validateErr: "Route[0] FaultInjection Abort must specify one of HTTPStatus or GRPCStatus",
+		},
+		{
+			name: "route with fault injection with both abort statuses",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					FaultInjection: &ServiceRouteFaultInjection{
+						Abort: &ServiceRouteFaultAbort{HTTPStatus: 503, GRPCStatus: 14},
+					},
+				},
+			}),
+			validateErr: "Route[0] FaultInjection Abort should only contain one of HTTPStatus or GRPCStatus",
+		},
+		{
+			name: "route with fault injection with invalid abort http status",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					FaultInjection: &ServiceRouteFaultInjection{
+						Abort: &ServiceRouteFaultAbort{HTTPStatus: 99},
+					},
+				},
+			}),
+			validateErr: "Route[0] FaultInjection Abort HTTPStatus must be between 200 and 599, got 99",
+		},
+		{
+			name: "route with fault injection with invalid abort grpc status",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					FaultInjection: &ServiceRouteFaultInjection{
+						Abort: &ServiceRouteFaultAbort{GRPCStatus: 17},
+					},
+				},
+			}),
+			validateErr: "Route[0] FaultInjection Abort GRPCStatus must be between 1 and 16, got 17",
+		},
+		{
+			name: "route with fault injection with invalid header",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					FaultInjection: &ServiceRouteFaultInjection{
+						Abort: &ServiceRouteFaultAbort{HTTPStatus: 503, Percentage: 100},
+						Header: []ServiceRouteHTTPMatchHeader{
+							{Name: "x-chaos"},
+						},
+					},
+				},
+			}),
+			validateErr: "Route[0] FaultInjection Header[0] should only contain one of Present, Exact, Prefix, Suffix, or Regex",
+		},
+		////////////////
+		{
+			name: "route with mirror",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Mirror: &MirrorPolicy{
+						Service:    "shadow",
+						Peer:       "cluster-01",
+						Percentage: 12.5,
+					},
+				},
+			}),
+		},
+		{
+			name: "route with mirror with invalid percentage",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Mirror: &MirrorPolicy{Percentage: 150},
+				},
+			}),
+			validateErr: "Route[0] Mirror Percentage must be between 0 and 100, got 150",
+		},
+		{
+			name: "route with mirror to peer with subset",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Mirror: &MirrorPolicy{
+						Service:       "shadow",
+						ServiceSubset: "v2",
+						Peer:          "cluster-01",
+					},
+				},
+			}),
+			validateErr: "Route[0] Mirror Peer cannot be set with ServiceSubset",
+		},
+		{
+			name: "route with mirror to peer with partition",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Mirror: &MirrorPolicy{
+						Service:   "shadow",
+						Partition: "part",
+						Peer:      "cluster-01",
+					},
+				},
+			}),
+			validateErr: "Route[0] Mirror Partition cannot be set with Peer",
+		},
 	}
 
 	for _, tc := range cases {
@@ -23,6 +23,10 @@ type ServiceIntentionsConfigEntry struct {
 
 	JWT *IntentionJWTRequirement `json:",omitempty"`
 
+	// Mode is whether the intentions are enforced or only audited. It can be
+	// overridden by each source.
+	Mode IntentionMode `json:",omitempty"`
+
 	Meta map[string]string `json:",omitempty"` // formerly Intention.Meta
 
 	acl.EnterpriseMeta `hcl:",squash" mapstructure:",squash"` // formerly DestinationNS
@@ -140,6 +144,7 @@ func (e *ServiceIntentionsConfigEntry) ToIntention(src *SourceIntention) *Intent
 		SourceName:           src.Name,
 		SourceType:           src.Type,
 		JWT:                  e.JWT,
+		Mode:                 e.Mode,
 		Action:               src.Action,
 		Permissions:          src.Permissions,
 		Meta:                 meta,
@@ -150,6 +155,10 @@ func (e *ServiceIntentionsConfigEntry) ToIntention(src *SourceIntention) *Intent
 		RaftIndex:            e.RaftIndex,
 	}
 
+	if src.Mode != "" {
+		ixn.Mode = src.Mode
+	}
+
 	if src.LegacyCreateTime != nil {
 		ixn.CreatedAt = *src.LegacyCreateTime
 	}
@@ -278,6 +287,10 @@ type SourceIntention struct {
 
 	// SamenessGroup is the name of the sameness group, if applicable.
 	SamenessGroup string `json:",omitempty" alias:"sameness_group"`
+
+	// Mode is whether this intention is enforced or only audited. If empty
+	// the Mode of the config entry is used.
+	Mode IntentionMode `json:",omitempty"`
 }
 
 type IntentionJWTRequirement struct {
@@ -667,10 +680,17 @@ func (e *ServiceIntentionsConfigEntry) validate(legacyWrite bool) error {
 		}
 	}
 
+	if err := e.Mode.validate(); err != nil {
+		return fmt.Errorf("Mode %v", err)
+	}
+
 	if legacyWrite {
 		if len(e.Meta) > 0 {
 			return fmt.Errorf("Meta must be omitted for legacy intention writes")
 		}
+		if e.Mode != "" {
+			return fmt.Errorf("Mode must be omitted for legacy intention writes")
+		}
 	} else {
 		if err := validateConfigEntryMeta(e.Meta); err != nil {
 			return err
@@ -717,6 +737,10 @@ func (e *ServiceIntentionsConfigEntry) validate(legacyWrite bool) error {
 			return fmt.Errorf("Sources[%d].SamenessGroup: cannot set SamenessGroup and Peer at the same time", i)
 		}
 
+		if err := src.Mode.validate(); err != nil {
+			return fmt.Errorf("Sources[%d].Mode %v", i, err)
+		}
+
 		// Length of opaque values
 		if len(src.Description) > metaValueMaxLength {
 			return fmt.Errorf(
@@ -732,6 +756,10 @@ func (e *ServiceIntentionsConfigEntry) validate(legacyWrite bool) error {
 				return fmt.Errorf("Sources[%d].SamenessGroup cannot be set by legacy intentions", i)
 			}
 
+			if src.Mode != "" {
+				return fmt.Errorf("Sources[%d].Mode cannot be set by legacy intentions", i)
+			}
+
 			if len(src.LegacyMeta) > metaMaxKeyPairs {
 				return fmt.Errorf(
 					"Sources[%d].Meta exceeds maximum element count %d", i, metaMaxKeyPairs)
@@ -1412,6 +1412,76 @@ func TestServiceIntentionsConfigEntry(t *testing.T) {
 				},
 			},
 		},
+		"invalid mode": {
+			entry: &ServiceIntentionsConfigEntry{
+				Kind: ServiceIntentions,
+				Name: "test",
+				Mode: "dry-run",
+				Sources: []*SourceIntention{
+					{
+						Name:   "foo",
+						Action: IntentionActionAllow,
+					},
+				},
+			},
+			validateErr: `Mode must be one of "enforce" or "audit"`,
+		},
+		"invalid source mode": {
+			entry: &ServiceIntentionsConfigEntry{
+				Kind: ServiceIntentions,
+				Name: "test",
+				Sources: []*SourceIntention{
+					{
+						Name:   "foo",
+						Action: IntentionActionAllow,
+						Mode:   "dry-run",
+					},
+				},
+			},
+			validateErr: `Sources[0].Mode must be one of "enforce" or "audit"`,
+		},
+		"mode not allowed on legacy writes": {
+			legacy: true,
+			entry: &ServiceIntentionsConfigEntry{
+				Kind: ServiceIntentions,
+				Name: "test",
+				Sources: []*SourceIntention{
+					{
+						LegacyID:         legacyIDs[0],
+						Name:             "foo",
+						Action:           IntentionActionAllow,
+						LegacyCreateTime: &testTimeA,
+						LegacyUpdateTime: &testTimeA,
+						Mode:             IntentionModeAudit,
+					},
+				},
+			},
+			validateErr: "Sources[0].Mode cannot be set by legacy intentions",
+		},
+		"audit mode": {
+			entry: &ServiceIntentionsConfigEntry{
+				Kind: ServiceIntentions,
+				Name: "test",
+				Mode: IntentionModeAudit,
+				Sources: []*SourceIntention{
+					{
+						Name:   "foo",
+						Action: IntentionActionDeny,
+					},
+					{
+						Name:   "bar",
+						Action: IntentionActionAllow,
+						Mode:   IntentionModeEnforce,
+					},
+				},
+			},
+			check: func(t *testing.T, entry *ServiceIntentionsConfigEntry) {
+				ixns := entry.ToIntentions()
+				require.Len(t, ixns, 2)
+				require.True(t, ixns[0].IsAudit())
+				require.False(t, ixns[1].IsAudit())
+			},
+		},
 	}
 	for name, tc := range cases {
 		tc := tc
@@ -645,6 +645,27 @@ func TestDecodeConfigEntry(t *testing.T) {
 								}
 								remove = ["qux"]
 							}
+							fault_injection {
+								delay {
+									duration   = "2s"
+									percentage = 12.5
+								}
+								abort {
+									http_status = 503
+									percentage  = 50
+								}
+								header = [
+									{
+										name    = "x-chaos"
+										present = true
+									},
+								]
+							}
+							mirror {
+								service        = "shadow"
+								service_subset = "v2"
+								percentage     = 25
+							}
 						}
 					},
 					{
@@ -747,6 +768,27 @@ func TestDecodeConfigEntry(t *testing.T) {
 								}
 								Remove = ["qux"]
 							}
+							FaultInjection {
+								Delay {
+									Duration   = "2s"
+									Percentage = 12.5
+								}
+								Abort {
+									HTTPStatus = 503
+									Percentage = 50
+								}
+								Header = [
+									{
+										Name    = "x-chaos"
+										Present = true
+									},
+								]
+							}
+							Mirror {
+								Service       = "shadow"
+								ServiceSubset = "v2"
+								Percentage    = 25
+							}
 						}
 					},
 					{
@@ -841,6 +883,24 @@ func TestDecodeConfigEntry(t *testing.T) {
 								Set:    map[string]string{"bar": "baz"},
 								Remove: []string{"qux"},
 							},
+							FaultInjection: &ServiceRouteFaultInjection{
+								Delay: &ServiceRouteFaultDelay{
+									Duration:   2 * time.Second,
+									Percentage: 12.5,
+								},
+								Abort: &ServiceRouteFaultAbort{
+									HTTPStatus: 503,
+									Percentage: 50,
+								},
+								Header: []ServiceRouteHTTPMatchHeader{
+									{Name: "x-chaos", Present: true},
+								},
+							},
+							Mirror: &MirrorPolicy{
+								Service:       "shadow",
+								ServiceSubset: "v2",
+								Percentage:    25,
+							},
 						},
 					},
 					{
@@ -2907,6 +2967,17 @@ func TestServiceConfigEntry(t *testing.T) {
 			},
 			validateErr: `Invalid MutualTLSMode "invalid-mtls-mode". Must be one of "", "strict", or "permissive".`,
 		},
+		"validate: invalid tracing provider in service-defaults": {
+			entry: &ServiceConfigEntry{
+				Kind: ServiceDefaults,
+				Name: "web",
+				Tracing: &TracingConfig{
+					Provider:    TracingProvider("jaeger"),
+					ServiceName: "jaeger",
+				},
+			},
+			validateErr: "invalid tracing provider: jaeger",
+		},
 	}
 	testConfigEntryNormalizeAndValidate(t, cases)
 }
@@ -3244,6 +3315,9 @@ func TestParseUpstreamConfig(t *testing.T) {
 }
 
 func TestProxyConfigEntry(t *testing.T) {
+	tracingSampling := 25.0
+	invalidTracingSampling := 101.0
+
 	cases := map[string]configEntryTestcase{
 		"proxy config name provided is not global": {
 			entry: &ProxyConfigEntry{
@@ -3331,6 +3405,166 @@ func TestProxyConfigEntry(t *testing.T) {
 			},
 			validateErr: "invalid access log json for JSON format",
 		},
+		"proxy config has valid opentelemetry access log config": {
+			entry: &ProxyConfigEntry{
+				Name: "global",
+				AccessLogs: AccessLogsConfig{
+					Enabled:     true,
+					Type:        OpenTelemetryLogSinkType,
+					ServiceName: "otel-collector",
+					LogName:     "mesh",
+					TextFormat:  "%START_TIME% %RESPONSE_CODE%",
+				},
+			},
+			expected: &ProxyConfigEntry{
+				Name: ProxyConfigGlobal,
+				Kind: ProxyDefaults,
+				AccessLogs: AccessLogsConfig{
+					Enabled:     true,
+					Type:        OpenTelemetryLogSinkType,
+					ServiceName: "otel-collector",
+					LogName:     "mesh",
+					TextFormat:  "%START_TIME% %RESPONSE_CODE%",
+				},
+				EnterpriseMeta: *acl.DefaultEnterpriseMeta(),
+			},
+		},
+		"proxy config has invalid access log config - no service specified": {
+			entry: &ProxyConfigEntry{
+				Name: "global",
+				AccessLogs: AccessLogsConfig{
+					Enabled: true,
+					Type:    GRPCLogSinkType,
+				},
+			},
+			validateErr: "service name must be specified when using grpc type access logs",
+		},
+		"proxy config has invalid access log config - service with wrong type": {
+			entry: &ProxyConfigEntry{
+				Name: "global",
+				AccessLogs: AccessLogsConfig{
+					Enabled:     true,
+					Type:        StdOutLogSinkType,
+					ServiceName: "otel-collector",
+				},
+			},
+			validateErr: "service is only valid for grpc and opentelemetry type access logs",
+		},
+		"proxy config has invalid access log config - log name with wrong type": {
+			entry: &ProxyConfigEntry{
+				Name: "global",
+				AccessLogs: AccessLogsConfig{
+					Enabled: true,
+					LogName: "mesh",
+				},
+			},
+			validateErr: "log name is only valid for grpc and opentelemetry type access logs",
+		},
+		"proxy config has invalid access log config - format with grpc type": {
+			entry: &ProxyConfigEntry{
+				Name: "global",
+				AccessLogs: AccessLogsConfig{
+					Enabled:     true,
+					Type:        GRPCLogSinkType,
+					ServiceName: "als",
+					TextFormat:  "%START_TIME%",
+				},
+			},
+			validateErr: "formats are not supported for grpc type access logs",
+		},
+		"proxy config has valid tracing config": {
+			entry: &ProxyConfigEntry{
+				Name: "global",
+				Tracing: &TracingConfig{
+					Provider:       ZipkinTracingProvider,
+					ServiceName:    "zipkin",
+					RandomSampling: &tracingSampling,
+					CustomTags: []TracingCustomTag{
+						{Tag: "pod", Environment: "POD_NAME", DefaultValue: "unknown"},
+					},
+				},
+			},
+			expected: &ProxyConfigEntry{
+				Name: ProxyConfigGlobal,
+				Kind: ProxyDefaults,
+				Tracing: &TracingConfig{
+					Provider:       ZipkinTracingProvider,
+					ServiceName:    "zipkin",
+					RandomSampling: &tracingSampling,
+					CustomTags: []TracingCustomTag{
+						{Tag: "pod", Environment: "POD_NAME", DefaultValue: "unknown"},
+					},
+				},
+				EnterpriseMeta: *acl.DefaultEnterpriseMeta(),
+			},
+		},
+		"proxy config has invalid tracing config - no provider": {
+			entry: &ProxyConfigEntry{
+				Name: "global",
+				Tracing: &TracingConfig{
+					ServiceName: "otel-collector",
+				},
+			},
+			validateErr: "tracing provider must be specified",
+		},
+		"proxy config has invalid tracing config - no service specified": {
+			entry: &ProxyConfigEntry{
+				Name: "global",
+				Tracing: &TracingConfig{
+					Provider: OpenTelemetryTracingProvider,
+				},
+			},
+			validateErr: "tracing service name must be specified",
+		},
+		"proxy config has invalid tracing config - collector endpoint with opentelemetry": {
+			entry: &ProxyConfigEntry{
+				Name: "global",
+				Tracing: &TracingConfig{
+					Provider:          OpenTelemetryTracingProvider,
+					ServiceName:       "otel-collector",
+					CollectorEndpoint: "/api/v2/spans",
+				},
+			},
+			validateErr: "collector endpoint is only valid for the zipkin tracing provider",
+		},
+		"proxy config has invalid tracing config - sampling out of range": {
+			entry: &ProxyConfigEntry{
+				Name: "global",
+				Tracing: &TracingConfig{
+					Provider:        OpenTelemetryTracingProvider,
+					ServiceName:     "otel-collector",
+					OverallSampling: &invalidTracingSampling,
+				},
+			},
+			validateErr: "tracing overall sampling must be between 0 and 100, got 101",
+		},
+		"proxy config has invalid tracing config - custom tag with two sources": {
+			entry: &ProxyConfigEntry{
+				Name: "global",
+				Tracing: &TracingConfig{
+					Provider:    OpenTelemetryTracingProvider,
+					ServiceName: "otel-collector",
+					CustomTags: []TracingCustomTag{
+						{Tag: "team", Literal: "payments", RequestHeader: "x-team"},
+					},
+				},
+			},
+			validateErr: `tracing custom tag "team" must specify exactly one of Literal, Environment, or RequestHeader`,
+		},
+		"proxy config has invalid tracing config - duplicate custom tag": {
+			entry: &ProxyConfigEntry{
+				Name: "global",
+				Tracing: &TracingConfig{
+					Provider:    OpenTelemetryTracingProvider,
+					ServiceName: "otel-collector",
+					CustomTags: []TracingCustomTag{
+						{Tag: "team", Literal: "payments"},
+						{Tag: "team", Literal: "billing"},
+					},
+				},
+			},
+			validateErr: `duplicate tracing custom tag "team"`,
+		},
 	}
 	testConfigEntryNormalizeAndValidate(t, cases)
 }
@@ -22,6 +22,11 @@ type ConnectAuthorizeRequest struct {
 	// lists.
 	ClientCertURI    string
 	ClientCertSerial string
+
+	// HTTP optionally describes the HTTP request being authorized. If it is
+	// set, the L7 permissions of a matching intention are evaluated against
+	// it instead of denying the request.
+	HTTP *IntentionCheckHTTPRequest `json:",omitempty"`
 }
 
 func (req *ConnectAuthorizeRequest) TargetPartition() string {
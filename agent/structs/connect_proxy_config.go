@@ -43,10 +43,24 @@ const (
 type LogSinkType string
 
 const (
-	DefaultLogSinkType LogSinkType = ""
-	FileLogSinkType    LogSinkType = "file"
-	StdErrLogSinkType  LogSinkType = "stderr"
-	StdOutLogSinkType  LogSinkType = "stdout"
+	DefaultLogSinkType       LogSinkType = ""
+	FileLogSinkType          LogSinkType = "file"
+	StdErrLogSinkType        LogSinkType = "stderr"
+	StdOutLogSinkType        LogSinkType = "stdout"
+	GRPCLogSinkType          LogSinkType = "grpc"
+	OpenTelemetryLogSinkType LogSinkType = "opentelemetry"
+)
+
+// IsGRPC returns true if logs of this type are sent to a service over gRPC.
+func (t LogSinkType) IsGRPC() bool {
+	return t == GRPCLogSinkType || t == OpenTelemetryLogSinkType
+}
+
+type TracingProvider string
+
+const (
+	OpenTelemetryTracingProvider TracingProvider = "opentelemetry"
+	ZipkinTracingProvider        TracingProvider = "zipkin"
 )
 
 const (
@@ -168,12 +182,22 @@ type AccessLogsConfig struct {
 	// have a matching listener filter.
 	DisableListenerLogs bool `json:",omitempty" alias:"disable_listener_logs"`
 
-	// Type selects the output for logs: "file", "stderr". "stdout"
+	// Type selects the output for logs: "file", "stderr", "stdout", "grpc", "opentelemetry"
 	Type LogSinkType `json:",omitempty" alias:"type"`
 
 	// Path is the output file to write logs
 	Path string `json:",omitempty" alias:"path"`
 
+	// ServiceName, ServiceNamespace and ServicePartition identify the upstream service
+	// that receives the logs for the "grpc" and "opentelemetry" types.
+	ServiceName      string `json:",omitempty" alias:"service_name"`
+	ServiceNamespace string `json:",omitempty" alias:"service_namespace"`
+	ServicePartition string `json:",omitempty" alias:"service_partition"`
+
+	// LogName identifies the log stream to the receiving service for the
+	// "grpc" and "opentelemetry" types.
+	LogName string `json:",omitempty" alias:"log_name"`
+
 	// The presence of one format string or the other implies the access log string encoding.
 	// Defining Both is invalid.
 	JSONFormat string `json:",omitempty" alias:"json_format"`
@@ -197,6 +221,10 @@ func (c *AccessLogsConfig) ToAPI() *api.AccessLogsConfig {
 		DisableListenerLogs: c.DisableListenerLogs,
 		Type:                api.LogSinkType(c.Type),
 		Path:                c.Path,
+		ServiceName:         c.ServiceName,
+		ServiceNamespace:    c.ServiceNamespace,
+		ServicePartition:    c.ServicePartition,
+		LogName:             c.LogName,
 		JSONFormat:          c.JSONFormat,
 		TextFormat:          c.TextFormat,
 	}
@@ -210,6 +238,10 @@ func (c *AccessLogsConfig) Validate() error {
 		if c.Path == "" {
 			return errors.New("path must be specified when using file type access logs")
 		}
+	case GRPCLogSinkType, OpenTelemetryLogSinkType:
+		if c.ServiceName == "" {
+			return fmt.Errorf("service name must be specified when using %s type access logs", c.Type)
+		}
 	default:
 		return fmt.Errorf("invalid access log type: %s", c.Type)
 	}
@@ -222,6 +254,19 @@ func (c *AccessLogsConfig) Validate() error {
 		return errors.New("path is only valid for file type access logs")
 	}
 
+	if !c.Type.IsGRPC() {
+		if c.ServiceName != "" || c.ServiceNamespace != "" || c.ServicePartition != "" {
+			return errors.New("service is only valid for grpc and opentelemetry type access logs")
+		}
+		if c.LogName != "" {
+			return errors.New("log name is only valid for grpc and opentelemetry type access logs")
+		}
+	}
+
+	if c.Type == GRPCLogSinkType && (c.JSONFormat != "" || c.TextFormat != "") {
+		return errors.New("formats are not supported for grpc type access logs")
+	}
+
 	if c.JSONFormat != "" {
 		msg := json.RawMessage{}
 		if err := json.Unmarshal([]byte(c.JSONFormat), &msg); err != nil {
@@ -231,6 +276,106 @@ func (c *AccessLogsConfig) Validate() error {
 	return nil
 }
 
+// TracingConfig configures Envoy to report spans of the HTTP requests that pass
+// through the proxy to a tracing collector.
+type TracingConfig struct {
+	// Provider selects the tracer used by Envoy: "opentelemetry" or "zipkin".
+	Provider TracingProvider `json:",omitempty" alias:"provider"`
+
+	// ServiceName, ServiceNamespace and ServicePartition identify the upstream service
+	// of the tracing collector.
+	ServiceName      string `json:",omitempty" alias:"service_name"`
+	ServiceNamespace string `json:",omitempty" alias:"service_namespace"`
+	ServicePartition string `json:",omitempty" alias:"service_partition"`
+
+	// CollectorEndpoint is the API endpoint of the collector that spans are sent to.
+	// It is only valid for the "zipkin" provider and defaults to "/api/v2/spans".
+	CollectorEndpoint string `json:",omitempty" alias:"collector_endpoint"`
+
+	// ClientSampling, RandomSampling and OverallSampling are the percentages of
+	// requests that are traced when the client forces tracing, when the request
+	// is not already traced, and overall. Envoy traces 100% of requests by default.
+	ClientSampling  *float64 `json:",omitempty" alias:"client_sampling"`
+	RandomSampling  *float64 `json:",omitempty" alias:"random_sampling"`
+	OverallSampling *float64 `json:",omitempty" alias:"overall_sampling"`
+
+	// CustomTags are added to every span.
+	CustomTags []TracingCustomTag `json:",omitempty" alias:"custom_tags"`
+}
+
+// TracingCustomTag is a tag added to spans. Its value is exactly one of a
+// literal, an environment variable of the proxy, or a request header.
+type TracingCustomTag struct {
+	Tag           string `json:",omitempty" alias:"tag"`
+	Literal       string `json:",omitempty" alias:"literal"`
+	Environment   string `json:",omitempty" alias:"environment"`
+	RequestHeader string `json:",omitempty" alias:"request_header"`
+
+	// DefaultValue is used when the environment variable or request header is not set.
+	DefaultValue string `json:",omitempty" alias:"default_value"`
+}
+
+func (c *TracingConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	switch c.Provider {
+	case OpenTelemetryTracingProvider, ZipkinTracingProvider:
+		// OK
+	case "":
+		return errors.New("tracing provider must be specified")
+	default:
+		return fmt.Errorf("invalid tracing provider: %s", c.Provider)
+	}
+
+	if c.ServiceName == "" {
+		return errors.New("tracing service name must be specified")
+	}
+
+	if c.Provider != ZipkinTracingProvider && c.CollectorEndpoint != "" {
+		return errors.New("collector endpoint is only valid for the zipkin tracing provider")
+	}
+
+	for _, s := range []struct {
+		name     string
+		sampling *float64
+	}{
+		{"client", c.ClientSampling},
+		{"random", c.RandomSampling},
+		{"overall", c.OverallSampling},
+	} {
+		if s.sampling != nil && (*s.sampling < 0 || *s.sampling > 100) {
+			return fmt.Errorf("tracing %s sampling must be between 0 and 100, got %v", s.name, *s.sampling)
+		}
+	}
+
+	seen := make(map[string]bool, len(c.CustomTags))
+	for _, tag := range c.CustomTags {
+		if tag.Tag == "" {
+			return errors.New("tracing custom tag name must be specified")
+		}
+		if seen[tag.Tag] {
+			return fmt.Errorf("duplicate tracing custom tag %q", tag.Tag)
+		}
+		seen[tag.Tag] = true
+
+		var sources int
+		for _, v := range []string{tag.Literal, tag.Environment, tag.RequestHeader} {
+			if v != "" {
+				sources++
+			}
+		}
+		if sources != 1 {
+			return fmt.Errorf("tracing custom tag %q must specify exactly one of Literal, Environment, or RequestHeader", tag.Tag)
+		}
+		if tag.Literal != "" && tag.DefaultValue != "" {
+			return fmt.Errorf("tracing custom tag %q cannot specify a default value for a literal", tag.Tag)
+		}
+	}
+	return nil
+}
+
 // ConnectProxyConfig describes the configuration needed for any proxy managed
 // or unmanaged. It describes a single logical service's listener and optionally
 // upstreams and sidecar-related config for a single instance. To describe a
@@ -294,6 +439,9 @@ type ConnectProxyConfig struct {
 
 	// AccessLogs configures the output and format of Envoy access logs
 	AccessLogs AccessLogsConfig `json:",omitempty" alias:"access_logs"`
+
+	// Tracing configures Envoy to report spans to a tracing collector.
+	Tracing *TracingConfig `json:"-" bexpr:"-"`
 }
 
 func (t *ConnectProxyConfig) UnmarshalJSON(data []byte) (err error) {
@@ -350,6 +498,18 @@ func (t *ConnectProxyConfig) UnmarshalJSON(data []byte) (err error) {
 	if t.AccessLogs.Path == "" {
 		t.AccessLogs.Path = aux.AccessLogsSnake.Path
 	}
+	if t.AccessLogs.ServiceName == "" {
+		t.AccessLogs.ServiceName = aux.AccessLogsSnake.ServiceName
+	}
+	if t.AccessLogs.ServiceNamespace == "" {
+		t.AccessLogs.ServiceNamespace = aux.AccessLogsSnake.ServiceNamespace
+	}
+	if t.AccessLogs.ServicePartition == "" {
+		t.AccessLogs.ServicePartition = aux.AccessLogsSnake.ServicePartition
+	}
+	if t.AccessLogs.LogName == "" {
+		t.AccessLogs.LogName = aux.AccessLogsSnake.LogName
+	}
 	if t.AccessLogs.JSONFormat == "" {
 		t.AccessLogs.JSONFormat = aux.AccessLogsSnake.JSONFormat
 	}
@@ -165,6 +165,10 @@ func (r *DiscoveryResolver) UnmarshalJSON(data []byte) error {
 type DiscoveryRoute struct {
 	Definition *ServiceRoute `json:",omitempty"`
 	NextNode   string        `json:",omitempty"`
+
+	// MirrorNode is the resolver node that the requests are mirrored to, if
+	// the route mirrors requests.
+	MirrorNode string `json:",omitempty"`
 }
 
 // compiled form of ServiceSplit
@@ -178,6 +182,10 @@ type DiscoverySplit struct {
 	// to that leg of the split.
 	Weight   float32 `json:",omitempty"`
 	NextNode string  `json:",omitempty"`
+
+	// MirrorNode is the resolver node that the requests of this split leg are
+	// mirrored to, if the split mirrors requests.
+	MirrorNode string `json:",omitempty"`
 }
 
 // compiled form of ServiceResolverFailover
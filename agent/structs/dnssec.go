@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package structs
+
+import (
+	"time"
+)
+
+// DNSSECKey is a key used to sign DNS answers. The same key material signs
+// the Consul domain and the alt domain; only the owner name of the DNSKEY
+// record differs.
+type DNSSECKey struct {
+	// KeyTag identifies the key in DS and RRSIG records.
+	KeyTag uint16
+
+	// Algorithm is the DNSSEC algorithm number of the key.
+	Algorithm uint8
+
+	// Flags are the DNSKEY flags of the key.
+	Flags uint16
+
+	// PublicKey is the base64 encoded public key, as in a DNSKEY record.
+	PublicKey string
+
+	// PrivateKey is the private key in the BIND private key format. It is
+	// only returned by Operator.DNSSECSigningKeys, to agents signing answers.
+	PrivateKey string `json:",omitempty"`
+
+	// Active is set on the key answers are signed with. Inactive keys are
+	// still published so that signatures made before a rollover validate
+	// until they expire from caches, and so that the DNSKEY RRset validates
+	// against the DS record of any of them.
+	Active bool
+
+	// ActivateTime is when a key pending activation becomes the active key.
+	// New keys are published this long before answers are signed with them,
+	// so that validating resolvers have fetched the new DNSKEY RRset by then.
+	// It is zero for keys that have been activated.
+	ActivateTime time.Time `json:",omitempty"`
+
+	// CreateTime is when the key was generated.
+	CreateTime time.Time
+}
+
+// DNSSECKeyring is the set of DNSSEC keys shared by all agents. It is stored
+// in the system metadata of the primary datacenter, including the private
+// keys, which are not encrypted in the Raft log or in snapshots.
+type DNSSECKeyring struct {
+	Keys []*DNSSECKey
+}
+
+// ActiveKey returns the key answers are signed with.
+func (k *DNSSECKeyring) ActiveKey() *DNSSECKey {
+	for _, key := range k.Keys {
+		if key.Active {
+			return key
+		}
+	}
+	return nil
+}
+
+// PendingKey returns the key waiting to become the active key, if any.
+func (k *DNSSECKeyring) PendingKey() *DNSSECKey {
+	for _, key := range k.Keys {
+		if !key.Active && !key.ActivateTime.IsZero() {
+			return key
+		}
+	}
+	return nil
+}
+
+// IndexedDNSSECKeys is the response of the Operator.DNSSECKeyringGet and
+// Operator.DNSSECSigningKeys endpoints.
+type IndexedDNSSECKeys struct {
+	Keys []*DNSSECKey
+	QueryMeta
+}
+
+// DNSSECKeyRequest is used to rotate the active DNSSEC key or to remove an
+// inactive key.
+type DNSSECKeyRequest struct {
+	// Datacenter is the target this request is intended for.
+	Datacenter string
+
+	// KeyTag is the key to remove.
+	KeyTag uint16
+
+	// WriteRequest holds the ACL token to go along with this request.
+	WriteRequest
+}
+
+// RequestDatacenter returns the datacenter for a given request.
+func (r *DNSSECKeyRequest) RequestDatacenter() string {
+	return r.Datacenter
+}
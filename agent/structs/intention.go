@@ -86,6 +86,11 @@ type Intention struct {
 	// JWT specifies JWT authn that applies to incoming requests.
 	JWT *IntentionJWTRequirement `bexpr:"-" json:",omitempty"`
 
+	// Mode is whether the intention is enforced or only audited.
+	//
+	// NOTE: This field is not editable by legacy intention writes.
+	Mode IntentionMode `json:",omitempty"`
+
 	// DefaultAddr is not used.
 	// Deprecated: DefaultAddr is not used and may be removed in a future version.
 	DefaultAddr string `bexpr:"-" codec:",omitempty" json:",omitempty"`
@@ -500,10 +505,16 @@ func (x *Intention) ToSourceIntention(legacy bool) *SourceIntention {
 	}
 	if !legacy {
 		src.Permissions = x.Permissions
+		src.Mode = x.Mode
 	}
 	return src
 }
 
+// IsAudit returns true if the intention is not enforced, but only audited.
+func (x *Intention) IsAudit() bool {
+	return x.Mode == IntentionModeAudit
+}
+
 // IntentionAction is the action that the intention represents. This
 // can be "allow" or "deny".
 type IntentionAction string
@@ -513,6 +524,29 @@ const (
 	IntentionActionDeny  IntentionAction = "deny"
 )
 
+// IntentionMode is whether an intention is enforced or only audited.
+type IntentionMode string
+
+const (
+	// IntentionModeEnforce is the default mode. The intention is used to
+	// authorize connections and requests.
+	IntentionModeEnforce IntentionMode = "enforce"
+
+	// IntentionModeAudit ignores the intention when authorizing connections
+	// and requests. Instead the proxies count and log the connections and
+	// requests that the intention would deny, so that the effect of the
+	// intention can be checked before it is enforced.
+	IntentionModeAudit IntentionMode = "audit"
+)
+
+func (m IntentionMode) validate() error {
+	switch m {
+	case "", IntentionModeEnforce, IntentionModeAudit:
+		return nil
+	}
+	return fmt.Errorf("must be one of %q or %q", IntentionModeEnforce, IntentionModeAudit)
+}
+
 // IntentionSourceType is the type of the source within an intention.
 type IntentionSourceType string
 
@@ -707,6 +741,11 @@ type IntentionQueryCheck struct {
 
 	// SourceType is the type of the value for the source.
 	SourceType IntentionSourceType
+
+	// HTTP optionally describes an HTTP request to evaluate against the L7
+	// permissions of the matching intention. If it is nil, intentions with
+	// L7 permissions deny the connection.
+	HTTP *IntentionCheckHTTPRequest `json:",omitempty"`
 }
 
 // GetACLPrefix returns the prefix to look up the ACL policy for this
@@ -716,9 +755,48 @@ func (q *IntentionQueryCheck) GetACLPrefix() (string, bool) {
 	return q.DestinationName, q.DestinationName != ""
 }
 
+// IntentionCheckHTTPRequest is a simulated HTTP request used to evaluate
+// L7 intention permissions.
+type IntentionCheckHTTPRequest struct {
+	Method string `json:",omitempty"`
+
+	// Path is the request path. Any query string is ignored, as it is by
+	// the RBAC filter when matching paths.
+	Path string `json:",omitempty"`
+
+	// Header maps header names to their values. Names are matched case
+	// insensitively and multiple values should be joined with a comma.
+	Header map[string]string `json:",omitempty"`
+}
+
 // IntentionQueryCheckResponse is the response for a test request.
 type IntentionQueryCheckResponse struct {
 	Allowed bool
+
+	// Explanation describes how the decision was reached. It is only set
+	// when the token used for the request may read the matching intention.
+	Explanation *IntentionCheckExplanation `json:",omitempty"`
+}
+
+// IntentionCheckExplanation describes which intention and permission
+// decided the outcome of an intention check.
+type IntentionCheckExplanation struct {
+	// Intention is the highest precedence intention matching the source and
+	// destination. It is nil if the default intention policy applied.
+	Intention *Intention `json:",omitempty"`
+
+	// Permission is the first L7 permission of Intention that matched the
+	// request, and PermissionIndex is its position in Intention.Permissions.
+	// PermissionIndex is only meaningful if Permission is set.
+	Permission      *IntentionPermission `json:",omitempty"`
+	PermissionIndex int
+
+	// DefaultAllow is the default intention policy that applies when no
+	// intention or permission matches.
+	DefaultAllow bool
+
+	// Reason is a human-readable summary of the decision.
+	Reason string
 }
 
 // IntentionDecisionSummary contains a summary of a set of intentions between two services
@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package structs
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/consul/acl"
+)
+
+// MaintenanceWindowOp is the operation for a request related to scheduled
+// maintenance windows.
+type MaintenanceWindowOp string
+
+const (
+	MaintenanceWindowUpsert MaintenanceWindowOp = "upsert"
+	MaintenanceWindowDelete MaintenanceWindowOp = "delete"
+)
+
+// MaintenanceWindow is a scheduled period during which a node, or a single
+// service on that node, is placed into maintenance mode. Agents watch the
+// windows for their own node and add or remove the maintenance check when a
+// window opens or closes.
+type MaintenanceWindow struct {
+	// ID uniquely identifies the window. It is generated by the servers if
+	// not provided when the window is created.
+	ID string
+
+	// Node is the node the window applies to.
+	Node string
+
+	// ServiceID, if set, restricts the window to a single service instance
+	// on Node. Otherwise the whole node is placed into maintenance.
+	ServiceID string `json:",omitempty"`
+
+	// Start is when the first occurrence of the window opens.
+	Start time.Time
+
+	// Duration is how long each occurrence of the window stays open.
+	Duration time.Duration
+
+	// Recurrence, if non-zero, is the interval between the start of
+	// consecutive occurrences. A zero value means the window occurs once.
+	Recurrence time.Duration `json:",omitempty"`
+
+	// Reason is included in the notes of the maintenance check.
+	Reason string `json:",omitempty"`
+
+	acl.EnterpriseMeta `hcl:",squash" mapstructure:",squash" bexpr:"-"`
+	RaftIndex          `bexpr:"-"`
+}
+
+// Validate returns an error if the window is not well formed.
+func (w *MaintenanceWindow) Validate() error {
+	if w.Node == "" {
+		return fmt.Errorf("Must provide node")
+	}
+	if w.Start.IsZero() {
+		return fmt.Errorf("Must provide start time")
+	}
+	if w.Duration <= 0 {
+		return fmt.Errorf("Duration must be > 0")
+	}
+	if w.Recurrence < 0 {
+		return fmt.Errorf("Recurrence must not be negative")
+	}
+	if w.Recurrence > 0 && w.Recurrence < w.Duration {
+		return fmt.Errorf("Recurrence must be greater than or equal to Duration")
+	}
+	return nil
+}
+
+// occurrence returns the start of the most recent occurrence of the window
+// that began at or before t. The second return value is false if the window
+// has not started yet.
+func (w *MaintenanceWindow) occurrence(t time.Time) (time.Time, bool) {
+	if t.Before(w.Start) {
+		return time.Time{}, false
+	}
+	if w.Recurrence <= 0 {
+		return w.Start, true
+	}
+	n := t.Sub(w.Start) / w.Recurrence
+	return w.Start.Add(n * w.Recurrence), true
+}
+
+// ActiveAt reports whether the window is open at the given time.
+func (w *MaintenanceWindow) ActiveAt(t time.Time) bool {
+	start, ok := w.occurrence(t)
+	if !ok {
+		return false
+	}
+	return t.Before(start.Add(w.Duration))
+}
+
+// NextTransition returns the next time after t at which the window opens or
+// closes. A zero time is returned if the window will never change state
+// again.
+func (w *MaintenanceWindow) NextTransition(t time.Time) time.Time {
+	start, ok := w.occurrence(t)
+	if !ok {
+		return w.Start
+	}
+	if end := start.Add(w.Duration); t.Before(end) {
+		return end
+	}
+	if w.Recurrence <= 0 {
+		return time.Time{}
+	}
+	return start.Add(w.Recurrence)
+}
+
+func (w *MaintenanceWindow) MarshalJSON() ([]byte, error) {
+	type Alias MaintenanceWindow
+	exported := &struct {
+		Duration   string
+		Recurrence string `json:",omitempty"`
+		*Alias
+	}{
+		Duration: w.Duration.String(),
+		Alias:    (*Alias)(w),
+	}
+	if w.Recurrence != 0 {
+		exported.Recurrence = w.Recurrence.String()
+	}
+
+	return json.Marshal(exported)
+}
+
+func (w *MaintenanceWindow) UnmarshalJSON(data []byte) (err error) {
+	type Alias MaintenanceWindow
+	aux := &struct {
+		Duration   interface{}
+		Recurrence interface{}
+		*Alias
+	}{
+		Alias: (*Alias)(w),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	if aux.Duration != nil {
+		switch v := aux.Duration.(type) {
+		case string:
+			if w.Duration, err = time.ParseDuration(v); err != nil {
+				return err
+			}
+		case float64:
+			w.Duration = time.Duration(v)
+		}
+	}
+	if aux.Recurrence != nil {
+		switch v := aux.Recurrence.(type) {
+		case string:
+			if w.Recurrence, err = time.ParseDuration(v); err != nil {
+				return err
+			}
+		case float64:
+			w.Recurrence = time.Duration(v)
+		}
+	}
+	return nil
+}
+
+// MaintenanceWindows is a list of maintenance windows.
+type MaintenanceWindows []*MaintenanceWindow
+
+// MaintenanceWindowRequest is used to create, update and delete scheduled
+// maintenance windows.
+type MaintenanceWindowRequest struct {
+	// Datacenter is the target for this request.
+	Datacenter string
+
+	// Op is the type of operation being requested.
+	Op MaintenanceWindowOp
+
+	// Window is the window to modify. For deletes only the ID is used.
+	Window *MaintenanceWindow
+
+	// WriteRequest is a common struct containing ACL tokens and other
+	// write-related common elements for requests.
+	WriteRequest
+}
+
+// RequestDatacenter returns the datacenter for a given request.
+func (r *MaintenanceWindowRequest) RequestDatacenter() string {
+	return r.Datacenter
+}
+
+// IndexedMaintenanceWindows is used to return a list of maintenance windows.
+type IndexedMaintenanceWindows struct {
+	Windows MaintenanceWindows
+	QueryMeta
+}
@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package structs
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaintenanceWindow_Validate(t *testing.T) {
+	start := time.Date(2023, 6, 4, 2, 0, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		window  MaintenanceWindow
+		wantErr string
+	}{
+		"valid": {
+			window: MaintenanceWindow{Node: "node1", Start: start, Duration: time.Hour},
+		},
+		"missing node": {
+			window:  MaintenanceWindow{Start: start, Duration: time.Hour},
+			wantErr: "Must provide node",
+		},
+		"missing start": {
+			window:  MaintenanceWindow{Node: "node1", Duration: time.Hour},
+			wantErr: "Must provide start time",
+		},
+		"missing duration": {
+			window:  MaintenanceWindow{Node: "node1", Start: start},
+			wantErr: "Duration must be > 0",
+		},
+		"recurrence shorter than duration": {
+			window:  MaintenanceWindow{Node: "node1", Start: start, Duration: time.Hour, Recurrence: time.Minute},
+			wantErr: "Recurrence must be greater than or equal to Duration",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.window.Validate()
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestMaintenanceWindow_ActiveAt(t *testing.T) {
+	start := time.Date(2023, 6, 4, 2, 0, 0, 0, time.UTC)
+	once := &MaintenanceWindow{Start: start, Duration: 2 * time.Hour}
+	weekly := &MaintenanceWindow{Start: start, Duration: 2 * time.Hour, Recurrence: 7 * 24 * time.Hour}
+
+	cases := map[string]struct {
+		window     *MaintenanceWindow
+		at         time.Time
+		active     bool
+		transition time.Time
+	}{
+		"once before start": {
+			window:     once,
+			at:         start.Add(-time.Minute),
+			transition: start,
+		},
+		"once at start": {
+			window:     once,
+			at:         start,
+			active:     true,
+			transition: start.Add(2 * time.Hour),
+		},
+		"once after end": {
+			window: once,
+			at:     start.Add(2 * time.Hour),
+		},
+		"weekly during second occurrence": {
+			window:     weekly,
+			at:         start.Add(7*24*time.Hour + time.Hour),
+			active:     true,
+			transition: start.Add(7*24*time.Hour + 2*time.Hour),
+		},
+		"weekly between occurrences": {
+			window:     weekly,
+			at:         start.Add(3 * 24 * time.Hour),
+			transition: start.Add(7 * 24 * time.Hour),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.active, tc.window.ActiveAt(tc.at))
+			require.Equal(t, tc.transition, tc.window.NextTransition(tc.at))
+		})
+	}
+}
+
+func TestMaintenanceWindow_JSON(t *testing.T) {
+	in := &MaintenanceWindow{
+		ID:         "w1",
+		Node:       "node1",
+		Start:      time.Date(2023, 6, 4, 2, 0, 0, 0, time.UTC),
+		Duration:   2 * time.Hour,
+		Recurrence: 24 * time.Hour,
+	}
+	buf, err := json.Marshal(in)
+	require.NoError(t, err)
+	require.Contains(t, string(buf), `"Duration":"2h0m0s"`)
+	require.Contains(t, string(buf), `"Recurrence":"24h0m0s"`)
+
+	var out MaintenanceWindow
+	require.NoError(t, json.Unmarshal(buf, &out))
+	require.Equal(t, in, &out)
+}
@@ -171,6 +171,26 @@ func (o *ConnectProxyConfig) DeepCopy() *ConnectProxyConfig {
 		retV := o.Expose.DeepCopy()
 		cp.Expose = *retV
 	}
+	if o.Tracing != nil {
+		cp.Tracing = new(TracingConfig)
+		*cp.Tracing = *o.Tracing
+		if o.Tracing.ClientSampling != nil {
+			cp.Tracing.ClientSampling = new(float64)
+			*cp.Tracing.ClientSampling = *o.Tracing.ClientSampling
+		}
+		if o.Tracing.RandomSampling != nil {
+			cp.Tracing.RandomSampling = new(float64)
+			*cp.Tracing.RandomSampling = *o.Tracing.RandomSampling
+		}
+		if o.Tracing.OverallSampling != nil {
+			cp.Tracing.OverallSampling = new(float64)
+			*cp.Tracing.OverallSampling = *o.Tracing.OverallSampling
+		}
+		if o.Tracing.CustomTags != nil {
+			cp.Tracing.CustomTags = make([]TracingCustomTag, len(o.Tracing.CustomTags))
+			copy(cp.Tracing.CustomTags, o.Tracing.CustomTags)
+		}
+	}
 	return &cp
 }
 
@@ -260,6 +280,10 @@ func (o *DiscoverySplit) DeepCopy() *DiscoverySplit {
 		if o.Definition.ResponseHeaders != nil {
 			cp.Definition.ResponseHeaders = o.Definition.ResponseHeaders.DeepCopy()
 		}
+		if o.Definition.Mirror != nil {
+			cp.Definition.Mirror = new(MirrorPolicy)
+			*cp.Definition.Mirror = *o.Definition.Mirror
+		}
 	}
 	return &cp
 }
@@ -839,6 +863,26 @@ func (o *ServiceConfigEntry) DeepCopy() *ServiceConfigEntry {
 			cp.Meta[k2] = v2
 		}
 	}
+	if o.Tracing != nil {
+		cp.Tracing = new(TracingConfig)
+		*cp.Tracing = *o.Tracing
+		if o.Tracing.ClientSampling != nil {
+			cp.Tracing.ClientSampling = new(float64)
+			*cp.Tracing.ClientSampling = *o.Tracing.ClientSampling
+		}
+		if o.Tracing.RandomSampling != nil {
+			cp.Tracing.RandomSampling = new(float64)
+			*cp.Tracing.RandomSampling = *o.Tracing.RandomSampling
+		}
+		if o.Tracing.OverallSampling != nil {
+			cp.Tracing.OverallSampling = new(float64)
+			*cp.Tracing.OverallSampling = *o.Tracing.OverallSampling
+		}
+		if o.Tracing.CustomTags != nil {
+			cp.Tracing.CustomTags = make([]TracingCustomTag, len(o.Tracing.CustomTags))
+			copy(cp.Tracing.CustomTags, o.Tracing.CustomTags)
+		}
+	}
 	return &cp
 }
 
@@ -889,6 +933,26 @@ func (o *ServiceConfigResponse) DeepCopy() *ServiceConfigResponse {
 			}
 		}
 	}
+	if o.Tracing != nil {
+		cp.Tracing = new(TracingConfig)
+		*cp.Tracing = *o.Tracing
+		if o.Tracing.ClientSampling != nil {
+			cp.Tracing.ClientSampling = new(float64)
+			*cp.Tracing.ClientSampling = *o.Tracing.ClientSampling
+		}
+		if o.Tracing.RandomSampling != nil {
+			cp.Tracing.RandomSampling = new(float64)
+			*cp.Tracing.RandomSampling = *o.Tracing.RandomSampling
+		}
+		if o.Tracing.OverallSampling != nil {
+			cp.Tracing.OverallSampling = new(float64)
+			*cp.Tracing.OverallSampling = *o.Tracing.OverallSampling
+		}
+		if o.Tracing.CustomTags != nil {
+			cp.Tracing.CustomTags = make([]TracingCustomTag, len(o.Tracing.CustomTags))
+			copy(cp.Tracing.CustomTags, o.Tracing.CustomTags)
+		}
+	}
 	return &cp
 }
 
@@ -1044,6 +1108,26 @@ func (o *ServiceRouteDestination) DeepCopy() *ServiceRouteDestination {
 	if o.ResponseHeaders != nil {
 		cp.ResponseHeaders = o.ResponseHeaders.DeepCopy()
 	}
+	if o.FaultInjection != nil {
+		cp.FaultInjection = new(ServiceRouteFaultInjection)
+		*cp.FaultInjection = *o.FaultInjection
+		if o.FaultInjection.Delay != nil {
+			cp.FaultInjection.Delay = new(ServiceRouteFaultDelay)
+			*cp.FaultInjection.Delay = *o.FaultInjection.Delay
+		}
+		if o.FaultInjection.Abort != nil {
+			cp.FaultInjection.Abort = new(ServiceRouteFaultAbort)
+			*cp.FaultInjection.Abort = *o.FaultInjection.Abort
+		}
+		if o.FaultInjection.Header != nil {
+			cp.FaultInjection.Header = make([]ServiceRouteHTTPMatchHeader, len(o.FaultInjection.Header))
+			copy(cp.FaultInjection.Header, o.FaultInjection.Header)
+		}
+	}
+	if o.Mirror != nil {
+		cp.Mirror = new(MirrorPolicy)
+		*cp.Mirror = *o.Mirror
+	}
 	return &cp
 }
 
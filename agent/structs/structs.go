@@ -89,6 +89,7 @@ const (
 	RaftLogVerifierCheckpoint                   = 41 // Only used for log verifier, no-op on FSM.
 	ResourceOperationType                       = 42
 	UpdateVirtualIPRequestType                  = 43
+	MaintenanceWindowRequestType                = 44
 )
 
 const (
@@ -158,6 +159,7 @@ var requestTypeStrings = map[MessageType]string{
 	RaftLogVerifierCheckpoint:       "RaftLogVerifierCheckpoint",
 	ResourceOperationType:           "Resource",
 	UpdateVirtualIPRequestType:      "UpdateManualVirtualIPRequestType",
+	MaintenanceWindowRequestType:    "MaintenanceWindow",
 }
 
 const (
@@ -1794,6 +1796,17 @@ type HealthCheck struct {
 	// It is empty if the check was registered locally.
 	PeerName string `json:",omitempty"`
 
+	// DependsOn is the ID of another check on the same node that this check
+	// depends on. While that check is critical, a non-passing status of
+	// this check is reported as suppressed.
+	DependsOn types.CheckID `json:",omitempty"`
+
+	// Suppressed is true if the check is not passing while the check it
+	// depends on is critical. A suppressed check is still treated as not
+	// passing for routing, but can be filtered out to avoid cascading
+	// alerts.
+	Suppressed bool `json:",omitempty"`
+
 	Definition HealthCheckDefinition `bexpr:"-"`
 
 	acl.EnterpriseMeta `hcl:",squash" mapstructure:",squash" bexpr:"-"`
@@ -1974,6 +1987,8 @@ func (c *HealthCheck) IsSame(other *HealthCheck) bool {
 		!reflect.DeepEqual(c.ServiceTags, other.ServiceTags) ||
 		!reflect.DeepEqual(c.Definition, other.Definition) ||
 		c.PeerName != other.PeerName ||
+		c.DependsOn != other.DependsOn ||
+		c.Suppressed != other.Suppressed ||
 		!c.EnterpriseMeta.IsSame(&other.EnterpriseMeta) {
 		return false
 	}
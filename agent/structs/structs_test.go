@@ -1491,6 +1491,18 @@ func TestStructs_HealthCheck_IsSame(t *testing.T) {
 				hc.ServiceName = "XXX"
 			},
 		},
+		{
+			name: "DependsOn",
+			setup: func(hc *HealthCheck) {
+				hc.DependsOn = "XXX"
+			},
+		},
+		{
+			name: "Suppressed",
+			setup: func(hc *HealthCheck) {
+				hc.Suppressed = true
+			},
+		},
 	}
 
 	run := func(t *testing.T, tc testcase) {
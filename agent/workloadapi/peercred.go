@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workloadapi
+
+import (
+	"context"
+	"errors"
+	"net"
+
+	"google.golang.org/grpc/credentials"
+)
+
+// PeerCredentials is the credentials.AuthInfo of a connection to the
+// Workload API socket. It holds the Unix credentials of the process that
+// connected, as reported by the kernel.
+type PeerCredentials struct {
+	credentials.CommonAuthInfo
+
+	PID int32
+	UID uint32
+	GID uint32
+}
+
+// AuthType implements credentials.AuthInfo.
+func (PeerCredentials) AuthType() string {
+	return "peercred"
+}
+
+// Credentials returns the transport credentials of the gRPC server of the
+// Workload API. They don't secure connections, which are made over a Unix
+// socket, but attach the credentials of the connecting process to the peer of
+// every call.
+func Credentials() credentials.TransportCredentials {
+	return peerCredentials{}
+}
+
+type peerCredentials struct{}
+
+// ClientHandshake implements credentials.TransportCredentials.
+func (peerCredentials) ClientHandshake(context.Context, string, net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return nil, nil, errors.New("workloadapi: peer credentials can only be used by servers")
+}
+
+// ServerHandshake implements credentials.TransportCredentials.
+func (peerCredentials) ServerHandshake(conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	creds, err := readPeerCredentials(conn)
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	creds.SecurityLevel = credentials.NoSecurity
+	return conn, creds, nil
+}
+
+// Info implements credentials.TransportCredentials.
+func (peerCredentials) Info() credentials.ProtocolInfo {
+	return credentials.ProtocolInfo{SecurityProtocol: "peercred"}
+}
+
+// Clone implements credentials.TransportCredentials.
+func (c peerCredentials) Clone() credentials.TransportCredentials {
+	return c
+}
+
+// OverrideServerName implements credentials.TransportCredentials.
+func (peerCredentials) OverrideServerName(string) error {
+	return nil
+}
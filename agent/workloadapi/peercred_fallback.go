@@ -0,0 +1,20 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build !linux
+// +build !linux
+
+package workloadapi
+
+import (
+	"errors"
+	"net"
+)
+
+// PeerCredentialsSupported is true if the credentials of the processes
+// connecting to the Workload API can be read on this platform.
+const PeerCredentialsSupported = false
+
+func readPeerCredentials(net.Conn) (PeerCredentials, error) {
+	return PeerCredentials{}, errors.New("workloadapi: peer credentials are only supported on Linux")
+}
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build linux
+// +build linux
+
+package workloadapi
+
+import (
+	"fmt"
+	"net"
+
+	"golang.org/x/sys/unix"
+)
+
+// PeerCredentialsSupported is true if the credentials of the processes
+// connecting to the Workload API can be read on this platform.
+const PeerCredentialsSupported = true
+
+func readPeerCredentials(conn net.Conn) (PeerCredentials, error) {
+	uc, ok := conn.(*net.UnixConn)
+	if !ok {
+		return PeerCredentials{}, fmt.Errorf("workloadapi: unsupported connection type %T", conn)
+	}
+	raw, err := uc.SyscallConn()
+	if err != nil {
+		return PeerCredentials{}, err
+	}
+
+	var ucred *unix.Ucred
+	var credErr error
+	err = raw.Control(func(fd uintptr) {
+		ucred, credErr = unix.GetsockoptUcred(int(fd), unix.SOL_SOCKET, unix.SO_PEERCRED)
+	})
+	if err != nil {
+		return PeerCredentials{}, err
+	}
+	if credErr != nil {
+		return PeerCredentials{}, fmt.Errorf("workloadapi: failed to read peer credentials: %w", credErr)
+	}
+	return PeerCredentials{PID: ucred.Pid, UID: ucred.Uid, GID: ucred.Gid}, nil
+}
@@ -0,0 +1,401 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package workloadapi implements the SPIFFE Workload API on client agents. It
+// issues X.509-SVIDs to the processes running the services registered with the
+// agent, which are identified by the Unix credentials of their connections.
+package workloadapi
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/go-hclog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/consul/agent/cache"
+	cachetype "github.com/hashicorp/consul/agent/cache-types"
+	"github.com/hashicorp/consul/agent/connect"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/agent/workloadapi/pbworkload"
+)
+
+const (
+	// WorkloadUIDMetaKey is the key of the service meta holding the Unix user
+	// ID of the processes that are issued the identity of the service.
+	WorkloadUIDMetaKey = "spiffe-workload-uid"
+
+	// securityHeader is the metadata that SPIFFE clients must send with every
+	// call, so that the Workload API can't be called by proxied requests.
+	securityHeader = "workload.spiffe.io"
+
+	rootsWatchID = "roots"
+	leafWatchID  = "leaf:"
+)
+
+// Cache is an interface to represent the necessary methods of the agent/cache.Cache.
+// It is used to watch the CA roots and the leaf certificates of services.
+type Cache interface {
+	Notify(ctx context.Context, t string, r cache.Request, correlationID string, ch chan<- cache.UpdateEvent) error
+}
+
+// LocalState is an interface to represent the necessary methods of the
+// agent/local.State. It is used to find the services that callers are
+// entitled to the identity of.
+type LocalState interface {
+	AllServices() map[structs.ServiceID]*structs.NodeService
+	ServiceToken(id structs.ServiceID) string
+	Notify(ch chan<- struct{})
+	StopNotify(ch chan<- struct{})
+}
+
+// Tokens is an interface to represent the necessary methods of the
+// agent/token.Store. It is used to fetch leaf certificates for services
+// registered without a token.
+type Tokens interface {
+	UserToken() string
+}
+
+type Server struct {
+	Config
+}
+
+type Config struct {
+	Logger     hclog.Logger
+	Cache      Cache
+	LocalState LocalState
+	Tokens     Tokens
+	// Datacenter of the agent this gRPC server is hosted on
+	Datacenter string
+}
+
+func NewServer(cfg Config) *Server {
+	return &Server{cfg}
+}
+
+var _ pbworkload.SpiffeWorkloadAPIServer = (*Server)(nil)
+
+func (s *Server) Register(grpcServer *grpc.Server) {
+	pbworkload.RegisterSpiffeWorkloadAPIServer(grpcServer, s)
+}
+
+// identity is a service whose identity a caller is entitled to.
+type identity struct {
+	service structs.ServiceName
+	token   string
+}
+
+// FetchJWTSVID implements pbworkload.SpiffeWorkloadAPIServer. JWT-SVIDs are
+// not supported.
+func (s *Server) FetchJWTSVID(context.Context, *pbworkload.JWTSVIDRequest) (*pbworkload.JWTSVIDResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "JWT-SVIDs are not supported")
+}
+
+// FetchJWTBundles implements pbworkload.SpiffeWorkloadAPIServer. JWT-SVIDs are
+// not supported.
+func (s *Server) FetchJWTBundles(*pbworkload.JWTBundlesRequest, pbworkload.SpiffeWorkloadAPI_FetchJWTBundlesServer) error {
+	return status.Error(codes.Unimplemented, "JWT-SVIDs are not supported")
+}
+
+// ValidateJWTSVID implements pbworkload.SpiffeWorkloadAPIServer. JWT-SVIDs
+// are not supported.
+func (s *Server) ValidateJWTSVID(context.Context, *pbworkload.ValidateJWTSVIDRequest) (*pbworkload.ValidateJWTSVIDResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "JWT-SVIDs are not supported")
+}
+
+// FetchX509SVID implements pbworkload.SpiffeWorkloadAPIServer. It streams the
+// X.509-SVIDs of every service the caller is entitled to the identity of,
+// until the set of services changes and the watches are restarted.
+func (s *Server) FetchX509SVID(_ *pbworkload.X509SVIDRequest, stream pbworkload.SpiffeWorkloadAPI_FetchX509SVIDServer) error {
+	ctx := stream.Context()
+	creds, err := s.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+
+	stateCh := make(chan struct{}, 1)
+	s.LocalState.Notify(stateCh)
+	defer s.LocalState.StopNotify(stateCh)
+
+	identities := s.identities(creds)
+	for {
+		if len(identities) == 0 {
+			return status.Error(codes.PermissionDenied, "no identity issued")
+		}
+		identities, err = s.streamX509SVIDs(ctx, stream, creds, identities, stateCh)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// streamX509SVIDs streams the X.509-SVIDs of the given identities until they
+// change, in which case it returns the new ones.
+func (s *Server) streamX509SVIDs(ctx context.Context, stream pbworkload.SpiffeWorkloadAPI_FetchX509SVIDServer,
+	creds PeerCredentials, identities []identity, stateCh <-chan struct{}) ([]identity, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	updateCh := make(chan cache.UpdateEvent, len(identities)+1)
+	if err := s.watchRoots(ctx, updateCh); err != nil {
+		return nil, err
+	}
+	for i, id := range identities {
+		req := &cachetype.ConnectCALeafRequest{
+			Datacenter:     s.Datacenter,
+			Token:          id.token,
+			Service:        id.service.Name,
+			EnterpriseMeta: id.service.EnterpriseMeta,
+		}
+		if err := s.Cache.Notify(ctx, cachetype.ConnectCALeafName, req, leafWatchID+strconv.Itoa(i), updateCh); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to watch leaf certificate: %v", err)
+		}
+	}
+
+	var roots *structs.IndexedCARoots
+	leaves := make(map[string]*structs.IssuedCert, len(identities))
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, status.FromContextError(ctx.Err()).Err()
+
+		case <-stateCh:
+			changed := s.identities(creds)
+			if !equalIdentities(identities, changed) {
+				return changed, nil
+			}
+
+		case u := <-updateCh:
+			if u.Err != nil {
+				s.Logger.Warn("failed to fetch X.509-SVID data", "watch", u.CorrelationID, "error", u.Err)
+				continue
+			}
+			switch result := u.Result.(type) {
+			case *structs.IndexedCARoots:
+				roots = result
+			case *structs.IssuedCert:
+				leaves[u.CorrelationID] = result
+			default:
+				s.Logger.Error("unexpected cache result type", "watch", u.CorrelationID, "type", fmt.Sprintf("%T", u.Result))
+				continue
+			}
+			if roots == nil || len(leaves) < len(identities) {
+				continue
+			}
+
+			resp, err := x509SVIDResponse(roots, identities, leaves)
+			if err != nil {
+				s.Logger.Error("failed to build X.509-SVIDs", "error", err)
+				continue
+			}
+			if err := stream.Send(resp); err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+// FetchX509Bundles implements pbworkload.SpiffeWorkloadAPIServer. It streams
+// the CA roots of the local trust domain.
+func (s *Server) FetchX509Bundles(_ *pbworkload.X509BundlesRequest, stream pbworkload.SpiffeWorkloadAPI_FetchX509BundlesServer) error {
+	ctx := stream.Context()
+	creds, err := s.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+	if len(s.identities(creds)) == 0 {
+		return status.Error(codes.PermissionDenied, "no identity issued")
+	}
+
+	updateCh := make(chan cache.UpdateEvent, 1)
+	if err := s.watchRoots(ctx, updateCh); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+
+		case u := <-updateCh:
+			if u.Err != nil {
+				s.Logger.Warn("failed to fetch CA roots", "error", u.Err)
+				continue
+			}
+			roots, ok := u.Result.(*structs.IndexedCARoots)
+			if !ok {
+				s.Logger.Error("unexpected cache result type", "type", fmt.Sprintf("%T", u.Result))
+				continue
+			}
+			bundle, err := rootsBundle(roots)
+			if err != nil {
+				s.Logger.Error("failed to build X.509 bundle", "error", err)
+				continue
+			}
+			resp := &pbworkload.X509BundlesResponse{
+				Bundles: map[string][]byte{trustDomainID(roots): bundle},
+			}
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *Server) watchRoots(ctx context.Context, ch chan<- cache.UpdateEvent) error {
+	req := &structs.DCSpecificRequest{Datacenter: s.Datacenter}
+	if err := s.Cache.Notify(ctx, cachetype.ConnectCARootName, req, rootsWatchID, ch); err != nil {
+		return status.Errorf(codes.Internal, "failed to watch CA roots: %v", err)
+	}
+	return nil
+}
+
+// authenticate checks the security header of a call and returns the
+// credentials of the calling process.
+func (s *Server) authenticate(ctx context.Context) (PeerCredentials, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(securityHeader); len(values) != 1 || values[0] != "true" {
+		return PeerCredentials{}, status.Errorf(codes.InvalidArgument, "security header missing from request")
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return PeerCredentials{}, status.Error(codes.Internal, "call has no peer")
+	}
+	creds, ok := p.AuthInfo.(PeerCredentials)
+	if !ok {
+		return PeerCredentials{}, status.Error(codes.Internal, "call has no peer credentials")
+	}
+	return creds, nil
+}
+
+// identities returns the services whose identity the caller is entitled to,
+// sorted by ID. These are the services registered with the agent whose
+// WorkloadUIDMetaKey meta matches the user ID of the caller.
+func (s *Server) identities(creds PeerCredentials) []identity {
+	uid := strconv.FormatUint(uint64(creds.UID), 10)
+
+	var ids []structs.ServiceID
+	services := s.LocalState.AllServices()
+	for id, svc := range services {
+		if svc.Kind != structs.ServiceKindTypical || svc.Meta[WorkloadUIDMetaKey] != uid {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i].String() < ids[j].String()
+	})
+
+	var identities []identity
+	seen := make(map[structs.ServiceName]bool)
+	for _, id := range ids {
+		svc := services[id]
+		name := structs.NewServiceName(svc.Service, &svc.EnterpriseMeta)
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		token := s.LocalState.ServiceToken(id)
+		if token == "" {
+			token = s.Tokens.UserToken()
+		}
+		identities = append(identities, identity{service: name, token: token})
+	}
+	return identities
+}
+
+func equalIdentities(a, b []identity) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// x509SVIDResponse builds the X.509-SVIDs of the identities from their leaf
+// certificates, which are keyed by watch correlation ID.
+func x509SVIDResponse(roots *structs.IndexedCARoots, identities []identity,
+	leaves map[string]*structs.IssuedCert) (*pbworkload.X509SVIDResponse, error) {
+	bundle, err := rootsBundle(roots)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pbworkload.X509SVIDResponse{}
+	for i := range identities {
+		leaf := leaves[leafWatchID+strconv.Itoa(i)]
+		chain, err := pemToDER(leaf.CertPEM)
+		if err != nil {
+			return nil, fmt.Errorf("invalid leaf certificate for %s: %w", leaf.Service, err)
+		}
+		signer, err := connect.ParseSigner(leaf.PrivateKeyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("invalid private key for %s: %w", leaf.Service, err)
+		}
+		key, err := x509.MarshalPKCS8PrivateKey(signer)
+		if err != nil {
+			return nil, fmt.Errorf("invalid private key for %s: %w", leaf.Service, err)
+		}
+		resp.Svids = append(resp.Svids, &pbworkload.X509SVID{
+			SpiffeId:    leaf.ServiceURI,
+			X509Svid:    chain,
+			X509SvidKey: key,
+			Bundle:      bundle,
+		})
+	}
+	return resp, nil
+}
+
+// rootsBundle returns the concatenated DER encoded certificates of the roots.
+func rootsBundle(roots *structs.IndexedCARoots) ([]byte, error) {
+	var bundle bytes.Buffer
+	for _, root := range roots.Roots {
+		der, err := pemToDER(root.RootCert)
+		if err != nil {
+			return nil, fmt.Errorf("invalid root certificate %s: %w", root.ID, err)
+		}
+		bundle.Write(der)
+	}
+	return bundle.Bytes(), nil
+}
+
+// trustDomainID returns the SPIFFE ID of the trust domain of the roots.
+func trustDomainID(roots *structs.IndexedCARoots) string {
+	return "spiffe://" + roots.TrustDomain
+}
+
+// pemToDER returns the concatenated DER encoding of the certificates in a PEM
+// bundle.
+func pemToDER(pemValue string) ([]byte, error) {
+	var der bytes.Buffer
+	rest := []byte(pemValue)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			return nil, fmt.Errorf("unexpected PEM block type %q", block.Type)
+		}
+		der.Write(block.Bytes)
+	}
+	if der.Len() == 0 {
+		return nil, fmt.Errorf("no certificate found")
+	}
+	return der.Bytes(), nil
+}
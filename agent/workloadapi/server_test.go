@@ -0,0 +1,277 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workloadapi
+
+import (
+	"context"
+	"crypto/x509"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/consul/agent/cache"
+	cachetype "github.com/hashicorp/consul/agent/cache-types"
+	"github.com/hashicorp/consul/agent/connect"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/agent/workloadapi/pbworkload"
+)
+
+func TestServer_FetchX509SVID(t *testing.T) {
+	client, c, state := testServer(t)
+	ca := connect.TestCA(t, nil)
+
+	state.setServices(map[structs.ServiceID]*structs.NodeService{
+		structs.NewServiceID("web-1", nil): testService("web-1", "web", os.Getuid()),
+		structs.NewServiceID("web-2", nil): testService("web-2", "web", os.Getuid()),
+		structs.NewServiceID("db", nil):    testService("db", "db", os.Getuid()+1),
+	})
+	state.tokens[structs.NewServiceID("web-1", nil)] = "web-token"
+
+	ctx, cancel := context.WithTimeout(testSecurityHeader(), 10*time.Second)
+	defer cancel()
+	stream, err := client.FetchX509SVID(ctx, &pbworkload.X509SVIDRequest{})
+	require.NoError(t, err)
+
+	roots := c.nextWatch(t)
+	require.Equal(t, cachetype.ConnectCARootName, roots.t)
+	leaf := c.nextWatch(t)
+	require.Equal(t, cachetype.ConnectCALeafName, leaf.t)
+	leafReq := leaf.req.(*cachetype.ConnectCALeafRequest)
+	require.Equal(t, "web", leafReq.Service)
+	require.Equal(t, "web-token", leafReq.Token)
+	require.Equal(t, "dc1", leafReq.Datacenter)
+
+	roots.send(&structs.IndexedCARoots{TrustDomain: connect.TestTrustDomain, Roots: []*structs.CARoot{ca}})
+	certPEM, keyPEM := connect.TestLeaf(t, "web", ca)
+	spiffeID := connect.TestSpiffeIDService(t, "web").URI().String()
+	leaf.send(&structs.IssuedCert{
+		CertPEM:       certPEM,
+		PrivateKeyPEM: keyPEM,
+		Service:       "web",
+		ServiceURI:    spiffeID,
+	})
+
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	require.Len(t, resp.Svids, 1)
+	svid := resp.Svids[0]
+	require.Equal(t, spiffeID, svid.SpiffeId)
+
+	certs, err := x509.ParseCertificates(svid.X509Svid)
+	require.NoError(t, err)
+	require.Len(t, certs, 1)
+	require.Equal(t, spiffeID, certs[0].URIs[0].String())
+	_, err = x509.ParsePKCS8PrivateKey(svid.X509SvidKey)
+	require.NoError(t, err)
+	bundle, err := x509.ParseCertificates(svid.Bundle)
+	require.NoError(t, err)
+	require.Len(t, bundle, 1)
+	require.Equal(t, ca.SerialNumber, bundle[0].SerialNumber.Uint64())
+
+	// The stream ends once the caller is no longer entitled to any identity.
+	state.setServices(nil)
+	_, err = stream.Recv()
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestServer_FetchX509Bundles(t *testing.T) {
+	client, c, state := testServer(t)
+	ca := connect.TestCA(t, nil)
+
+	state.setServices(map[structs.ServiceID]*structs.NodeService{
+		structs.NewServiceID("web", nil): testService("web", "web", os.Getuid()),
+	})
+
+	ctx, cancel := context.WithTimeout(testSecurityHeader(), 10*time.Second)
+	defer cancel()
+	stream, err := client.FetchX509Bundles(ctx, &pbworkload.X509BundlesRequest{})
+	require.NoError(t, err)
+
+	roots := c.nextWatch(t)
+	require.Equal(t, cachetype.ConnectCARootName, roots.t)
+	roots.send(&structs.IndexedCARoots{TrustDomain: connect.TestTrustDomain, Roots: []*structs.CARoot{ca}})
+
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	require.Len(t, resp.Bundles, 1)
+	bundle, err := x509.ParseCertificates(resp.Bundles["spiffe://"+connect.TestTrustDomain])
+	require.NoError(t, err)
+	require.Len(t, bundle, 1)
+	require.Equal(t, ca.SerialNumber, bundle[0].SerialNumber.Uint64())
+}
+
+func TestServer_Unauthenticated(t *testing.T) {
+	client, _, state := testServer(t)
+	state.setServices(map[structs.ServiceID]*structs.NodeService{
+		structs.NewServiceID("db", nil): testService("db", "db", os.Getuid()+1),
+	})
+
+	cases := map[string]struct {
+		ctx  context.Context
+		code codes.Code
+	}{
+		"missing security header": {
+			ctx:  context.Background(),
+			code: codes.InvalidArgument,
+		},
+		"no identity": {
+			ctx:  testSecurityHeader(),
+			code: codes.PermissionDenied,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(tc.ctx, 5*time.Second)
+			defer cancel()
+
+			svids, err := client.FetchX509SVID(ctx, &pbworkload.X509SVIDRequest{})
+			require.NoError(t, err)
+			_, err = svids.Recv()
+			require.Equal(t, tc.code, status.Code(err))
+
+			bundles, err := client.FetchX509Bundles(ctx, &pbworkload.X509BundlesRequest{})
+			require.NoError(t, err)
+			_, err = bundles.Recv()
+			require.Equal(t, tc.code, status.Code(err))
+		})
+	}
+}
+
+func testServer(t *testing.T) (pbworkload.SpiffeWorkloadAPIClient, *testCache, *testLocalState) {
+	if !PeerCredentialsSupported {
+		t.Skip("peer credentials are not supported on this platform")
+	}
+
+	c := &testCache{watches: make(chan testWatch, 10)}
+	state := &testLocalState{
+		tokens:   make(map[structs.ServiceID]string),
+		handlers: make(map[chan<- struct{}]struct{}),
+	}
+
+	path := filepath.Join(t.TempDir(), "workload.sock")
+	lis, err := net.Listen("unix", path)
+	require.NoError(t, err)
+
+	srv := grpc.NewServer(grpc.Creds(Credentials()))
+	NewServer(Config{
+		Logger:     hclog.NewNullLogger(),
+		Cache:      c,
+		LocalState: state,
+		Tokens:     testTokens{},
+		Datacenter: "dc1",
+	}).Register(srv)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.Dial("unix://"+path, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return pbworkload.NewSpiffeWorkloadAPIClient(conn), c, state
+}
+
+func testSecurityHeader() context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), securityHeader, "true")
+}
+
+func testService(id, name string, uid int) *structs.NodeService {
+	return &structs.NodeService{
+		ID:      id,
+		Service: name,
+		Meta:    map[string]string{WorkloadUIDMetaKey: strconv.Itoa(uid)},
+	}
+}
+
+type testWatch struct {
+	t   string
+	req cache.Request
+	id  string
+	ch  chan<- cache.UpdateEvent
+}
+
+func (w testWatch) send(result interface{}) {
+	w.ch <- cache.UpdateEvent{CorrelationID: w.id, Result: result}
+}
+
+type testCache struct {
+	watches chan testWatch
+}
+
+func (c *testCache) Notify(_ context.Context, t string, r cache.Request, correlationID string, ch chan<- cache.UpdateEvent) error {
+	c.watches <- testWatch{t: t, req: r, id: correlationID, ch: ch}
+	return nil
+}
+
+func (c *testCache) nextWatch(t *testing.T) testWatch {
+	t.Helper()
+	select {
+	case w := <-c.watches:
+		return w
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for cache watch")
+		return testWatch{}
+	}
+}
+
+type testLocalState struct {
+	lock     sync.Mutex
+	services map[structs.ServiceID]*structs.NodeService
+	tokens   map[structs.ServiceID]string
+	handlers map[chan<- struct{}]struct{}
+}
+
+func (s *testLocalState) setServices(services map[structs.ServiceID]*structs.NodeService) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.services = services
+	for ch := range s.handlers {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (s *testLocalState) AllServices() map[structs.ServiceID]*structs.NodeService {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.services
+}
+
+func (s *testLocalState) ServiceToken(id structs.ServiceID) string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.tokens[id]
+}
+
+func (s *testLocalState) Notify(ch chan<- struct{}) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.handlers[ch] = struct{}{}
+}
+
+func (s *testLocalState) StopNotify(ch chan<- struct{}) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.handlers, ch)
+}
+
+type testTokens struct{}
+
+func (testTokens) UserToken() string {
+	return "user-token"
+}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package xds
+
+import (
+	"fmt"
+
+	envoy_accesslog_v3 "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
+
+	"github.com/hashicorp/consul/agent/proxycfg"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/agent/xds/accesslogs"
+)
+
+// accessLogsConfig is the access log configuration of a proxy, along with the
+// cluster of the service that receives its logs over gRPC.
+type accessLogsConfig struct {
+	config *structs.AccessLogsConfig
+
+	// cluster is the name of the cluster of the service that receives "grpc"
+	// and "opentelemetry" type logs.
+	cluster string
+
+	// clusterErr is the reason the cluster could not be found.
+	clusterErr error
+}
+
+// make returns the Envoy access log configuration for a listener or a listener filter.
+func (c accessLogsConfig) make(isListener bool) ([]*envoy_accesslog_v3.AccessLog, error) {
+	logs, err := accesslogs.MakeAccessLogs(c.config, isListener, c.cluster)
+	if err != nil && c.clusterErr != nil {
+		return nil, c.clusterErr
+	}
+	return logs, err
+}
+
+// accessLogs returns the access log configuration of the proxy in the snapshot.
+func (s *ResourceGenerator) accessLogs(cfgSnap *proxycfg.ConfigSnapshot) accessLogsConfig {
+	logs := accessLogsConfig{config: &cfgSnap.Proxy.AccessLogs}
+	if logs.config.Enabled && logs.config.Type.IsGRPC() {
+		logs.cluster, logs.clusterErr = s.accessLogsCluster(cfgSnap, logs.config)
+	}
+	return logs
+}
+
+// accessLogsCluster returns the name of the cluster of the service that receives access
+// logs over gRPC.
+func (s *ResourceGenerator) accessLogsCluster(cfgSnap *proxycfg.ConfigSnapshot, logs *structs.AccessLogsConfig) (string, error) {
+	cluster, protocol, err := s.collectorCluster(cfgSnap, "access log", logs.ServiceName, logs.ServiceNamespace, logs.ServicePartition)
+	if err != nil {
+		return "", err
+	}
+	if !isGRPCProtocol(protocol) {
+		return "", fmt.Errorf("access log service %q must use the grpc or http2 protocol, got %q", logs.ServiceName, protocol)
+	}
+	return cluster, nil
+}
@@ -5,11 +5,15 @@ package accesslogs
 
 import (
 	"fmt"
+	"sort"
 
 	envoy_accesslog_v3 "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
 	envoy_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_fileaccesslog_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/file/v3"
+	envoy_grpcaccesslog_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/grpc/v3"
+	envoy_otelaccesslog_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/open_telemetry/v3"
 	envoy_streamaccesslog_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/stream/v3"
+	otlp_common_v1 "go.opentelemetry.io/proto/otlp/common/v1"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/structpb"
 
@@ -46,13 +50,19 @@ const (
 	"upstream_transport_failure_reason": "%UPSTREAM_TRANSPORT_FAILURE_REASON%"
 }
 `
+
+	// defaultLogName is the name of the log stream reported to access log
+	// services when none is configured.
+	defaultLogName = "consul"
 )
 
 // MakeAccessLogs returns a fully-hydrated slice of Envoy Access log configurations based
 // on the proxy-defaults settings. Currently only one access logger is supported.
 // Listeners (as opposed to listener filters) can trigger an access log filter with the boolean.
+// The cluster is the name of the cluster of the service that receives "grpc" and "opentelemetry"
+// type logs, and is ignored for other types.
 // Tests are located in agent/xds/listeners_test.go.
-func MakeAccessLogs(logs *structs.AccessLogsConfig, isListener bool) ([]*envoy_accesslog_v3.AccessLog, error) {
+func MakeAccessLogs(logs *structs.AccessLogsConfig, isListener bool, cluster string) ([]*envoy_accesslog_v3.AccessLog, error) {
 	if logs == nil || !logs.Enabled {
 		return nil, nil
 	}
@@ -61,7 +71,7 @@ func MakeAccessLogs(logs *structs.AccessLogsConfig, isListener bool) ([]*envoy_a
 		return nil, nil
 	}
 
-	config, err := getLogger(logs)
+	config, err := getLogger(logs, cluster)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get logger: %w", err)
 	}
@@ -85,7 +95,16 @@ func MakeAccessLogs(logs *structs.AccessLogsConfig, isListener bool) ([]*envoy_a
 }
 
 // getLogger returns an individual instance of an Envoy logger based on proxy-defaults
-func getLogger(logs *structs.AccessLogsConfig) (*anypb.Any, error) {
+func getLogger(logs *structs.AccessLogsConfig, cluster string) (*anypb.Any, error) {
+	if logs.Type.IsGRPC() && cluster == "" {
+		return nil, fmt.Errorf("no cluster found for access log service %q", logs.ServiceName)
+	}
+
+	// The http_grpc logger sends structured entries and does not take a format.
+	if logs.Type == structs.GRPCLogSinkType {
+		return getGRPCLogger(logs, cluster)
+	}
+
 	logFormat, err := getLogFormat(logs)
 	if err != nil {
 		return nil, fmt.Errorf("could not get envoy log format: %w", err)
@@ -98,6 +117,8 @@ func getLogger(logs *structs.AccessLogsConfig) (*anypb.Any, error) {
 		return getStderrLogger(logFormat)
 	case structs.FileLogSinkType:
 		return getFileLogger(logFormat, logs.Path)
+	case structs.OpenTelemetryLogSinkType:
+		return getOpenTelemetryLogger(logFormat, logs, cluster)
 	default:
 		return nil, fmt.Errorf("unsupported log format: %s", logs.Type)
 	}
@@ -175,6 +196,92 @@ func getFileLogger(logFormat *envoy_core_v3.SubstitutionFormatString, path strin
 	})
 }
 
+// getGRPCLogger returns Envoy's representation of a gRPC access log service sink that sends
+// logs to the given cluster.
+func getGRPCLogger(logs *structs.AccessLogsConfig, cluster string) (*anypb.Any, error) {
+	return anypb.New(&envoy_grpcaccesslog_v3.HttpGrpcAccessLogConfig{
+		CommonConfig: getCommonGRPCConfig(logs, cluster),
+	})
+}
+
+// getOpenTelemetryLogger returns Envoy's representation of an OpenTelemetry log sink that sends
+// logs to the given cluster. A text format is sent as the body of each log record, and the
+// fields of a JSON format are sent as its attributes.
+func getOpenTelemetryLogger(logFormat *envoy_core_v3.SubstitutionFormatString, logs *structs.AccessLogsConfig, cluster string) (*anypb.Any, error) {
+	cfg := &envoy_otelaccesslog_v3.OpenTelemetryAccessLogConfig{
+		CommonConfig: getCommonGRPCConfig(logs, cluster),
+	}
+
+	switch f := logFormat.Format.(type) {
+	case *envoy_core_v3.SubstitutionFormatString_TextFormatSource:
+		cfg.Body = &otlp_common_v1.AnyValue{
+			Value: &otlp_common_v1.AnyValue_StringValue{StringValue: f.TextFormatSource.GetInlineString()},
+		}
+	case *envoy_core_v3.SubstitutionFormatString_JsonFormat:
+		cfg.Attributes = makeOTelKeyValueList(f.JsonFormat)
+	}
+
+	return anypb.New(cfg)
+}
+
+// getCommonGRPCConfig returns the configuration shared by the gRPC based log sinks.
+func getCommonGRPCConfig(logs *structs.AccessLogsConfig, cluster string) *envoy_grpcaccesslog_v3.CommonGrpcAccessLogConfig {
+	logName := logs.LogName
+	if logName == "" {
+		logName = defaultLogName
+	}
+	return &envoy_grpcaccesslog_v3.CommonGrpcAccessLogConfig{
+		LogName: logName,
+		GrpcService: &envoy_core_v3.GrpcService{
+			TargetSpecifier: &envoy_core_v3.GrpcService_EnvoyGrpc_{
+				EnvoyGrpc: &envoy_core_v3.GrpcService_EnvoyGrpc{
+					ClusterName: cluster,
+				},
+			},
+		},
+		TransportApiVersion: envoy_core_v3.ApiVersion_V3,
+	}
+}
+
+// makeOTelKeyValueList converts a JSON log format to the equivalent OpenTelemetry attributes.
+func makeOTelKeyValueList(s *structpb.Struct) *otlp_common_v1.KeyValueList {
+	keys := make([]string, 0, len(s.GetFields()))
+	for k := range s.GetFields() {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	list := &otlp_common_v1.KeyValueList{}
+	for _, k := range keys {
+		list.Values = append(list.Values, &otlp_common_v1.KeyValue{
+			Key:   k,
+			Value: makeOTelAnyValue(s.Fields[k]),
+		})
+	}
+	return list
+}
+
+func makeOTelAnyValue(v *structpb.Value) *otlp_common_v1.AnyValue {
+	switch k := v.GetKind().(type) {
+	case *structpb.Value_StringValue:
+		return &otlp_common_v1.AnyValue{Value: &otlp_common_v1.AnyValue_StringValue{StringValue: k.StringValue}}
+	case *structpb.Value_BoolValue:
+		return &otlp_common_v1.AnyValue{Value: &otlp_common_v1.AnyValue_BoolValue{BoolValue: k.BoolValue}}
+	case *structpb.Value_NumberValue:
+		return &otlp_common_v1.AnyValue{Value: &otlp_common_v1.AnyValue_DoubleValue{DoubleValue: k.NumberValue}}
+	case *structpb.Value_StructValue:
+		return &otlp_common_v1.AnyValue{Value: &otlp_common_v1.AnyValue_KvlistValue{KvlistValue: makeOTelKeyValueList(k.StructValue)}}
+	case *structpb.Value_ListValue:
+		values := make([]*otlp_common_v1.AnyValue, 0, len(k.ListValue.GetValues()))
+		for _, lv := range k.ListValue.GetValues() {
+			values = append(values, makeOTelAnyValue(lv))
+		}
+		return &otlp_common_v1.AnyValue{Value: &otlp_common_v1.AnyValue_ArrayValue{ArrayValue: &otlp_common_v1.ArrayValue{Values: values}}}
+	default:
+		return &otlp_common_v1.AnyValue{}
+	}
+}
+
 // getListenerAccessLogFilter returns a filter that will be used on listeners to decide when a log is emitted.
 // Set to "NR" which corresponds to "No route configured for a given request in addition
 // to 404 response code, or no matching filter chain for a downstream connection."
@@ -68,6 +68,12 @@ func makeClusterDiscoChainTests(enterprise bool) []clusterTestCase {
 				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "failover", enterprise, nil, nil)
 			},
 		},
+		{
+			name: "connect-proxy-with-router-with-mirror",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "router-with-mirror", enterprise, nil, nil)
+			},
+		},
 		{
 			name: "connect-proxy-with-tcp-chain-failover-through-remote-gateway",
 			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
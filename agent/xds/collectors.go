@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package xds
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/connect"
+	"github.com/hashicorp/consul/agent/proxycfg"
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// collectorCluster returns the name and protocol of the cluster of a service that
+// the proxy sends telemetry, such as access logs or spans, to. The kind describes
+// the telemetry in errors.
+//
+// Proxies and gateways with upstreams send telemetry to an upstream in the local
+// datacenter, terminating gateways to one of their linked services, and mesh
+// gateways to a service that is exported to a peer.
+func (s *ResourceGenerator) collectorCluster(cfgSnap *proxycfg.ConfigSnapshot, kind, name, namespace, partition string) (string, string, error) {
+	entMeta := acl.NewEnterpriseMetaWithPartition(partition, namespace)
+	sn := structs.NewServiceName(name, &entMeta)
+
+	switch cfgSnap.Kind {
+	case structs.ServiceKindConnectProxy, structs.ServiceKindIngressGateway, structs.ServiceKindAPIGateway:
+		upstreamsSnapshot, err := cfgSnap.ToConfigSnapshotUpstreams()
+		if err != nil {
+			return "", "", err
+		}
+
+		chain := upstreamsSnapshot.DiscoveryChain[proxycfg.NewUpstreamIDFromServiceName(sn)]
+		if chain == nil {
+			return "", "", fmt.Errorf("%s service %q is not an upstream of the proxy", kind, sn.String())
+		}
+
+		target, err := simpleChainTarget(chain)
+		if err != nil {
+			return "", "", fmt.Errorf("%s service %q: %w", kind, sn.String(), err)
+		}
+		return s.getTargetClusterName(upstreamsSnapshot, chain, target.ID, false, false), chain.Protocol, nil
+
+	case structs.ServiceKindTerminatingGateway:
+		if _, ok := cfgSnap.TerminatingGateway.ServiceGroups[sn]; !ok {
+			return "", "", fmt.Errorf("%s service %q is not linked to the terminating gateway", kind, sn.String())
+		}
+
+		var protocol string
+		if svcConfig, ok := cfgSnap.TerminatingGateway.ServiceConfigs[sn]; ok {
+			// The parse func returns the default config if there is an error,
+			// which is reported when the clusters are generated.
+			upstreamCfg, _ := structs.ParseUpstreamConfig(svcConfig.ProxyConfig)
+			protocol = upstreamCfg.Protocol
+		}
+
+		if cfgSnap.Roots == nil {
+			return "", "", fmt.Errorf("no CA roots found for %s service %q", kind, sn.String())
+		}
+		return connect.ServiceSNI(sn.Name, "", sn.NamespaceOrDefault(), sn.PartitionOrDefault(), cfgSnap.Datacenter, cfgSnap.Roots.TrustDomain), protocol, nil
+
+	case structs.ServiceKindMeshGateway:
+		// Only exported services have clusters that originate mTLS from the mesh gateway.
+		var exported bool
+		for _, svc := range cfgSnap.MeshGatewayValidExportedServices() {
+			if svc == sn {
+				exported = true
+				break
+			}
+		}
+		if !exported {
+			return "", "", fmt.Errorf("%s service %q is not exported to a peer", kind, sn.String())
+		}
+
+		chain := cfgSnap.MeshGateway.DiscoveryChain[sn]
+		target, err := simpleChainTarget(chain)
+		if err != nil {
+			return "", "", fmt.Errorf("%s service %q: %w", kind, sn.String(), err)
+		}
+		return meshGatewayExportedClusterNamePrefix + CustomizeClusterName(target.Name, chain), chain.Protocol, nil
+
+	default:
+		return "", "", fmt.Errorf("%s services are not supported by %s proxies", kind, cfgSnap.Kind)
+	}
+}
+
+func isGRPCProtocol(protocol string) bool {
+	return protocol == "grpc" || protocol == "http2"
+}
@@ -292,6 +292,78 @@ end`,
 				}, nil)
 			},
 		},
+		{
+			name: "ext-authz-grpc-local-uri",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshot(t, func(ns *structs.NodeService) {
+					ns.Proxy.Config["protocol"] = "http"
+					ns.Proxy.EnvoyExtensions = []structs.EnvoyExtension{
+						{
+							Name:     api.BuiltinExtAuthzExtension,
+							Required: true,
+							Arguments: map[string]interface{}{
+								"ListenerType": "inbound",
+								"GrpcService": map[string]interface{}{
+									"Target": map[string]interface{}{
+										"URI":     "127.0.0.1:9191",
+										"Timeout": "250ms",
+									},
+								},
+							},
+						},
+					}
+				}, nil)
+			},
+		},
+		{
+			name: "ext-authz-http-upstream-service",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshot(t, func(ns *structs.NodeService) {
+					ns.Proxy.Config["protocol"] = "http"
+					ns.Proxy.EnvoyExtensions = []structs.EnvoyExtension{
+						{
+							Name: api.BuiltinExtAuthzExtension,
+							Arguments: map[string]interface{}{
+								"ListenerType":     "inbound",
+								"FailureModeAllow": true,
+								"HttpService": map[string]interface{}{
+									"Target": map[string]interface{}{
+										"Service": map[string]interface{}{
+											"Name": "db",
+										},
+									},
+									"PathPrefix":     "/authz",
+									"AllowedHeaders": []string{"x-user"},
+								},
+							},
+						},
+					}
+				}, nil)
+			},
+		},
+		{
+			name: "ext-authz-outbound-applies-to-upstreams",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "default", false, nil, nil, &structs.ServiceConfigEntry{
+					Kind:     structs.ServiceDefaults,
+					Name:     "db",
+					Protocol: "http",
+					EnvoyExtensions: []structs.EnvoyExtension{
+						{
+							Name: api.BuiltinExtAuthzExtension,
+							Arguments: map[string]interface{}{
+								"ListenerType": "outbound",
+								"GrpcService": map[string]interface{}{
+									"Target": map[string]interface{}{
+										"URI": "authz.example.com:9191",
+									},
+								},
+							},
+						},
+					},
+				})
+			},
+		},
 	}
 
 	latestEnvoyVersion := xdscommon.EnvoyVersions[0]
@@ -17,6 +17,7 @@ import (
 	envoy_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoy_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_fault_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/fault/v3"
 	envoy_grpc_http1_bridge_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/grpc_http1_bridge/v3"
 	envoy_grpc_stats_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/grpc_stats/v3"
 	envoy_http_router_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/router/v3"
@@ -41,7 +42,6 @@ import (
 	"github.com/hashicorp/consul/agent/connect"
 	"github.com/hashicorp/consul/agent/proxycfg"
 	"github.com/hashicorp/consul/agent/structs"
-	"github.com/hashicorp/consul/agent/xds/accesslogs"
 	"github.com/hashicorp/consul/envoyextensions/xdscommon"
 	"github.com/hashicorp/consul/lib"
 	"github.com/hashicorp/consul/lib/stringslice"
@@ -52,32 +52,40 @@ import (
 
 const virtualIPTag = "virtual"
 
+// faultFilterName is the name of the HTTP filter that injects the faults
+// configured on service-router routes.
+const faultFilterName = "envoy.filters.http.fault"
+
 // listenersFromSnapshot returns the xDS API representation of the "listeners" in the snapshot.
 func (s *ResourceGenerator) listenersFromSnapshot(cfgSnap *proxycfg.ConfigSnapshot) ([]proto.Message, error) {
 	if cfgSnap == nil {
 		return nil, errors.New("nil config given")
 	}
 
+	// The access log collector is resolved once and shared by every listener
+	// and filter chain.
+	accessLogs := s.accessLogs(cfgSnap)
+
 	switch cfgSnap.Kind {
 	case structs.ServiceKindConnectProxy:
-		return s.listenersFromSnapshotConnectProxy(cfgSnap)
+		return s.listenersFromSnapshotConnectProxy(cfgSnap, accessLogs)
 	case structs.ServiceKindTerminatingGateway,
 		structs.ServiceKindMeshGateway,
 		structs.ServiceKindIngressGateway,
 		structs.ServiceKindAPIGateway:
-		return s.listenersFromSnapshotGateway(cfgSnap)
+		return s.listenersFromSnapshotGateway(cfgSnap, accessLogs)
 	default:
 		return nil, fmt.Errorf("Invalid service kind: %v", cfgSnap.Kind)
 	}
 }
 
 // listenersFromSnapshotConnectProxy returns the "listeners" for a connect proxy service
-func (s *ResourceGenerator) listenersFromSnapshotConnectProxy(cfgSnap *proxycfg.ConfigSnapshot) ([]proto.Message, error) {
+func (s *ResourceGenerator) listenersFromSnapshotConnectProxy(cfgSnap *proxycfg.ConfigSnapshot, accessLogs accessLogsConfig) ([]proto.Message, error) {
 	resources := make([]proto.Message, 1)
 	var err error
 
 	// Configure inbound listener.
-	resources[0], err = s.makeInboundListener(cfgSnap, xdscommon.PublicListenerName)
+	resources[0], err = s.makeInboundListener(cfgSnap, xdscommon.PublicListenerName, accessLogs)
 	if err != nil {
 		return nil, err
 	}
@@ -100,7 +108,7 @@ func (s *ResourceGenerator) listenersFromSnapshotConnectProxy(cfgSnap *proxycfg.
 
 		opts := makeListenerOpts{
 			name:       xdscommon.OutboundListenerName,
-			accessLogs: cfgSnap.Proxy.AccessLogs,
+			accessLogs: accessLogs,
 			addr:       "127.0.0.1",
 			port:       port,
 			direction:  envoy_core_v3.TrafficDirection_OUTBOUND,
@@ -124,12 +132,7 @@ func (s *ResourceGenerator) listenersFromSnapshotConnectProxy(cfgSnap *proxycfg.
 		// default config if there is an error so it's safe to continue.
 		s.Logger.Warn("failed to parse Connect.Proxy.Config", "error", err)
 	}
-	var tracing *envoy_http_v3.HttpConnectionManager_Tracing
-	if proxyCfg.ListenerTracingJSON != "" {
-		if tracing, err = makeTracingFromUserConfig(proxyCfg.ListenerTracingJSON); err != nil {
-			s.Logger.Warn("failed to parse ListenerTracingJSON config", "error", err)
-		}
-	}
+	tracing := s.makeTracing(cfgSnap, proxyCfg.ListenerTracingJSON)
 
 	upstreamsSnapshot, err := cfgSnap.ToConfigSnapshotUpstreams()
 	if err != nil {
@@ -178,13 +181,14 @@ func (s *ResourceGenerator) listenersFromSnapshotConnectProxy(cfgSnap *proxycfg.
 		// Generate the upstream listeners for when they are explicitly set with a local bind port or socket path
 		if upstreamCfg != nil && upstreamCfg.HasLocalPortOrSocket() {
 			filterChain, err := s.makeUpstreamFilterChain(filterChainOpts{
-				accessLogs:  &cfgSnap.Proxy.AccessLogs,
-				routeName:   uid.EnvoyID(),
-				clusterName: clusterName,
-				filterName:  filterName,
-				protocol:    cfg.Protocol,
-				useRDS:      useRDS,
-				tracing:     tracing,
+				accessLogs:     accessLogs,
+				routeName:      uid.EnvoyID(),
+				clusterName:    clusterName,
+				filterName:     filterName,
+				protocol:       cfg.Protocol,
+				useRDS:         useRDS,
+				tracing:        tracing,
+				faultInjection: useRDS && chainHasFaultInjection(chain),
 			})
 			if err != nil {
 				return nil, err
@@ -192,7 +196,7 @@ func (s *ResourceGenerator) listenersFromSnapshotConnectProxy(cfgSnap *proxycfg.
 
 			opts := makeListenerOpts{
 				name:       uid.EnvoyID(),
-				accessLogs: cfgSnap.Proxy.AccessLogs,
+				accessLogs: accessLogs,
 				direction:  envoy_core_v3.TrafficDirection_OUTBOUND,
 				logger:     s.Logger,
 				upstream:   upstreamCfg,
@@ -213,13 +217,14 @@ func (s *ResourceGenerator) listenersFromSnapshotConnectProxy(cfgSnap *proxycfg.
 		// as we do for explicit upstreams above.
 
 		filterChain, err := s.makeUpstreamFilterChain(filterChainOpts{
-			accessLogs:  &cfgSnap.Proxy.AccessLogs,
-			routeName:   uid.EnvoyID(),
-			clusterName: clusterName,
-			filterName:  filterName,
-			protocol:    cfg.Protocol,
-			useRDS:      useRDS,
-			tracing:     tracing,
+			accessLogs:     accessLogs,
+			routeName:      uid.EnvoyID(),
+			clusterName:    clusterName,
+			filterName:     filterName,
+			protocol:       cfg.Protocol,
+			useRDS:         useRDS,
+			tracing:        tracing,
+			faultInjection: useRDS && chainHasFaultInjection(chain),
 		})
 		if err != nil {
 			return nil, err
@@ -287,7 +292,7 @@ func (s *ResourceGenerator) listenersFromSnapshotConnectProxy(cfgSnap *proxycfg.
 			const name = "~http" // name used for the shared route name
 			routeName := clusterNameForDestination(cfgSnap, name, fmt.Sprintf("%d", svcConfig.Destination.Port), svcConfig.NamespaceOrDefault(), svcConfig.PartitionOrDefault())
 			filterChain, err := s.makeUpstreamFilterChain(filterChainOpts{
-				accessLogs: &cfgSnap.Proxy.AccessLogs,
+				accessLogs: accessLogs,
 				routeName:  routeName,
 				filterName: routeName,
 				protocol:   svcConfig.Protocol,
@@ -305,7 +310,7 @@ func (s *ResourceGenerator) listenersFromSnapshotConnectProxy(cfgSnap *proxycfg.
 				clusterName := clusterNameForDestination(cfgSnap, uid.Name, address, uid.NamespaceOrDefault(), uid.PartitionOrDefault())
 
 				filterChain, err := s.makeUpstreamFilterChain(filterChainOpts{
-					accessLogs:  &cfgSnap.Proxy.AccessLogs,
+					accessLogs:  accessLogs,
 					routeName:   uid.EnvoyID(),
 					clusterName: clusterName,
 					filterName:  clusterName,
@@ -384,7 +389,7 @@ func (s *ResourceGenerator) listenersFromSnapshotConnectProxy(cfgSnap *proxycfg.
 		// Generate the upstream listeners for when they are explicitly set with a local bind port or socket path
 		if upstreamCfg != nil && upstreamCfg.HasLocalPortOrSocket() {
 			filterChain, err := s.makeUpstreamFilterChain(filterChainOpts{
-				accessLogs:  &cfgSnap.Proxy.AccessLogs,
+				accessLogs:  accessLogs,
 				clusterName: clusterName,
 				filterName: fmt.Sprintf("%s.%s.%s",
 					upstreamCfg.DestinationName,
@@ -401,7 +406,7 @@ func (s *ResourceGenerator) listenersFromSnapshotConnectProxy(cfgSnap *proxycfg.
 
 			opts := makeListenerOpts{
 				name:       uid.EnvoyID(),
-				accessLogs: cfgSnap.Proxy.AccessLogs,
+				accessLogs: accessLogs,
 				direction:  envoy_core_v3.TrafficDirection_OUTBOUND,
 				logger:     s.Logger,
 				upstream:   upstreamCfg,
@@ -423,7 +428,7 @@ func (s *ResourceGenerator) listenersFromSnapshotConnectProxy(cfgSnap *proxycfg.
 		// as we do for explicit upstreams above.
 
 		filterChain, err := s.makeUpstreamFilterChain(filterChainOpts{
-			accessLogs:  &cfgSnap.Proxy.AccessLogs,
+			accessLogs:  accessLogs,
 			routeName:   uid.EnvoyID(),
 			clusterName: clusterName,
 			filterName: fmt.Sprintf("%s.%s.%s",
@@ -490,7 +495,7 @@ func (s *ResourceGenerator) listenersFromSnapshotConnectProxy(cfgSnap *proxycfg.
 				filterName := fmt.Sprintf("%s.%s.%s.%s", uid.Name, uid.NamespaceOrDefault(), uid.PartitionOrDefault(), cfgSnap.Datacenter)
 
 				filterChain, err := s.makeUpstreamFilterChain(filterChainOpts{
-					accessLogs:  &cfgSnap.Proxy.AccessLogs,
+					accessLogs:  accessLogs,
 					clusterName: "passthrough~" + sni,
 					filterName:  filterName,
 					protocol:    "tcp",
@@ -542,7 +547,7 @@ func (s *ResourceGenerator) listenersFromSnapshotConnectProxy(cfgSnap *proxycfg.
 			!meshConf.TransparentProxy.MeshDestinationsOnly {
 
 			filterChain, err := s.makeUpstreamFilterChain(filterChainOpts{
-				accessLogs:  &cfgSnap.Proxy.AccessLogs,
+				accessLogs:  accessLogs,
 				clusterName: OriginalDestinationClusterName,
 				filterName:  OriginalDestinationClusterName,
 				protocol:    "tcp",
@@ -587,7 +592,7 @@ func (s *ResourceGenerator) listenersFromSnapshotConnectProxy(cfgSnap *proxycfg.
 
 		opts := makeListenerOpts{
 			name:       uid.EnvoyID(),
-			accessLogs: cfgSnap.Proxy.AccessLogs,
+			accessLogs: accessLogs,
 			direction:  envoy_core_v3.TrafficDirection_OUTBOUND,
 			logger:     s.Logger,
 			upstream:   u,
@@ -597,7 +602,7 @@ func (s *ResourceGenerator) listenersFromSnapshotConnectProxy(cfgSnap *proxycfg.
 
 		filterChain, err := s.makeUpstreamFilterChain(filterChainOpts{
 			// TODO (SNI partition) add partition for upstream SNI
-			accessLogs:  &cfgSnap.Proxy.AccessLogs,
+			accessLogs:  accessLogs,
 			clusterName: connect.UpstreamSNI(u, "", cfgSnap.Datacenter, cfgSnap.Roots.TrustDomain),
 			filterName:  uid.EnvoyID(),
 			routeName:   uid.EnvoyID(),
@@ -636,7 +641,7 @@ func (s *ResourceGenerator) listenersFromSnapshotConnectProxy(cfgSnap *proxycfg.
 			clusterName = makeExposeClusterName(path.LocalPathPort)
 		}
 
-		l, err := s.makeExposedCheckListener(cfgSnap, clusterName, path)
+		l, err := s.makeExposedCheckListener(cfgSnap, clusterName, path, accessLogs)
 		if err != nil {
 			return nil, err
 		}
@@ -777,7 +782,7 @@ func parseCheckPath(check structs.CheckType) (structs.ExposePath, error) {
 }
 
 // listenersFromSnapshotGateway returns the "listener" for a terminating-gateway or mesh-gateway service
-func (s *ResourceGenerator) listenersFromSnapshotGateway(cfgSnap *proxycfg.ConfigSnapshot) ([]proto.Message, error) {
+func (s *ResourceGenerator) listenersFromSnapshotGateway(cfgSnap *proxycfg.ConfigSnapshot, accessLogs accessLogsConfig) ([]proto.Message, error) {
 	cfg, err := ParseGatewayConfig(cfgSnap.Proxy.Config)
 	if err != nil {
 		// Don't hard fail on a config typo, just warn. The parse func returns
@@ -846,7 +851,7 @@ func (s *ResourceGenerator) listenersFromSnapshotGateway(cfgSnap *proxycfg.Confi
 
 		switch cfgSnap.Kind {
 		case structs.ServiceKindTerminatingGateway:
-			l, err = s.makeTerminatingGatewayListener(cfgSnap, a.name, a.Address, a.Port)
+			l, err = s.makeTerminatingGatewayListener(cfgSnap, a.name, a.Address, a.Port, accessLogs)
 			if err != nil {
 				return nil, err
 			}
@@ -857,19 +862,19 @@ func (s *ResourceGenerator) listenersFromSnapshotGateway(cfgSnap *proxycfg.Confi
 			if err != nil {
 				return nil, err
 			}
-			listeners, err := s.makeIngressGatewayListeners(a.Address, cfgSnap)
+			listeners, err := s.makeIngressGatewayListeners(a.Address, cfgSnap, accessLogs)
 			if err != nil {
 				return nil, err
 			}
 			resources = append(resources, listeners...)
 		case structs.ServiceKindIngressGateway:
-			listeners, err := s.makeIngressGatewayListeners(a.Address, cfgSnap)
+			listeners, err := s.makeIngressGatewayListeners(a.Address, cfgSnap, accessLogs)
 			if err != nil {
 				return nil, err
 			}
 			resources = append(resources, listeners...)
 		case structs.ServiceKindMeshGateway:
-			l, err = s.makeMeshGatewayListener(a.name, a.Address, a.Port, cfgSnap)
+			l, err = s.makeMeshGatewayListener(a.name, a.Address, a.Port, cfgSnap, accessLogs)
 			if err != nil {
 				return nil, err
 			}
@@ -894,7 +899,7 @@ func (s *ResourceGenerator) listenersFromSnapshotGateway(cfgSnap *proxycfg.Confi
 // longer in the config.
 type makeListenerOpts struct {
 	addr       string
-	accessLogs structs.AccessLogsConfig
+	accessLogs accessLogsConfig
 	logger     hclog.Logger
 	mode       string
 	name       string
@@ -923,7 +928,7 @@ func makeListenerWithDefault(opts makeListenerOpts) *envoy_listener_v3.Listener
 	if opts.addr == "" {
 		opts.addr = "127.0.0.1"
 	}
-	accessLog, err := accesslogs.MakeAccessLogs(&opts.accessLogs, true)
+	accessLog, err := opts.accessLogs.make(true)
 	if err != nil && opts.logger != nil {
 		// Since access logging is non-essential for routing, warn and move on
 		opts.logger.Warn("error generating access log xds", err)
@@ -942,7 +947,7 @@ func makePipeListener(opts makeListenerOpts) *envoy_listener_v3.Listener {
 	if err != nil {
 		modeInt = 0
 	}
-	accessLog, err := accesslogs.MakeAccessLogs(&opts.accessLogs, true)
+	accessLog, err := opts.accessLogs.make(true)
 	if err != nil && opts.logger != nil {
 		// Since access logging is non-essential for routing, warn and move on
 		opts.logger.Warn("error generating access log xds", err)
@@ -1255,7 +1260,7 @@ func makeSpiffeValidatorConfig(trustDomain, roots string, peerBundles []*pbpeeri
 	return anypb.New(cfg)
 }
 
-func (s *ResourceGenerator) makeInboundListener(cfgSnap *proxycfg.ConfigSnapshot, name string) (proto.Message, error) {
+func (s *ResourceGenerator) makeInboundListener(cfgSnap *proxycfg.ConfigSnapshot, name string, accessLogs accessLogsConfig) (proto.Message, error) {
 	var l *envoy_listener_v3.Listener
 	var err error
 
@@ -1332,7 +1337,7 @@ func (s *ResourceGenerator) makeInboundListener(cfgSnap *proxycfg.ConfigSnapshot
 
 	opts := makeListenerOpts{
 		name:       name,
-		accessLogs: cfgSnap.Proxy.AccessLogs,
+		accessLogs: accessLogs,
 		addr:       addr,
 		port:       port,
 		direction:  envoy_core_v3.TrafficDirection_INBOUND,
@@ -1341,12 +1346,7 @@ func (s *ResourceGenerator) makeInboundListener(cfgSnap *proxycfg.ConfigSnapshot
 	l = makeListener(opts)
 	s.injectConnectionBalanceConfig(cfg.BalanceInboundConnections, l)
 
-	var tracing *envoy_http_v3.HttpConnectionManager_Tracing
-	if cfg.ListenerTracingJSON != "" {
-		if tracing, err = makeTracingFromUserConfig(cfg.ListenerTracingJSON); err != nil {
-			s.Logger.Warn("failed to parse ListenerTracingJSON config", "error", err)
-		}
-	}
+	tracing := s.makeTracing(cfgSnap, cfg.ListenerTracingJSON)
 
 	filterOpts := listenerFilterOpts{
 		protocol:         cfg.Protocol,
@@ -1356,7 +1356,7 @@ func (s *ResourceGenerator) makeInboundListener(cfgSnap *proxycfg.ConfigSnapshot
 		requestTimeoutMs: cfg.LocalRequestTimeoutMs,
 		idleTimeoutMs:    cfg.LocalIdleTimeoutMs,
 		tracing:          tracing,
-		accessLogs:       &cfgSnap.Proxy.AccessLogs,
+		accessLogs:       accessLogs,
 		logger:           s.Logger,
 	}
 	if useHTTPFilter {
@@ -1494,7 +1494,7 @@ func (s *ResourceGenerator) finalizePublicListenerFromConfig(l *envoy_listener_v
 	return nil
 }
 
-func (s *ResourceGenerator) makeExposedCheckListener(cfgSnap *proxycfg.ConfigSnapshot, cluster string, path structs.ExposePath) (proto.Message, error) {
+func (s *ResourceGenerator) makeExposedCheckListener(cfgSnap *proxycfg.ConfigSnapshot, cluster string, path structs.ExposePath, accessLogs accessLogsConfig) (proto.Message, error) {
 	cfg, err := ParseProxyConfig(cfgSnap.Proxy.Config)
 	if err != nil {
 		// Don't hard fail on a config typo, just warn. The parse func returns
@@ -1519,7 +1519,7 @@ func (s *ResourceGenerator) makeExposedCheckListener(cfgSnap *proxycfg.ConfigSna
 
 	listenerOpts := makeListenerOpts{
 		name:       listenerName,
-		accessLogs: cfgSnap.Proxy.AccessLogs,
+		accessLogs: accessLogs,
 		addr:       addr,
 		port:       path.ListenerPort,
 		direction:  envoy_core_v3.TrafficDirection_INBOUND,
@@ -1538,7 +1538,7 @@ func (s *ResourceGenerator) makeExposedCheckListener(cfgSnap *proxycfg.ConfigSna
 		statPrefix:       "",
 		routePath:        path.Path,
 		httpAuthzFilters: nil,
-		accessLogs:       &cfgSnap.Proxy.AccessLogs,
+		accessLogs:       accessLogs,
 		logger:           s.Logger,
 		// in the exposed check listener we don't set the tracing configuration
 	}
@@ -1592,11 +1592,12 @@ func (s *ResourceGenerator) makeTerminatingGatewayListener(
 	cfgSnap *proxycfg.ConfigSnapshot,
 	name, addr string,
 	port int,
+	accessLogs accessLogsConfig,
 ) (*envoy_listener_v3.Listener, error) {
 
 	listenerOpts := makeListenerOpts{
 		name:       name,
-		accessLogs: cfgSnap.Proxy.AccessLogs,
+		accessLogs: accessLogs,
 		addr:       addr,
 		port:       port,
 		direction:  envoy_core_v3.TrafficDirection_INBOUND,
@@ -1637,6 +1638,7 @@ func (s *ResourceGenerator) makeTerminatingGatewayListener(
 			service:    svc,
 			intentions: intentions,
 			protocol:   cfg.Protocol,
+			accessLogs: accessLogs,
 		}
 
 		clusterChain, err := s.makeFilterChainTerminatingGateway(cfgSnap, opts)
@@ -1684,6 +1686,7 @@ func (s *ResourceGenerator) makeTerminatingGatewayListener(
 			intentions: intentions,
 			protocol:   cfg.Protocol,
 			port:       dest.Port,
+			accessLogs: accessLogs,
 		}
 		for _, address := range dest.Addresses {
 			clusterName := clusterNameForDestination(cfgSnap, svc.Name, address, svc.NamespaceOrDefault(), svc.PartitionOrDefault())
@@ -1712,7 +1715,7 @@ func (s *ResourceGenerator) makeTerminatingGatewayListener(
 	// This fallback catch-all filter ensures a listener will be present for health checks to pass
 	// Envoy will reset these connections since known endpoints are caught by filter chain matches above
 	filterOpts := listenerFilterOpts{
-		accessLogs: &cfgSnap.Proxy.AccessLogs,
+		accessLogs: accessLogs,
 		cluster:    "",
 		filterName: name,
 		logger:     s.Logger,
@@ -1746,6 +1749,7 @@ type terminatingGatewayFilterChainOpts struct {
 	protocol   string
 	address    string // only valid for destination listeners
 	port       int    // only valid for destination listeners
+	accessLogs accessLogsConfig
 }
 
 func (s *ResourceGenerator) makeFilterChainTerminatingGateway(cfgSnap *proxycfg.ConfigSnapshot, tgtwyOpts terminatingGatewayFilterChainOpts) (*envoy_listener_v3.FilterChain, error) {
@@ -1795,12 +1799,7 @@ func (s *ResourceGenerator) makeFilterChainTerminatingGateway(cfgSnap *proxycfg.
 		// default config if there is an error so it's safe to continue.
 		s.Logger.Warn("failed to parse Connect.Proxy.Config", "error", err)
 	}
-	var tracing *envoy_http_v3.HttpConnectionManager_Tracing
-	if proxyCfg.ListenerTracingJSON != "" {
-		if tracing, err = makeTracingFromUserConfig(proxyCfg.ListenerTracingJSON); err != nil {
-			s.Logger.Warn("failed to parse ListenerTracingJSON config", "error", err)
-		}
-	}
+	tracing := s.makeTracing(cfgSnap, proxyCfg.ListenerTracingJSON)
 
 	// Lastly we setup the actual proxying component. For L4 this is a straight
 	// tcp proxy. For L7 this is a very hands-off HTTP proxy just to inject an
@@ -1813,7 +1812,7 @@ func (s *ResourceGenerator) makeFilterChainTerminatingGateway(cfgSnap *proxycfg.
 		statPrefix: "upstream.",
 		routePath:  "",
 		tracing:    tracing,
-		accessLogs: &cfgSnap.Proxy.AccessLogs,
+		accessLogs: tgtwyOpts.accessLogs,
 		logger:     s.Logger,
 	}
 
@@ -1855,7 +1854,7 @@ func (s *ResourceGenerator) makeFilterChainTerminatingGateway(cfgSnap *proxycfg.
 	return filterChain, nil
 }
 
-func (s *ResourceGenerator) makeMeshGatewayListener(name, addr string, port int, cfgSnap *proxycfg.ConfigSnapshot) (*envoy_listener_v3.Listener, error) {
+func (s *ResourceGenerator) makeMeshGatewayListener(name, addr string, port int, cfgSnap *proxycfg.ConfigSnapshot, accessLogs accessLogsConfig) (*envoy_listener_v3.Listener, error) {
 	tlsInspector, err := makeTLSInspectorListenerFilter()
 	if err != nil {
 		return nil, err
@@ -1869,7 +1868,7 @@ func (s *ResourceGenerator) makeMeshGatewayListener(name, addr string, port int,
 	// The cluster name here doesn't matter as the sni_cluster
 	// filter will fill it in for us.
 	filterOpts := listenerFilterOpts{
-		accessLogs: &cfgSnap.Proxy.AccessLogs,
+		accessLogs: accessLogs,
 		cluster:    "",
 		filterName: name,
 		logger:     s.Logger,
@@ -1889,7 +1888,7 @@ func (s *ResourceGenerator) makeMeshGatewayListener(name, addr string, port int,
 
 	opts := makeListenerOpts{
 		name:       name,
-		accessLogs: cfgSnap.Proxy.AccessLogs,
+		accessLogs: accessLogs,
 		addr:       addr,
 		port:       port,
 		direction:  envoy_core_v3.TrafficDirection_UNSPECIFIED,
@@ -1902,7 +1901,7 @@ func (s *ResourceGenerator) makeMeshGatewayListener(name, addr string, port int,
 		peerNames := cfgSnap.MeshGateway.ExportedServicesWithPeers[svc]
 		chain := cfgSnap.MeshGateway.DiscoveryChain[svc]
 
-		filterChain, err := s.makeMeshGatewayPeerFilterChain(cfgSnap, svc, peerNames, chain)
+		filterChain, err := s.makeMeshGatewayPeerFilterChain(cfgSnap, svc, peerNames, chain, accessLogs)
 		if err != nil {
 			return nil, err
 		} else if filterChain == nil {
@@ -1923,7 +1922,7 @@ func (s *ResourceGenerator) makeMeshGatewayListener(name, addr string, port int,
 		filterName := fmt.Sprintf("%s.%s", name, key.String())
 
 		filterOpts := listenerFilterOpts{
-			accessLogs: &cfgSnap.Proxy.AccessLogs,
+			accessLogs: accessLogs,
 			cluster:    clusterName,
 			filterName: filterName,
 			logger:     s.Logger,
@@ -1959,7 +1958,7 @@ func (s *ResourceGenerator) makeMeshGatewayListener(name, addr string, port int,
 			clusterName := cfgSnap.ServerSNIFn(key.Datacenter, "")
 			filterName := fmt.Sprintf("%s.%s", name, key.String())
 			filterOpts := listenerFilterOpts{
-				accessLogs: &cfgSnap.Proxy.AccessLogs,
+				accessLogs: accessLogs,
 				cluster:    clusterName,
 				filterName: filterName,
 				logger:     s.Logger,
@@ -1987,7 +1986,7 @@ func (s *ResourceGenerator) makeMeshGatewayListener(name, addr string, port int,
 
 			filterName := fmt.Sprintf("%s.%s", name, cfgSnap.Datacenter)
 			filterOpts := listenerFilterOpts{
-				accessLogs: &cfgSnap.Proxy.AccessLogs,
+				accessLogs: accessLogs,
 				cluster:    clusterName,
 				filterName: filterName,
 				logger:     s.Logger,
@@ -2025,7 +2024,7 @@ func (s *ResourceGenerator) makeMeshGatewayListener(name, addr string, port int,
 			filterName := fmt.Sprintf("%s.%s", name, cfgSnap.Datacenter)
 
 			filterOpts := listenerFilterOpts{
-				accessLogs: &cfgSnap.Proxy.AccessLogs,
+				accessLogs: accessLogs,
 				cluster:    clusterName,
 				filterName: filterName,
 				logger:     s.Logger,
@@ -2053,7 +2052,7 @@ func (s *ResourceGenerator) makeMeshGatewayListener(name, addr string, port int,
 	for name := range cfgSnap.MeshGateway.PeerServers {
 
 		filterOpts := listenerFilterOpts{
-			accessLogs: &cfgSnap.Proxy.AccessLogs,
+			accessLogs: accessLogs,
 			cluster:    name,
 			filterName: name,
 			logger:     s.Logger,
@@ -2090,6 +2089,7 @@ func (s *ResourceGenerator) makeMeshGatewayPeerFilterChain(
 	svc structs.ServiceName,
 	peerNames []string,
 	chain *structs.CompiledDiscoveryChain,
+	accessLogs accessLogsConfig,
 ) (*envoy_listener_v3.FilterChain, error) {
 	var (
 		useHTTPFilter = structs.IsProtocolHTTPLike(chain.Protocol)
@@ -2117,7 +2117,7 @@ func (s *ResourceGenerator) makeMeshGatewayPeerFilterChain(
 	filterName := fmt.Sprintf("%s.%s.%s.%s", chain.ServiceName, chain.Namespace, chain.Partition, chain.Datacenter)
 
 	filterChain, err := s.makeUpstreamFilterChain(filterChainOpts{
-		accessLogs:           &cfgSnap.Proxy.AccessLogs,
+		accessLogs:           accessLogs,
 		routeName:            uid.EnvoyID(),
 		clusterName:          clusterName,
 		filterName:           filterName,
@@ -2126,6 +2126,7 @@ func (s *ResourceGenerator) makeMeshGatewayPeerFilterChain(
 		statPrefix:           "mesh_gateway_local_peered.",
 		forwardClientDetails: true,
 		forwardClientPolicy:  envoy_http_v3.HttpConnectionManager_SANITIZE_SET,
+		tracing:              s.makeTracing(cfgSnap, ""),
 	})
 	if err != nil {
 		return nil, err
@@ -2166,7 +2167,7 @@ func (s *ResourceGenerator) makeMeshGatewayPeerFilterChain(
 }
 
 type filterChainOpts struct {
-	accessLogs           *structs.AccessLogsConfig
+	accessLogs           accessLogsConfig
 	routeName            string
 	clusterName          string
 	filterName           string
@@ -2177,6 +2178,7 @@ type filterChainOpts struct {
 	forwardClientDetails bool
 	forwardClientPolicy  envoy_http_v3.HttpConnectionManager_ForwardClientCertDetails
 	tracing              *envoy_http_v3.HttpConnectionManager_Tracing
+	faultInjection       bool
 }
 
 func (s *ResourceGenerator) makeUpstreamFilterChain(opts filterChainOpts) (*envoy_listener_v3.FilterChain, error) {
@@ -2193,6 +2195,7 @@ func (s *ResourceGenerator) makeUpstreamFilterChain(opts filterChainOpts) (*envo
 		forwardClientDetails: opts.forwardClientDetails,
 		forwardClientPolicy:  opts.forwardClientPolicy,
 		tracing:              opts.tracing,
+		faultInjection:       opts.faultInjection,
 		accessLogs:           opts.accessLogs,
 		logger:               s.Logger,
 	})
@@ -2329,7 +2332,7 @@ func (s *ResourceGenerator) getAndModifyUpstreamConfigForPeeredListener(
 
 type listenerFilterOpts struct {
 	// All listener filters
-	accessLogs *structs.AccessLogsConfig
+	accessLogs accessLogsConfig
 	cluster    string
 	filterName string
 	logger     hclog.Logger
@@ -2337,6 +2340,7 @@ type listenerFilterOpts struct {
 	statPrefix string
 
 	// HTTP listener filter options
+	faultInjection       bool
 	forwardClientDetails bool
 	forwardClientPolicy  envoy_http_v3.HttpConnectionManager_ForwardClientCertDetails
 	httpAuthzFilters     []*envoy_http_v3.HttpFilter
@@ -2386,7 +2390,7 @@ func makeSNIClusterFilter() (*envoy_listener_v3.Filter, error) {
 }
 
 func makeTCPProxyFilter(opts listenerFilterOpts) (*envoy_listener_v3.Filter, error) {
-	accessLogs, err := accesslogs.MakeAccessLogs(opts.accessLogs, false)
+	accessLogs, err := opts.accessLogs.make(false)
 	if err != nil && opts.logger != nil {
 		opts.logger.Warn("could not make access log xds for tcp proxy", err)
 	}
@@ -2433,7 +2437,7 @@ func makeHTTPFilter(opts listenerFilterOpts) (*envoy_listener_v3.Filter, error)
 		return nil, err
 	}
 
-	accessLogs, err := accesslogs.MakeAccessLogs(opts.accessLogs, false)
+	accessLogs, err := opts.accessLogs.make(false)
 	if err != nil && opts.logger != nil {
 		opts.logger.Warn("could not make access log xds for http connection manager", err)
 	}
@@ -2457,6 +2461,16 @@ func makeHTTPFilter(opts listenerFilterOpts) (*envoy_listener_v3.Filter, error)
 		cfg.Tracing = opts.tracing
 	}
 
+	if opts.faultInjection {
+		// The faults are configured per route, so the filter itself does not
+		// inject any.
+		fault, err := makeEnvoyHTTPFilter(faultFilterName, &envoy_fault_v3.HTTPFault{})
+		if err != nil {
+			return nil, err
+		}
+		cfg.HttpFilters = append([]*envoy_http_v3.HttpFilter{fault}, cfg.HttpFilters...)
+	}
+
 	if opts.useRDS {
 		if opts.cluster != "" {
 			return nil, fmt.Errorf("cannot specify cluster name when using RDS")
@@ -20,7 +20,7 @@ import (
 	"github.com/hashicorp/consul/types"
 )
 
-func (s *ResourceGenerator) makeIngressGatewayListeners(address string, cfgSnap *proxycfg.ConfigSnapshot) ([]proto.Message, error) {
+func (s *ResourceGenerator) makeIngressGatewayListeners(address string, cfgSnap *proxycfg.ConfigSnapshot, accessLogs accessLogsConfig) ([]proto.Message, error) {
 	var resources []proto.Message
 
 	for listenerKey, upstreams := range cfgSnap.IngressGateway.Upstreams {
@@ -78,7 +78,7 @@ func (s *ResourceGenerator) makeIngressGatewayListeners(address string, cfgSnap
 
 			opts := makeListenerOpts{
 				name:       uid.EnvoyID(),
-				accessLogs: cfgSnap.Proxy.AccessLogs,
+				accessLogs: accessLogs,
 				addr:       address,
 				port:       u.LocalBindPort,
 				direction:  envoy_core_v3.TrafficDirection_OUTBOUND,
@@ -87,13 +87,14 @@ func (s *ResourceGenerator) makeIngressGatewayListeners(address string, cfgSnap
 			l := makeListener(opts)
 
 			filterChain, err := s.makeUpstreamFilterChain(filterChainOpts{
-				accessLogs:  &cfgSnap.Proxy.AccessLogs,
+				accessLogs:  accessLogs,
 				routeName:   uid.EnvoyID(),
 				useRDS:      useRDS,
 				clusterName: clusterName,
 				filterName:  filterName,
 				protocol:    cfg.Protocol,
 				tlsContext:  tlsContext,
+				tracing:     s.makeTracing(cfgSnap, ""),
 			})
 			if err != nil {
 				return nil, err
@@ -110,7 +111,8 @@ func (s *ResourceGenerator) makeIngressGatewayListeners(address string, cfgSnap
 					routeName:  listenerKey.RouteName(),
 					cluster:    clusterName,
 					statPrefix: "ingress_upstream_",
-					accessLogs: &cfgSnap.Proxy.AccessLogs,
+					tracing:    s.makeTracing(cfgSnap, ""),
+					accessLogs: accessLogs,
 					logger:     s.Logger,
 				}, certs)
 				if err != nil {
@@ -129,7 +131,7 @@ func (s *ResourceGenerator) makeIngressGatewayListeners(address string, cfgSnap
 			// If multiple upstreams share this port, make a special listener for the protocol.
 			listenerOpts := makeListenerOpts{
 				name:       listenerKey.Protocol,
-				accessLogs: cfgSnap.Proxy.AccessLogs,
+				accessLogs: accessLogs,
 				addr:       address,
 				port:       listenerKey.Port,
 				direction:  envoy_core_v3.TrafficDirection_OUTBOUND,
@@ -137,6 +139,15 @@ func (s *ResourceGenerator) makeIngressGatewayListeners(address string, cfgSnap
 			}
 			listener := makeListener(listenerOpts)
 
+			// The routes of every upstream are served by the same filter chains.
+			var faultInjection bool
+			for _, u := range upstreams {
+				if chainHasFaultInjection(cfgSnap.IngressGateway.DiscoveryChain[proxycfg.NewUpstreamID(&u)]) {
+					faultInjection = true
+					break
+				}
+			}
+
 			filterOpts := listenerFilterOpts{
 				useRDS:           true,
 				protocol:         listenerKey.Protocol,
@@ -146,7 +157,9 @@ func (s *ResourceGenerator) makeIngressGatewayListeners(address string, cfgSnap
 				statPrefix:       "ingress_upstream_",
 				routePath:        "",
 				httpAuthzFilters: nil,
-				accessLogs:       &cfgSnap.Proxy.AccessLogs,
+				tracing:          s.makeTracing(cfgSnap, ""),
+				faultInjection:   faultInjection,
+				accessLogs:       accessLogs,
 				logger:           s.Logger,
 			}
 
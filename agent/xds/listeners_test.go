@@ -64,6 +64,19 @@ func makeListenerDiscoChainTests(enterprise bool) []listenerTestCase {
 				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "splitter-with-resolver-redirect-multidc", enterprise, nil, nil)
 			},
 		},
+		{
+			name: "connect-proxy-with-router-with-fault-injection",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "router-with-fault-injection", enterprise, nil, nil)
+			},
+		},
+		{
+			name: "ingress-with-router-with-fault-injection",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotIngressGateway(t, true, "http",
+					"router-with-fault-injection", nil, nil, nil)
+			},
+		},
 		{
 			name: "connect-proxy-with-tcp-chain",
 			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
@@ -1099,6 +1112,195 @@ func TestListenersFromSnapshot(t *testing.T) {
 					nil)
 			},
 		},
+		{
+			name: "access-logs-opentelemetry-upstream",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "simple", false, func(ns *structs.NodeService) {
+					ns.Proxy.AccessLogs = structs.AccessLogsConfig{
+						Enabled:     true,
+						Type:        structs.OpenTelemetryLogSinkType,
+						ServiceName: "db",
+						LogName:     "mesh",
+					}
+				}, nil,
+					&structs.ProxyConfigEntry{
+						Kind: structs.ProxyDefaults,
+						Name: structs.ProxyConfigGlobal,
+						Config: map[string]interface{}{
+							"protocol": "grpc",
+						},
+					},
+				)
+			},
+		},
+		{
+			name: "access-logs-grpc-upstream-tcp-protocol",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				// Logs are not configured because the service does not use gRPC.
+				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "simple", false, func(ns *structs.NodeService) {
+					ns.Proxy.AccessLogs = structs.AccessLogsConfig{
+						Enabled:     true,
+						Type:        structs.GRPCLogSinkType,
+						ServiceName: "db",
+					}
+				}, nil)
+			},
+		},
+		{
+			name: "access-logs-grpc-ingress-gateway",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotIngressGateway(t, true, "grpc", "default", func(ns *structs.NodeService) {
+					ns.Proxy.AccessLogs = structs.AccessLogsConfig{
+						Enabled:     true,
+						Type:        structs.GRPCLogSinkType,
+						ServiceName: "db",
+					}
+				}, nil, nil,
+					&structs.ProxyConfigEntry{
+						Kind: structs.ProxyDefaults,
+						Name: structs.ProxyConfigGlobal,
+						Config: map[string]interface{}{
+							"protocol": "grpc",
+						},
+					},
+				)
+			},
+		},
+		{
+			name: "access-logs-opentelemetry-terminating-gateway",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				db := structs.NewServiceName("db", nil)
+				return proxycfg.TestConfigSnapshotTerminatingGateway(t, true, func(ns *structs.NodeService) {
+					ns.Proxy.AccessLogs = structs.AccessLogsConfig{
+						Enabled:     true,
+						Type:        structs.OpenTelemetryLogSinkType,
+						ServiceName: "db",
+						JSONFormat:  "{ \"start_time\": \"%START_TIME%\", \"response\": { \"code\": \"%RESPONSE_CODE%\" } }",
+					}
+				}, []proxycfg.UpdateEvent{
+					{
+						CorrelationID: "service-config:" + db.String(),
+						Result: &structs.ServiceConfigResponse{
+							ProxyConfig: map[string]interface{}{"protocol": "grpc"},
+						},
+					},
+				})
+			},
+		},
+		{
+			name: "tracing-opentelemetry-upstream",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "simple", false, func(ns *structs.NodeService) {
+					ns.Proxy.Tracing = &structs.TracingConfig{
+						Provider:       structs.OpenTelemetryTracingProvider,
+						ServiceName:    "db",
+						RandomSampling: float64Pointer(10),
+						CustomTags: []structs.TracingCustomTag{
+							{Tag: "team", Literal: "payments"},
+							{Tag: "pod", Environment: "POD_NAME", DefaultValue: "unknown"},
+							{Tag: "request-id", RequestHeader: "x-request-id"},
+						},
+					}
+				}, nil,
+					&structs.ProxyConfigEntry{
+						Kind: structs.ProxyDefaults,
+						Name: structs.ProxyConfigGlobal,
+						Config: map[string]interface{}{
+							"protocol": "grpc",
+						},
+					},
+				)
+			},
+		},
+		{
+			name: "tracing-zipkin-upstream",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "simple", false, func(ns *structs.NodeService) {
+					ns.Proxy.Config["protocol"] = "http"
+					ns.Proxy.Tracing = &structs.TracingConfig{
+						Provider:        structs.ZipkinTracingProvider,
+						ServiceName:     "db",
+						ClientSampling:  float64Pointer(100),
+						OverallSampling: float64Pointer(50),
+					}
+				}, nil,
+					&structs.ProxyConfigEntry{
+						Kind: structs.ProxyDefaults,
+						Name: structs.ProxyConfigGlobal,
+						Config: map[string]interface{}{
+							"protocol": "http",
+						},
+					},
+				)
+			},
+		},
+		{
+			name: "tracing-escape-hatch-overrides-config",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "simple", false, func(ns *structs.NodeService) {
+					ns.Proxy.Config["protocol"] = "http"
+					ns.Proxy.Config["envoy_listener_tracing_json"] = customTraceJSON(t)
+					ns.Proxy.Tracing = &structs.TracingConfig{
+						Provider:    structs.ZipkinTracingProvider,
+						ServiceName: "db",
+					}
+				}, nil,
+					&structs.ProxyConfigEntry{
+						Kind: structs.ProxyDefaults,
+						Name: structs.ProxyConfigGlobal,
+						Config: map[string]interface{}{
+							"protocol": "http",
+						},
+					},
+				)
+			},
+		},
+		{
+			name: "tracing-zipkin-ingress-gateway",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotIngressGateway(t, true, "http", "default", func(ns *structs.NodeService) {
+					ns.Proxy.Tracing = &structs.TracingConfig{
+						Provider:          structs.ZipkinTracingProvider,
+						ServiceName:       "db",
+						CollectorEndpoint: "/api/v1/spans",
+					}
+				}, nil, nil,
+					&structs.ProxyConfigEntry{
+						Kind: structs.ProxyDefaults,
+						Name: structs.ProxyConfigGlobal,
+						Config: map[string]interface{}{
+							"protocol": "http",
+						},
+					},
+				)
+			},
+		},
+		{
+			name: "tracing-opentelemetry-terminating-gateway",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				web := structs.NewServiceName("web", nil)
+				db := structs.NewServiceName("db", nil)
+				return proxycfg.TestConfigSnapshotTerminatingGateway(t, true, func(ns *structs.NodeService) {
+					ns.Proxy.Tracing = &structs.TracingConfig{
+						Provider:    structs.OpenTelemetryTracingProvider,
+						ServiceName: "db",
+					}
+				}, []proxycfg.UpdateEvent{
+					{
+						CorrelationID: "service-config:" + web.String(),
+						Result: &structs.ServiceConfigResponse{
+							ProxyConfig: map[string]interface{}{"protocol": "http"},
+						},
+					},
+					{
+						CorrelationID: "service-config:" + db.String(),
+						Result: &structs.ServiceConfigResponse{
+							ProxyConfig: map[string]interface{}{"protocol": "grpc"},
+						},
+					},
+				})
+			},
+		},
 		{
 			name: "connect-proxy-with-tproxy-and-permissive-mtls",
 			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
@@ -1282,6 +1484,10 @@ func customHTTPListenerJSON(t testinf.T, opts customHTTPListenerJSONOptions) str
 	return buf.String()
 }
 
+func float64Pointer(f float64) *float64 {
+	return &f
+}
+
 func customTraceJSON(t testinf.T) string {
 	t.Helper()
 	return `
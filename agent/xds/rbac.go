@@ -28,11 +28,12 @@ func makeRBACNetworkFilter(
 	localInfo rbacLocalInfo,
 	peerTrustBundles []*pbpeering.PeeringTrustBundle,
 ) (*envoy_listener_v3.Filter, error) {
-	rules := makeRBACRules(intentions, intentionDefaultAllow, localInfo, false, peerTrustBundles)
+	rules, shadowRules := makeRBACRulesAndShadowRules(intentions, intentionDefaultAllow, localInfo, false, peerTrustBundles)
 
 	cfg := &envoy_network_rbac_v3.RBAC{
-		StatPrefix: "connect_authz",
-		Rules:      rules,
+		StatPrefix:  "connect_authz",
+		Rules:       rules,
+		ShadowRules: shadowRules,
 	}
 	return makeFilter("envoy.filters.network.rbac", cfg)
 }
@@ -43,10 +44,11 @@ func makeRBACHTTPFilter(
 	localInfo rbacLocalInfo,
 	peerTrustBundles []*pbpeering.PeeringTrustBundle,
 ) (*envoy_http_v3.HttpFilter, error) {
-	rules := makeRBACRules(intentions, intentionDefaultAllow, localInfo, true, peerTrustBundles)
+	rules, shadowRules := makeRBACRulesAndShadowRules(intentions, intentionDefaultAllow, localInfo, true, peerTrustBundles)
 
 	cfg := &envoy_http_rbac_v3.RBAC{
-		Rules: rules,
+		Rules:       rules,
+		ShadowRules: shadowRules,
 	}
 	return makeEnvoyHTTPFilter("envoy.filters.http.rbac", cfg)
 }
@@ -428,6 +430,32 @@ type rbacLocalInfo struct {
 	expectXFCC  bool
 }
 
+// makeRBACRulesAndShadowRules returns the rules that enforce the intentions
+// that are not audited. If any intention is audited it also returns shadow
+// rules that evaluate all of the intentions. Envoy counts and logs the
+// connections and requests that the shadow rules would deny, but does not
+// deny them.
+func makeRBACRulesAndShadowRules(
+	intentions structs.SimplifiedIntentions,
+	intentionDefaultAllow bool,
+	localInfo rbacLocalInfo,
+	isHTTP bool,
+	peerTrustBundles []*pbpeering.PeeringTrustBundle,
+) (*envoy_rbac_v3.RBAC, *envoy_rbac_v3.RBAC) {
+	enforced := make(structs.SimplifiedIntentions, 0, len(intentions))
+	for _, ixn := range intentions {
+		if !ixn.IsAudit() {
+			enforced = append(enforced, ixn)
+		}
+	}
+
+	rules := makeRBACRules(enforced, intentionDefaultAllow, localInfo, isHTTP, peerTrustBundles)
+	if len(enforced) == len(intentions) {
+		return rules, nil
+	}
+	return rules, makeRBACRules(intentions, intentionDefaultAllow, localInfo, isHTTP, peerTrustBundles)
+}
+
 // makeRBACRules translates Consul intentions into RBAC Policies for Envoy.
 //
 // Consul lets you define up to 9 different kinds of intentions that apply at
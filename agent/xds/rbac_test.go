@@ -484,6 +484,10 @@ func TestMakeRBACNetworkAndHTTPFilters(t *testing.T) {
 		ixn.Permissions = perms
 		return ixn
 	}
+	audited := func(ixn *structs.Intention) *structs.Intention {
+		ixn.Mode = structs.IntentionModeAudit
+		return ixn
+	}
 	testPeerTrustBundle := []*pbpeering.PeeringTrustBundle{
 		{
 			PeerName:          "peer1",
@@ -550,6 +554,20 @@ func TestMakeRBACNetworkAndHTTPFilters(t *testing.T) {
 				testSourceIntention("web", structs.IntentionActionDeny),
 			),
 		},
+		"default-allow-audited-deny": {
+			intentionDefaultAllow: true,
+			intentions: sorted(
+				testSourceIntention("web", structs.IntentionActionDeny),
+				audited(testSourceIntention("*", structs.IntentionActionDeny)),
+			),
+		},
+		"default-deny-audited-allow-deny": {
+			intentionDefaultAllow: false,
+			intentions: sorted(
+				audited(testSourceIntention("web", structs.IntentionActionDeny)),
+				testSourceIntention("*", structs.IntentionActionAllow),
+			),
+		},
 		"default-deny-allow-deny": {
 			intentionDefaultAllow: false,
 			intentions: sorted(
@@ -229,6 +229,17 @@ func getMeshGatewayPeeringGoldenTestCases() []goldenTestCase {
 				return proxycfg.TestConfigSnapshotPeeredMeshGateway(t, "default-services-http", nil, nil)
 			},
 		},
+		{
+			name: "mesh-gateway-with-exported-peered-services-http-with-tracing",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotPeeredMeshGateway(t, "default-services-http", func(ns *structs.NodeService) {
+					ns.Proxy.Tracing = &structs.TracingConfig{
+						Provider:    structs.ZipkinTracingProvider,
+						ServiceName: "gir",
+					}
+				}, nil)
+			},
+		},
 		{
 			name: "mesh-gateway-with-exported-peered-services-http-with-router",
 			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
@@ -6,6 +6,7 @@ package xds
 import (
 	"errors"
 	"fmt"
+	"math"
 	"net"
 	"sort"
 	"strings"
@@ -13,9 +14,13 @@ import (
 
 	envoy_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_fault_common_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/common/fault/v3"
+	envoy_fault_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/fault/v3"
 	envoy_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/hashicorp/consul/agent/connect"
@@ -603,6 +608,27 @@ func (s *ResourceGenerator) makeUpstreamRouteForDiscoveryChain(
 				if err := injectHeaderManipToRoute(destination, route); err != nil {
 					return nil, fmt.Errorf("failed to apply header manipulation configuration to route: %v", err)
 				}
+
+				// Faults are injected by the downstream proxy, and must not be
+				// injected again by the mesh gateway that proxies the request.
+				if destination.FaultInjection != nil && !forMeshGateway {
+					if err := injectFaultToRoute(destination.FaultInjection, route); err != nil {
+						return nil, fmt.Errorf("failed to apply fault injection configuration to route: %v", err)
+					}
+				}
+
+				if destination.Mirror != nil && discoveryRoute.MirrorNode != "" {
+					percentage := destination.Mirror.Percentage
+					if percentage <= 0 {
+						percentage = 100
+					}
+					policy := s.makeRequestMirrorPolicy(upstreamsSnapshot, chain, discoveryRoute.MirrorNode,
+						makeFaultPercent(percentage).Numerator, forMeshGateway)
+					if policy != nil {
+						routeAction.Route.RequestMirrorPolicies = append([]*envoy_route_v3.RouteAction_RequestMirrorPolicy{policy},
+							routeAction.Route.RequestMirrorPolicies...)
+					}
+				}
 			}
 
 			route.Match = routeMatch
@@ -732,43 +758,7 @@ func makeRouteMatchForDiscoveryRoute(discoveryRoute *structs.DiscoveryRoute) *en
 	}
 
 	if len(match.HTTP.Header) > 0 {
-		em.Headers = make([]*envoy_route_v3.HeaderMatcher, 0, len(match.HTTP.Header))
-		for _, hdr := range match.HTTP.Header {
-			eh := &envoy_route_v3.HeaderMatcher{
-				Name: hdr.Name,
-			}
-
-			switch {
-			case hdr.Exact != "":
-				eh.HeaderMatchSpecifier = &envoy_route_v3.HeaderMatcher_ExactMatch{
-					ExactMatch: hdr.Exact,
-				}
-			case hdr.Regex != "":
-				eh.HeaderMatchSpecifier = &envoy_route_v3.HeaderMatcher_SafeRegexMatch{
-					SafeRegexMatch: makeEnvoyRegexMatch(hdr.Regex),
-				}
-			case hdr.Prefix != "":
-				eh.HeaderMatchSpecifier = &envoy_route_v3.HeaderMatcher_PrefixMatch{
-					PrefixMatch: hdr.Prefix,
-				}
-			case hdr.Suffix != "":
-				eh.HeaderMatchSpecifier = &envoy_route_v3.HeaderMatcher_SuffixMatch{
-					SuffixMatch: hdr.Suffix,
-				}
-			case hdr.Present:
-				eh.HeaderMatchSpecifier = &envoy_route_v3.HeaderMatcher_PresentMatch{
-					PresentMatch: true,
-				}
-			default:
-				continue // skip this impossible situation
-			}
-
-			if hdr.Invert {
-				eh.InvertMatch = true
-			}
-
-			em.Headers = append(em.Headers, eh)
-		}
+		em.Headers = makeHeaderMatchers(match.HTTP.Header)
 	}
 
 	if len(match.HTTP.Methods) > 0 {
@@ -823,6 +813,47 @@ func makeRouteMatchForDiscoveryRoute(discoveryRoute *structs.DiscoveryRoute) *en
 	return em
 }
 
+func makeHeaderMatchers(headers []structs.ServiceRouteHTTPMatchHeader) []*envoy_route_v3.HeaderMatcher {
+	matchers := make([]*envoy_route_v3.HeaderMatcher, 0, len(headers))
+	for _, hdr := range headers {
+		eh := &envoy_route_v3.HeaderMatcher{
+			Name: hdr.Name,
+		}
+
+		switch {
+		case hdr.Exact != "":
+			eh.HeaderMatchSpecifier = &envoy_route_v3.HeaderMatcher_ExactMatch{
+				ExactMatch: hdr.Exact,
+			}
+		case hdr.Regex != "":
+			eh.HeaderMatchSpecifier = &envoy_route_v3.HeaderMatcher_SafeRegexMatch{
+				SafeRegexMatch: makeEnvoyRegexMatch(hdr.Regex),
+			}
+		case hdr.Prefix != "":
+			eh.HeaderMatchSpecifier = &envoy_route_v3.HeaderMatcher_PrefixMatch{
+				PrefixMatch: hdr.Prefix,
+			}
+		case hdr.Suffix != "":
+			eh.HeaderMatchSpecifier = &envoy_route_v3.HeaderMatcher_SuffixMatch{
+				SuffixMatch: hdr.Suffix,
+			}
+		case hdr.Present:
+			eh.HeaderMatchSpecifier = &envoy_route_v3.HeaderMatcher_PresentMatch{
+				PresentMatch: true,
+			}
+		default:
+			continue // skip this impossible situation
+		}
+
+		if hdr.Invert {
+			eh.InvertMatch = true
+		}
+
+		matchers = append(matchers, eh)
+	}
+	return matchers
+}
+
 func makeDefaultRouteMatch() *envoy_route_v3.RouteMatch {
 	return &envoy_route_v3.RouteMatch{
 		PathSpecifier: &envoy_route_v3.RouteMatch_Prefix{
@@ -914,10 +945,87 @@ func (s *ResourceGenerator) makeRouteActionForSplitter(
 					TotalWeight: makeUint32Value(envoyWeightScale), // scaled up 100%
 				},
 			},
+			RequestMirrorPolicies: s.makeRequestMirrorPoliciesForSplits(upstreamsSnapshot, splits, chain, forMeshGateway),
 		},
 	}, nil
 }
 
+// makeRequestMirrorPoliciesForSplits returns the mirror policies of the splits.
+// Envoy only supports mirroring for a whole route, so the mirrored fraction of
+// each split is scaled by the weight of the split and the fractions of the
+// splits mirroring to the same cluster are added together. The mirrored
+// requests are sampled from all of the requests of the route, not only from
+// those sent to the split.
+func (s *ResourceGenerator) makeRequestMirrorPoliciesForSplits(
+	upstreamsSnapshot *proxycfg.ConfigSnapshotUpstreams,
+	splits []*structs.DiscoverySplit,
+	chain *structs.CompiledDiscoveryChain,
+	forMeshGateway bool,
+) []*envoy_route_v3.RouteAction_RequestMirrorPolicy {
+	var policies []*envoy_route_v3.RouteAction_RequestMirrorPolicy
+	byCluster := make(map[string]*envoy_route_v3.RouteAction_RequestMirrorPolicy)
+	for _, split := range splits {
+		if split.MirrorNode == "" || split.Definition == nil || split.Definition.Mirror == nil {
+			continue
+		}
+		percentage := float64(split.Definition.Mirror.Percentage)
+		if percentage <= 0 {
+			percentage = 100
+		}
+		// Both the weight and the percentage are in percent, so their product
+		// is the mirrored fraction of the requests in ten thousandths.
+		numerator := uint32(math.Round(float64(split.Weight) * percentage))
+
+		policy := s.makeRequestMirrorPolicy(upstreamsSnapshot, chain, split.MirrorNode, numerator, forMeshGateway)
+		if policy == nil {
+			continue
+		}
+		if existing, ok := byCluster[policy.Cluster]; ok {
+			fraction := existing.RuntimeFraction.DefaultValue
+			fraction.Numerator += numerator
+			if fraction.Numerator > 10000 {
+				fraction.Numerator = 10000
+			}
+			continue
+		}
+		byCluster[policy.Cluster] = policy
+		policies = append(policies, policy)
+	}
+	return policies
+}
+
+// makeRequestMirrorPolicy returns a policy mirroring the given fraction of the
+// requests, in ten thousandths, to the cluster of the mirror node. It returns
+// nil when the cluster of the mirror node is not known.
+func (s *ResourceGenerator) makeRequestMirrorPolicy(
+	upstreamsSnapshot *proxycfg.ConfigSnapshotUpstreams,
+	chain *structs.CompiledDiscoveryChain,
+	mirrorNode string,
+	numerator uint32,
+	forMeshGateway bool,
+) *envoy_route_v3.RouteAction_RequestMirrorPolicy {
+	node := chain.Nodes[mirrorNode]
+	if node == nil || node.Type != structs.DiscoveryGraphNodeTypeResolver {
+		return nil
+	}
+	clusterName := s.getTargetClusterName(upstreamsSnapshot, chain, node.Resolver.Target, forMeshGateway, false)
+	if clusterName == "" {
+		return nil
+	}
+	if numerator > 10000 {
+		numerator = 10000
+	}
+	return &envoy_route_v3.RouteAction_RequestMirrorPolicy{
+		Cluster: clusterName,
+		RuntimeFraction: &envoy_core_v3.RuntimeFractionalPercent{
+			DefaultValue: &envoy_type_v3.FractionalPercent{
+				Numerator:   numerator,
+				Denominator: envoy_type_v3.FractionalPercent_TEN_THOUSAND,
+			},
+		},
+	}
+}
+
 func injectLBToRouteAction(lb *structs.LoadBalancer, action *envoy_route_v3.RouteAction) error {
 	if lb == nil || !lb.IsHashBased() {
 		return nil
@@ -1019,6 +1127,73 @@ func injectHeaderManipToRoute(dest *structs.ServiceRouteDestination, r *envoy_ro
 	return nil
 }
 
+// injectFaultToRoute configures the fault filter of the HTTP connection manager
+// to inject the faults into the requests that match the route. The filter must
+// be added to the connection manager, see chainHasFaultInjection.
+func injectFaultToRoute(fi *structs.ServiceRouteFaultInjection, r *envoy_route_v3.Route) error {
+	fault := &envoy_fault_v3.HTTPFault{}
+	if fi.Delay != nil {
+		fault.Delay = &envoy_fault_common_v3.FaultDelay{
+			FaultDelaySecifier: &envoy_fault_common_v3.FaultDelay_FixedDelay{
+				FixedDelay: durationpb.New(fi.Delay.Duration),
+			},
+			Percentage: makeFaultPercent(fi.Delay.Percentage),
+		}
+	}
+	if fi.Abort != nil {
+		abort := &envoy_fault_v3.FaultAbort{
+			Percentage: makeFaultPercent(fi.Abort.Percentage),
+		}
+		if fi.Abort.GRPCStatus != 0 {
+			abort.ErrorType = &envoy_fault_v3.FaultAbort_GrpcStatus{GrpcStatus: fi.Abort.GRPCStatus}
+		} else {
+			abort.ErrorType = &envoy_fault_v3.FaultAbort_HttpStatus{HttpStatus: fi.Abort.HTTPStatus}
+		}
+		fault.Abort = abort
+	}
+	if len(fi.Header) > 0 {
+		fault.Headers = makeHeaderMatchers(fi.Header)
+	}
+
+	any, err := anypb.New(fault)
+	if err != nil {
+		return err
+	}
+	if r.TypedPerFilterConfig == nil {
+		r.TypedPerFilterConfig = make(map[string]*anypb.Any)
+	}
+	r.TypedPerFilterConfig[faultFilterName] = any
+	return nil
+}
+
+// makeFaultPercent converts a percentage of requests with a resolution of
+// 0.01% to the fraction used by Envoy.
+func makeFaultPercent(percentage float32) *envoy_type_v3.FractionalPercent {
+	return &envoy_type_v3.FractionalPercent{
+		Numerator:   uint32(math.Round(float64(percentage) * 100)),
+		Denominator: envoy_type_v3.FractionalPercent_TEN_THOUSAND,
+	}
+}
+
+// chainHasFaultInjection returns true if any route of the chain injects faults,
+// in which case the fault filter must be added to the HTTP connection manager.
+func chainHasFaultInjection(chain *structs.CompiledDiscoveryChain) bool {
+	if chain == nil {
+		return false
+	}
+	for _, node := range chain.Nodes {
+		if node.Type != structs.DiscoveryGraphNodeTypeRouter {
+			continue
+		}
+		for _, route := range node.Routes {
+			if route.Definition.Destination != nil && route.Definition.Destination.FaultInjection != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func injectHeaderManipToVirtualHost(dest *structs.IngressService, vh *envoy_route_v3.VirtualHost) error {
 	if !dest.RequestHeaders.IsZero() {
 		vh.RequestHeadersToAdd = append(
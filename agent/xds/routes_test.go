@@ -72,6 +72,18 @@ func makeRouteDiscoChainTests(enterprise bool) []routeTestCase {
 				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "chain-and-router", enterprise, nil, nil)
 			},
 		},
+		{
+			name: "connect-proxy-with-router-with-fault-injection",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "router-with-fault-injection", enterprise, nil, nil)
+			},
+		},
+		{
+			name: "connect-proxy-with-router-with-mirror",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "router-with-mirror", enterprise, nil, nil)
+			},
+		},
 		{
 			name: "connect-proxy-lb-in-resolver",
 			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
@@ -147,6 +159,13 @@ func TestRoutesFromSnapshot(t *testing.T) {
 					"chain-and-router", nil, nil, nil)
 			},
 		},
+		{
+			name: "ingress-with-router-with-fault-injection",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotIngressGateway(t, true, "http",
+					"router-with-fault-injection", nil, nil, nil)
+			},
+		},
 		{
 			name: "ingress-lb-in-resolver",
 			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
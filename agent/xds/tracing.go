@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package xds
+
+import (
+	"fmt"
+
+	envoy_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_trace_v3 "github.com/envoyproxy/go-control-plane/envoy/config/trace/v3"
+	envoy_http_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_tracing_v3 "github.com/envoyproxy/go-control-plane/envoy/type/tracing/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/hashicorp/consul/agent/proxycfg"
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+const defaultZipkinCollectorEndpoint = "/api/v2/spans"
+
+// makeTracing returns the tracing configuration of the HTTP connection managers of
+// the proxy. The listenerTracingJSON escape hatch takes precedence over the tracing
+// config of the proxy-defaults and service-defaults. Errors are logged rather than
+// returned so that a tracing misconfiguration does not break traffic.
+func (s *ResourceGenerator) makeTracing(cfgSnap *proxycfg.ConfigSnapshot, listenerTracingJSON string) *envoy_http_v3.HttpConnectionManager_Tracing {
+	if listenerTracingJSON != "" {
+		tracing, err := makeTracingFromUserConfig(listenerTracingJSON)
+		if err != nil {
+			s.Logger.Warn("failed to parse ListenerTracingJSON config", "error", err)
+		}
+		return tracing
+	}
+
+	if cfgSnap.Proxy.Tracing == nil {
+		return nil
+	}
+	tracing, err := s.makeTracingFromConfig(cfgSnap, cfgSnap.Proxy.Tracing)
+	if err != nil {
+		s.Logger.Warn("failed to make tracing config", "error", err)
+		return nil
+	}
+	return tracing
+}
+
+func (s *ResourceGenerator) makeTracingFromConfig(cfgSnap *proxycfg.ConfigSnapshot, cfg *structs.TracingConfig) (*envoy_http_v3.HttpConnectionManager_Tracing, error) {
+	cluster, protocol, err := s.collectorCluster(cfgSnap, "tracing", cfg.ServiceName, cfg.ServiceNamespace, cfg.ServicePartition)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		providerName string
+		providerCfg  proto.Message
+	)
+	switch cfg.Provider {
+	case structs.OpenTelemetryTracingProvider:
+		if !isGRPCProtocol(protocol) {
+			return nil, fmt.Errorf("tracing service %q must use the grpc or http2 protocol, got %q", cfg.ServiceName, protocol)
+		}
+		providerName = "envoy.tracers.opentelemetry"
+		providerCfg = &envoy_trace_v3.OpenTelemetryConfig{
+			GrpcService: &envoy_core_v3.GrpcService{
+				TargetSpecifier: &envoy_core_v3.GrpcService_EnvoyGrpc_{
+					EnvoyGrpc: &envoy_core_v3.GrpcService_EnvoyGrpc{
+						ClusterName: cluster,
+					},
+				},
+			},
+		}
+	case structs.ZipkinTracingProvider:
+		if !structs.IsProtocolHTTPLike(protocol) {
+			return nil, fmt.Errorf("tracing service %q must use an HTTP protocol, got %q", cfg.ServiceName, protocol)
+		}
+		endpoint := cfg.CollectorEndpoint
+		if endpoint == "" {
+			endpoint = defaultZipkinCollectorEndpoint
+		}
+		providerName = "envoy.tracers.zipkin"
+		providerCfg = &envoy_trace_v3.ZipkinConfig{
+			CollectorCluster:         cluster,
+			CollectorEndpoint:        endpoint,
+			CollectorEndpointVersion: envoy_trace_v3.ZipkinConfig_HTTP_JSON,
+		}
+	default:
+		return nil, fmt.Errorf("invalid tracing provider: %s", cfg.Provider)
+	}
+
+	typedCfg, err := anypb.New(providerCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	tracing := &envoy_http_v3.HttpConnectionManager_Tracing{
+		ClientSampling:  makeTracingPercent(cfg.ClientSampling),
+		RandomSampling:  makeTracingPercent(cfg.RandomSampling),
+		OverallSampling: makeTracingPercent(cfg.OverallSampling),
+		Provider: &envoy_trace_v3.Tracing_Http{
+			Name:       providerName,
+			ConfigType: &envoy_trace_v3.Tracing_Http_TypedConfig{TypedConfig: typedCfg},
+		},
+	}
+	for _, tag := range cfg.CustomTags {
+		tracing.CustomTags = append(tracing.CustomTags, makeTracingCustomTag(tag))
+	}
+	return tracing, nil
+}
+
+func makeTracingPercent(sampling *float64) *envoy_type_v3.Percent {
+	if sampling == nil {
+		return nil
+	}
+	return &envoy_type_v3.Percent{Value: *sampling}
+}
+
+func makeTracingCustomTag(tag structs.TracingCustomTag) *envoy_tracing_v3.CustomTag {
+	t := &envoy_tracing_v3.CustomTag{Tag: tag.Tag}
+	switch {
+	case tag.Literal != "":
+		t.Type = &envoy_tracing_v3.CustomTag_Literal_{
+			Literal: &envoy_tracing_v3.CustomTag_Literal{Value: tag.Literal},
+		}
+	case tag.Environment != "":
+		t.Type = &envoy_tracing_v3.CustomTag_Environment_{
+			Environment: &envoy_tracing_v3.CustomTag_Environment{Name: tag.Environment, DefaultValue: tag.DefaultValue},
+		}
+	case tag.RequestHeader != "":
+		t.Type = &envoy_tracing_v3.CustomTag_RequestHeader{
+			RequestHeader: &envoy_tracing_v3.CustomTag_Header{Name: tag.RequestHeader, DefaultValue: tag.DefaultValue},
+		}
+	}
+	return t
+}
@@ -354,9 +354,13 @@ type AgentServiceCheck struct {
 	H2PingUseTLS           bool                `json:",omitempty"`
 	AliasNode              string              `json:",omitempty"`
 	AliasService           string              `json:",omitempty"`
+	AliasDatacenter        string              `json:",omitempty"`
+	AliasPeer              string              `json:",omitempty"`
 	SuccessBeforePassing   int                 `json:",omitempty"`
 	FailuresBeforeWarning  int                 `json:",omitempty"`
 	FailuresBeforeCritical int                 `json:",omitempty"`
+	DynamicWeight          bool                `json:",omitempty"`
+	DependsOn              string              `json:",omitempty"`
 
 	// In Consul 0.7 and later, checks that are associated with a service
 	// may also contain this optional DeregisterCriticalServiceAfter field,
@@ -414,6 +418,11 @@ type AgentAuthorizeParams struct {
 	Target           string
 	ClientCertURI    string
 	ClientCertSerial string
+
+	// HTTP optionally describes the HTTP request being authorized. If it is
+	// set, the L7 permissions of a matching intention are evaluated against
+	// it instead of denying the request.
+	HTTP *IntentionCheckHTTPRequest `json:",omitempty"`
 }
 
 // AgentAuthorize is the response structure for Connect authorization.
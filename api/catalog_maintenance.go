@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package api
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// MaintenanceWindow is a scheduled period during which a node, or a single
+// service on that node, is placed into maintenance mode by its agent.
+type MaintenanceWindow struct {
+	// ID uniquely identifies the window. It is generated by the servers if
+	// left empty when the window is created.
+	ID string
+
+	// Node is the node the window applies to.
+	Node string
+
+	// ServiceID, if set, restricts the window to a single service instance
+	// on Node. Otherwise the whole node is placed into maintenance.
+	ServiceID string `json:",omitempty"`
+
+	// Start is when the first occurrence of the window opens.
+	Start time.Time
+
+	// Duration is how long each occurrence of the window stays open.
+	Duration time.Duration
+
+	// Recurrence, if non-zero, is the interval between the start of
+	// consecutive occurrences. A zero value means the window occurs once.
+	Recurrence time.Duration `json:",omitempty"`
+
+	// Reason is included in the notes of the maintenance check.
+	Reason string `json:",omitempty"`
+
+	Partition string `json:",omitempty"`
+	Namespace string `json:",omitempty"`
+
+	CreateIndex uint64
+	ModifyIndex uint64
+}
+
+func (w *MaintenanceWindow) MarshalJSON() ([]byte, error) {
+	type Alias MaintenanceWindow
+	exported := &struct {
+		Duration   string
+		Recurrence string `json:",omitempty"`
+		*Alias
+	}{
+		Duration: w.Duration.String(),
+		Alias:    (*Alias)(w),
+	}
+	if w.Recurrence != 0 {
+		exported.Recurrence = w.Recurrence.String()
+	}
+	return json.Marshal(exported)
+}
+
+func (w *MaintenanceWindow) UnmarshalJSON(data []byte) error {
+	type Alias MaintenanceWindow
+	aux := &struct {
+		Duration   string
+		Recurrence string
+		*Alias
+	}{
+		Alias: (*Alias)(w),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	var err error
+	if aux.Duration != "" {
+		if w.Duration, err = time.ParseDuration(aux.Duration); err != nil {
+			return err
+		}
+	}
+	if aux.Recurrence != "" {
+		if w.Recurrence, err = time.ParseDuration(aux.Recurrence); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MaintenanceWindows is used to list the scheduled maintenance windows. If
+// node is not empty, only the windows for that node are returned.
+func (c *Catalog) MaintenanceWindows(node string, q *QueryOptions) ([]*MaintenanceWindow, *QueryMeta, error) {
+	r := c.c.newRequest("GET", "/v1/catalog/maintenance")
+	r.setQueryOptions(q)
+	if node != "" {
+		r.params.Set("node", node)
+	}
+	rtt, resp, err := c.c.doRequest(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer closeResponseBody(resp)
+	if err := requireOK(resp); err != nil {
+		return nil, nil, err
+	}
+
+	qm := &QueryMeta{}
+	parseQueryMeta(resp, qm)
+	qm.RequestTime = rtt
+
+	var out []*MaintenanceWindow
+	if err := decodeBody(resp, &out); err != nil {
+		return nil, nil, err
+	}
+	return out, qm, nil
+}
+
+// MaintenanceWindowSet is used to create or update a scheduled maintenance
+// window. The ID of the window is returned.
+func (c *Catalog) MaintenanceWindowSet(window *MaintenanceWindow, q *WriteOptions) (string, *WriteMeta, error) {
+	r := c.c.newRequest("PUT", "/v1/catalog/maintenance")
+	r.setWriteOptions(q)
+	r.obj = window
+	rtt, resp, err := c.c.doRequest(r)
+	if err != nil {
+		return "", nil, err
+	}
+	defer closeResponseBody(resp)
+	if err := requireOK(resp); err != nil {
+		return "", nil, err
+	}
+
+	wm := &WriteMeta{}
+	wm.RequestTime = rtt
+
+	var out struct{ ID string }
+	if err := decodeBody(resp, &out); err != nil {
+		return "", nil, err
+	}
+	return out.ID, wm, nil
+}
+
+// MaintenanceWindowDelete is used to delete a scheduled maintenance window.
+func (c *Catalog) MaintenanceWindowDelete(id string, q *WriteOptions) (*WriteMeta, error) {
+	r := c.c.newRequest("DELETE", "/v1/catalog/maintenance/"+id)
+	r.setWriteOptions(q)
+	rtt, resp, err := c.c.doRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	defer closeResponseBody(resp)
+	if err := requireOK(resp); err != nil {
+		return nil, err
+	}
+
+	wm := &WriteMeta{}
+	wm.RequestTime = rtt
+	return wm, nil
+}
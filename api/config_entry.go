@@ -43,6 +43,7 @@ const (
 	BuiltinLuaExtension            string = "builtin/lua"
 	BuiltinLocalRatelimitExtension string = "builtin/http/localratelimit"
 	BuiltinWasmExtension           string = "builtin/wasm"
+	BuiltinExtAuthzExtension       string = "builtin/ext-authz"
 )
 
 type ConfigEntry interface {
@@ -164,10 +165,12 @@ type ExposePath struct {
 type LogSinkType string
 
 const (
-	DefaultLogSinkType LogSinkType = ""
-	FileLogSinkType    LogSinkType = "file"
-	StdErrLogSinkType  LogSinkType = "stderr"
-	StdOutLogSinkType  LogSinkType = "stdout"
+	DefaultLogSinkType       LogSinkType = ""
+	FileLogSinkType          LogSinkType = "file"
+	StdErrLogSinkType        LogSinkType = "stderr"
+	StdOutLogSinkType        LogSinkType = "stdout"
+	GRPCLogSinkType          LogSinkType = "grpc"
+	OpenTelemetryLogSinkType LogSinkType = "opentelemetry"
 )
 
 // AccessLogsConfig contains the associated default settings for all Envoy instances within the datacenter or partition
@@ -179,18 +182,74 @@ type AccessLogsConfig struct {
 	// have a matching listener filter.
 	DisableListenerLogs bool `json:",omitempty" alias:"disable_listener_logs"`
 
-	// Type selects the output for logs: "file", "stderr". "stdout"
+	// Type selects the output for logs: "file", "stderr", "stdout", "grpc", "opentelemetry"
 	Type LogSinkType `json:",omitempty" alias:"type"`
 
 	// Path is the output file to write logs
 	Path string `json:",omitempty" alias:"path"`
 
+	// ServiceName, ServiceNamespace and ServicePartition identify the upstream service
+	// that receives the logs for the "grpc" and "opentelemetry" types.
+	ServiceName      string `json:",omitempty" alias:"service_name"`
+	ServiceNamespace string `json:",omitempty" alias:"service_namespace"`
+	ServicePartition string `json:",omitempty" alias:"service_partition"`
+
+	// LogName identifies the log stream to the receiving service for the
+	// "grpc" and "opentelemetry" types.
+	LogName string `json:",omitempty" alias:"log_name"`
+
 	// The presence of one format string or the other implies the access log string encoding.
 	// Defining Both is invalid.
 	JSONFormat string `json:",omitempty" alias:"json_format"`
 	TextFormat string `json:",omitempty" alias:"text_format"`
 }
 
+type TracingProvider string
+
+const (
+	OpenTelemetryTracingProvider TracingProvider = "opentelemetry"
+	ZipkinTracingProvider        TracingProvider = "zipkin"
+)
+
+// TracingConfig configures Envoy to report spans of the HTTP requests that pass
+// through the proxy to a tracing collector.
+type TracingConfig struct {
+	// Provider selects the tracer used by Envoy: "opentelemetry" or "zipkin".
+	Provider TracingProvider `json:",omitempty" alias:"provider"`
+
+	// ServiceName, ServiceNamespace and ServicePartition identify the upstream service
+	// of the tracing collector.
+	ServiceName      string `json:",omitempty" alias:"service_name"`
+	ServiceNamespace string `json:",omitempty" alias:"service_namespace"`
+	ServicePartition string `json:",omitempty" alias:"service_partition"`
+
+	// CollectorEndpoint is the API endpoint of the collector that spans are sent to.
+	// It is only valid for the "zipkin" provider and defaults to "/api/v2/spans".
+	CollectorEndpoint string `json:",omitempty" alias:"collector_endpoint"`
+
+	// ClientSampling, RandomSampling and OverallSampling are the percentages of
+	// requests that are traced when the client forces tracing, when the request
+	// is not already traced, and overall. Envoy traces 100% of requests by default.
+	ClientSampling  *float64 `json:",omitempty" alias:"client_sampling"`
+	RandomSampling  *float64 `json:",omitempty" alias:"random_sampling"`
+	OverallSampling *float64 `json:",omitempty" alias:"overall_sampling"`
+
+	// CustomTags are added to every span.
+	CustomTags []TracingCustomTag `json:",omitempty" alias:"custom_tags"`
+}
+
+// TracingCustomTag is a tag added to spans. Its value is exactly one of a
+// literal, an environment variable of the proxy, or a request header.
+type TracingCustomTag struct {
+	Tag           string `json:",omitempty" alias:"tag"`
+	Literal       string `json:",omitempty" alias:"literal"`
+	Environment   string `json:",omitempty" alias:"environment"`
+	RequestHeader string `json:",omitempty" alias:"request_header"`
+
+	// DefaultValue is used when the environment variable or request header is not set.
+	DefaultValue string `json:",omitempty" alias:"default_value"`
+}
+
 type UpstreamConfiguration struct {
 	// Overrides is a slice of per-service configuration. The name field is
 	// required.
@@ -326,6 +385,7 @@ type ServiceConfigEntry struct {
 	LocalRequestTimeoutMs     int                     `json:",omitempty" alias:"local_request_timeout_ms"`
 	BalanceInboundConnections string                  `json:",omitempty" alias:"balance_inbound_connections"`
 	EnvoyExtensions           []EnvoyExtension        `json:",omitempty" alias:"envoy_extensions"`
+	Tracing                   *TracingConfig          `json:",omitempty"`
 	Meta                      map[string]string       `json:",omitempty"`
 	CreateIndex               uint64
 	ModifyIndex               uint64
@@ -354,6 +414,7 @@ type ProxyConfigEntry struct {
 	EnvoyExtensions      []EnvoyExtension                     `json:",omitempty" alias:"envoy_extensions"`
 	FailoverPolicy       *ServiceResolverFailoverPolicy       `json:",omitempty" alias:"failover_policy"`
 	PrioritizeByLocality *ServiceResolverPrioritizeByLocality `json:",omitempty" alias:"prioritize_by_locality"`
+	Tracing              *TracingConfig                       `json:",omitempty"`
 
 	Meta        map[string]string `json:",omitempty"`
 	CreateIndex uint64
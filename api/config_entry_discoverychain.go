@@ -66,19 +66,21 @@ type ServiceRouteHTTPMatchQueryParam struct {
 }
 
 type ServiceRouteDestination struct {
-	Service               string               `json:",omitempty"`
-	ServiceSubset         string               `json:",omitempty" alias:"service_subset"`
-	Namespace             string               `json:",omitempty"`
-	Partition             string               `json:",omitempty"`
-	PrefixRewrite         string               `json:",omitempty" alias:"prefix_rewrite"`
-	RequestTimeout        time.Duration        `json:",omitempty" alias:"request_timeout"`
-	IdleTimeout           time.Duration        `json:",omitempty" alias:"idle_timeout"`
-	NumRetries            uint32               `json:",omitempty" alias:"num_retries"`
-	RetryOnConnectFailure bool                 `json:",omitempty" alias:"retry_on_connect_failure"`
-	RetryOnStatusCodes    []uint32             `json:",omitempty" alias:"retry_on_status_codes"`
-	RetryOn               []string             `json:",omitempty" alias:"retry_on"`
-	RequestHeaders        *HTTPHeaderModifiers `json:",omitempty" alias:"request_headers"`
-	ResponseHeaders       *HTTPHeaderModifiers `json:",omitempty" alias:"response_headers"`
+	Service               string                      `json:",omitempty"`
+	ServiceSubset         string                      `json:",omitempty" alias:"service_subset"`
+	Namespace             string                      `json:",omitempty"`
+	Partition             string                      `json:",omitempty"`
+	PrefixRewrite         string                      `json:",omitempty" alias:"prefix_rewrite"`
+	RequestTimeout        time.Duration               `json:",omitempty" alias:"request_timeout"`
+	IdleTimeout           time.Duration               `json:",omitempty" alias:"idle_timeout"`
+	NumRetries            uint32                      `json:",omitempty" alias:"num_retries"`
+	RetryOnConnectFailure bool                        `json:",omitempty" alias:"retry_on_connect_failure"`
+	RetryOnStatusCodes    []uint32                    `json:",omitempty" alias:"retry_on_status_codes"`
+	RetryOn               []string                    `json:",omitempty" alias:"retry_on"`
+	RequestHeaders        *HTTPHeaderModifiers        `json:",omitempty" alias:"request_headers"`
+	ResponseHeaders       *HTTPHeaderModifiers        `json:",omitempty" alias:"response_headers"`
+	FaultInjection        *ServiceRouteFaultInjection `json:",omitempty" alias:"fault_injection"`
+	Mirror                *MirrorPolicy               `json:",omitempty"`
 }
 
 func (e *ServiceRouteDestination) MarshalJSON() ([]byte, error) {
@@ -128,6 +130,59 @@ func (e *ServiceRouteDestination) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+type ServiceRouteFaultInjection struct {
+	Delay  *ServiceRouteFaultDelay       `json:",omitempty"`
+	Abort  *ServiceRouteFaultAbort       `json:",omitempty"`
+	Header []ServiceRouteHTTPMatchHeader `json:",omitempty"`
+}
+
+type ServiceRouteFaultDelay struct {
+	Duration   time.Duration `json:",omitempty"`
+	Percentage float32       `json:",omitempty"`
+}
+
+func (d *ServiceRouteFaultDelay) MarshalJSON() ([]byte, error) {
+	type Alias ServiceRouteFaultDelay
+	exported := &struct {
+		Duration string `json:",omitempty"`
+		*Alias
+	}{
+		Duration: d.Duration.String(),
+		Alias:    (*Alias)(d),
+	}
+	if d.Duration == 0 {
+		exported.Duration = ""
+	}
+
+	return json.Marshal(exported)
+}
+
+func (d *ServiceRouteFaultDelay) UnmarshalJSON(data []byte) error {
+	type Alias ServiceRouteFaultDelay
+	aux := &struct {
+		Duration string
+		*Alias
+	}{
+		Alias: (*Alias)(d),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if aux.Duration != "" {
+		if d.Duration, err = time.ParseDuration(aux.Duration); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type ServiceRouteFaultAbort struct {
+	HTTPStatus uint32  `json:",omitempty" alias:"http_status"`
+	GRPCStatus uint32  `json:",omitempty" alias:"grpc_status"`
+	Percentage float32 `json:",omitempty"`
+}
+
 type ServiceSplitterConfigEntry struct {
 	Kind      string
 	Name      string
@@ -157,6 +212,18 @@ type ServiceSplit struct {
 	Partition       string               `json:",omitempty"`
 	RequestHeaders  *HTTPHeaderModifiers `json:",omitempty" alias:"request_headers"`
 	ResponseHeaders *HTTPHeaderModifiers `json:",omitempty" alias:"response_headers"`
+	Mirror          *MirrorPolicy        `json:",omitempty"`
+}
+
+// MirrorPolicy sends a copy of a percentage of requests to another service.
+// The responses of the mirror are ignored.
+type MirrorPolicy struct {
+	Service       string  `json:",omitempty"`
+	ServiceSubset string  `json:",omitempty" alias:"service_subset"`
+	Namespace     string  `json:",omitempty"`
+	Partition     string  `json:",omitempty"`
+	Peer          string  `json:",omitempty"`
+	Percentage    float32 `json:",omitempty"`
 }
 
 type ServiceResolverConfigEntry struct {
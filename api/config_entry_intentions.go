@@ -13,6 +13,7 @@ type ServiceIntentionsConfigEntry struct {
 
 	Sources []*SourceIntention
 	JWT     *IntentionJWTRequirement `json:",omitempty"`
+	Mode    IntentionMode            `json:",omitempty"`
 
 	Meta map[string]string `json:",omitempty"`
 
@@ -30,7 +31,8 @@ type SourceIntention struct {
 	Permissions   []*IntentionPermission `json:",omitempty"`
 	Precedence    int
 	Type          IntentionSourceType
-	Description   string `json:",omitempty"`
+	Description   string        `json:",omitempty"`
+	Mode          IntentionMode `json:",omitempty"`
 
 	LegacyID         string            `json:",omitempty" alias:"legacy_id"`
 	LegacyMeta       map[string]string `json:",omitempty" alias:"legacy_meta"`
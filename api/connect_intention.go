@@ -60,6 +60,9 @@ type Intention struct {
 	// service-intentions config entry directly.
 	Permissions []*IntentionPermission `json:",omitempty"`
 
+	// Mode is whether the intention is enforced or only audited.
+	Mode IntentionMode `json:",omitempty"`
+
 	// DefaultAddr is not used.
 	// Deprecated: DefaultAddr is not used and may be removed in a future version.
 	DefaultAddr string `json:",omitempty"`
@@ -143,6 +146,16 @@ const (
 	IntentionActionDeny  IntentionAction = "deny"
 )
 
+// IntentionMode is whether an intention is enforced or only audited.
+// Audited intentions are not enforced, but the proxies count and log the
+// connections and requests that they would deny.
+type IntentionMode string
+
+const (
+	IntentionModeEnforce IntentionMode = "enforce"
+	IntentionModeAudit   IntentionMode = "audit"
+)
+
 // IntentionSourceType is the type of the source within an intention.
 type IntentionSourceType string
 
@@ -177,6 +190,52 @@ type IntentionCheck struct {
 
 	// SourceType is the type of the value for the source.
 	SourceType IntentionSourceType
+
+	// HTTP optionally describes an HTTP request to evaluate against the L7
+	// permissions of the matching intention. If it is nil, intentions with
+	// L7 permissions deny the connection.
+	HTTP *IntentionCheckHTTPRequest `json:",omitempty"`
+}
+
+// IntentionCheckHTTPRequest is a simulated HTTP request used to evaluate
+// L7 intention permissions.
+type IntentionCheckHTTPRequest struct {
+	Method string `json:",omitempty"`
+	Path   string `json:",omitempty"`
+
+	// Header maps header names to their values. Multiple values should be
+	// joined with a comma.
+	Header map[string]string `json:",omitempty"`
+}
+
+// IntentionCheckResult is the result of an intention check.
+type IntentionCheckResult struct {
+	Allowed bool
+
+	// Explanation describes how the decision was reached. It is only set
+	// when the token used for the request may read the matching intention.
+	Explanation *IntentionCheckExplanation `json:",omitempty"`
+}
+
+// IntentionCheckExplanation describes which intention and permission
+// decided the outcome of an intention check.
+type IntentionCheckExplanation struct {
+	// Intention is the highest precedence intention matching the source and
+	// destination. It is nil if the default intention policy applied.
+	Intention *Intention `json:",omitempty"`
+
+	// Permission is the first L7 permission of Intention that matched the
+	// request, and PermissionIndex is its position in Intention.Permissions.
+	// PermissionIndex is only meaningful if Permission is set.
+	Permission      *IntentionPermission `json:",omitempty"`
+	PermissionIndex int
+
+	// DefaultAllow is the default intention policy that applies when no
+	// intention or permission matches.
+	DefaultAllow bool
+
+	// Reason is a human-readable summary of the decision.
+	Reason string
 }
 
 // Intentions returns the list of intentions.
@@ -348,6 +407,16 @@ func (h *Connect) IntentionMatch(args *IntentionMatch, q *QueryOptions) (map[str
 // IntentionCheck returns whether a given source/destination would be allowed
 // or not given the current set of intentions and the configuration of Consul.
 func (h *Connect) IntentionCheck(args *IntentionCheck, q *QueryOptions) (bool, *QueryMeta, error) {
+	out, qm, err := h.IntentionCheckExplain(args, q)
+	if err != nil {
+		return false, nil, err
+	}
+	return out.Allowed, qm, nil
+}
+
+// IntentionCheckExplain is like IntentionCheck but also returns an
+// explanation of which intention and L7 permission decided the outcome.
+func (h *Connect) IntentionCheckExplain(args *IntentionCheck, q *QueryOptions) (*IntentionCheckResult, *QueryMeta, error) {
 	r := h.c.newRequest("GET", "/v1/connect/intentions/check")
 	r.setQueryOptions(q)
 	r.params.Set("source", args.Source)
@@ -355,24 +424,35 @@ func (h *Connect) IntentionCheck(args *IntentionCheck, q *QueryOptions) (bool, *
 	if args.SourceType != "" {
 		r.params.Set("source-type", string(args.SourceType))
 	}
+	if args.HTTP != nil {
+		if args.HTTP.Method != "" {
+			r.params.Set("http-method", args.HTTP.Method)
+		}
+		if args.HTTP.Path != "" {
+			r.params.Set("http-path", args.HTTP.Path)
+		}
+		for name, value := range args.HTTP.Header {
+			r.params.Add("http-header", name+":"+value)
+		}
+	}
 	rtt, resp, err := h.c.doRequest(r)
 	if err != nil {
-		return false, nil, err
+		return nil, nil, err
 	}
 	defer closeResponseBody(resp)
 	if err := requireOK(resp); err != nil {
-		return false, nil, err
+		return nil, nil, err
 	}
 
 	qm := &QueryMeta{}
 	parseQueryMeta(resp, qm)
 	qm.RequestTime = rtt
 
-	var out struct{ Allowed bool }
+	var out IntentionCheckResult
 	if err := decodeBody(resp, &out); err != nil {
-		return false, nil, err
+		return nil, nil, err
 	}
-	return out.Allowed, qm, nil
+	return &out, qm, nil
 }
 
 // IntentionUpsert will update an existing intention. The Source & Destination parameters
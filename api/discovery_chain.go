@@ -236,7 +236,9 @@ type DiscoveryTarget struct {
 	Service       string
 	ServiceSubset string
 	Namespace     string
+	Partition     string
 	Datacenter    string
+	Peer          string
 
 	MeshGateway    MeshGatewayConfig
 	Subset         ServiceResolverSubset
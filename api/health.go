@@ -50,6 +50,8 @@ type HealthCheck struct {
 	Partition   string `json:",omitempty"`
 	ExposedPort int
 	PeerName    string `json:",omitempty"`
+	DependsOn   string `json:",omitempty"`
+	Suppressed  bool   `json:",omitempty"`
 
 	Definition HealthCheckDefinition
 
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package api
+
+import (
+	"strconv"
+	"time"
+)
+
+// DNSSECKey is a key the Consul DNS interface signs answers with.
+type DNSSECKey struct {
+	// KeyTag identifies the key in DS and RRSIG records.
+	KeyTag uint16
+
+	// Algorithm is the DNSSEC algorithm number of the key.
+	Algorithm uint8
+
+	// Flags are the DNSKEY flags of the key.
+	Flags uint16
+
+	// PublicKey is the base64 encoded public key, as in a DNSKEY record.
+	PublicKey string
+
+	// Active is set on the key answers are signed with. Inactive keys are
+	// still published until they are removed.
+	Active bool
+
+	// CreateTime is when the key was generated.
+	CreateTime time.Time
+
+	// ActivateTime is when a key created by a rotation becomes the active
+	// key. It is zero once the key has been activated.
+	ActivateTime time.Time `json:",omitempty"`
+}
+
+// DNSSECKeys returns the DNSSEC keys of the cluster. Private keys are never
+// returned.
+func (op *Operator) DNSSECKeys(q *QueryOptions) ([]*DNSSECKey, *QueryMeta, error) {
+	r := op.c.newRequest("GET", "/v1/operator/dnssec/keys")
+	r.setQueryOptions(q)
+	rtt, resp, err := op.c.doRequest(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer closeResponseBody(resp)
+	if err := requireOK(resp); err != nil {
+		return nil, nil, err
+	}
+
+	qm := &QueryMeta{}
+	if err := parseQueryMeta(resp, qm); err != nil {
+		return nil, nil, err
+	}
+	qm.RequestTime = rtt
+
+	var out []*DNSSECKey
+	if err := decodeBody(resp, &out); err != nil {
+		return nil, nil, err
+	}
+	return out, qm, nil
+}
+
+// DNSSECRotate generates a new DNSSEC key and makes it the active key. The
+// previously active key stays published until it is removed.
+func (op *Operator) DNSSECRotate(q *WriteOptions) (*DNSSECKey, error) {
+	r := op.c.newRequest("PUT", "/v1/operator/dnssec/rotate")
+	r.setWriteOptions(q)
+	_, resp, err := op.c.doRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	defer closeResponseBody(resp)
+	if err := requireOK(resp); err != nil {
+		return nil, err
+	}
+
+	var out DNSSECKey
+	if err := decodeBody(resp, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DNSSECRemoveKey removes an inactive DNSSEC key.
+func (op *Operator) DNSSECRemoveKey(keyTag uint16, q *WriteOptions) error {
+	r := op.c.newRequest("DELETE", "/v1/operator/dnssec/keys")
+	r.setWriteOptions(q)
+
+	r.params.Set("key-tag", strconv.Itoa(int(keyTag)))
+
+	_, resp, err := op.c.doRequest(r)
+	if err != nil {
+		return err
+	}
+	defer closeResponseBody(resp)
+	if err := requireOK(resp); err != nil {
+		return err
+	}
+	return nil
+}
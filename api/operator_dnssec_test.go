@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPI_OperatorDNSSEC(t *testing.T) {
+	t.Parallel()
+	c, s := makeClient(t)
+	defer s.Stop()
+
+	operator := c.Operator()
+
+	first, err := operator.DNSSECRotate(nil)
+	require.NoError(t, err)
+	require.True(t, first.Active)
+	require.NotEmpty(t, first.PublicKey)
+
+	// Further keys are published before they become active.
+	second, err := operator.DNSSECRotate(nil)
+	require.NoError(t, err)
+	require.NotEqual(t, first.KeyTag, second.KeyTag)
+	require.False(t, second.Active)
+	require.True(t, second.ActivateTime.After(second.CreateTime))
+
+	keys, qm, err := operator.DNSSECKeys(nil)
+	require.NoError(t, err)
+	require.NotZero(t, qm.LastIndex)
+	require.Len(t, keys, 2)
+	require.Equal(t, first.KeyTag, keys[0].KeyTag)
+	require.True(t, keys[0].Active)
+	require.Equal(t, second.KeyTag, keys[1].KeyTag)
+	require.False(t, keys[1].Active)
+	require.Equal(t, second.ActivateTime, keys[1].ActivateTime)
+
+	// The active key cannot be removed.
+	require.Error(t, operator.DNSSECRemoveKey(first.KeyTag, nil))
+
+	// Removing the pending key cancels the rotation.
+	require.NoError(t, operator.DNSSECRemoveKey(second.KeyTag, nil))
+	keys, _, err = operator.DNSSECKeys(nil)
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	require.Equal(t, first.KeyTag, keys[0].KeyTag)
+}
@@ -189,6 +189,11 @@ func checksWatch(params map[string]interface{}) (WatcherFunc, error) {
 		state = "any"
 	}
 
+	excludeSuppressed := false
+	if err := assignValueBool(params, "exclude_suppressed", &excludeSuppressed); err != nil {
+		return nil, err
+	}
+
 	fn := func(p *Plan) (BlockingParamVal, interface{}, error) {
 		health := p.client.Health()
 		opts := makeQueryOptionsWithContext(p, stale)
@@ -204,6 +209,15 @@ func checksWatch(params map[string]interface{}) (WatcherFunc, error) {
 		if err != nil {
 			return nil, nil, err
 		}
+		if excludeSuppressed {
+			filtered := checks[:0]
+			for _, check := range checks {
+				if !check.Suppressed {
+					filtered = append(filtered, check)
+				}
+			}
+			checks = filtered
+		}
 		return WaitIndexVal(meta.LastIndex), checks, err
 	}
 	return fn, nil
@@ -692,6 +692,84 @@ func TestChecksWatch_State(t *testing.T) {
 	}
 }
 
+func TestChecksWatch_ExcludeSuppressed(t *testing.T) {
+	t.Parallel()
+	c, s := makeClient(t)
+	defer s.Stop()
+
+	s.WaitForSerfCheck(t)
+
+	var (
+		wakeups  [][]*api.HealthCheck
+		notifyCh = make(chan struct{})
+	)
+
+	plan := mustParse(t, `{"type":"checks", "state":"critical", "exclude_suppressed":true}`)
+	plan.Handler = func(idx uint64, raw interface{}) {
+		if raw == nil {
+			return // ignore
+		}
+		v, ok := raw.([]*api.HealthCheck)
+		if !ok {
+			return // ignore
+		}
+		wakeups = append(wakeups, v)
+		notifyCh <- struct{}{}
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		if err := plan.Run(s.HTTPAddr); err != nil {
+			t.Errorf("err: %v", err)
+		}
+	}()
+	defer plan.Stop()
+
+	// Wait for first wakeup.
+	<-notifyCh
+	{
+		catalog := c.Catalog()
+
+		reg := &api.CatalogRegistration{
+			Node:       "foobar",
+			Address:    "1.1.1.1",
+			Datacenter: "dc1",
+			Checks: api.HealthChecks{
+				{
+					Node:    "foobar",
+					CheckID: "network",
+					Name:    "network",
+					Status:  api.HealthCritical,
+				},
+				{
+					Node:       "foobar",
+					CheckID:    "web",
+					Name:       "web",
+					Status:     api.HealthCritical,
+					DependsOn:  "network",
+					Suppressed: true,
+				},
+			},
+		}
+		if _, err := catalog.Register(reg, nil); err != nil {
+			t.Fatalf("err: %v", err)
+		}
+	}
+
+	// Wait for second wakeup.
+	<-notifyCh
+
+	plan.Stop()
+	wg.Wait()
+
+	require.Len(t, wakeups, 2)
+	require.Len(t, wakeups[0], 0)
+	require.Len(t, wakeups[1], 1)
+	require.Equal(t, "network", wakeups[1][0].CheckID)
+}
+
 func TestChecksWatch_Service(t *testing.T) {
 	t.Parallel()
 	c, s := makeClient(t)
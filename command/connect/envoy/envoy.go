@@ -770,7 +770,9 @@ func generateAccessLogs(c *cmd, args *BootstrapTplArgs) error {
 			return fmt.Errorf("config entry %s is not a valid proxy-default", configEntry.GetName())
 		}
 
-		if proxyDefaults.AccessLogs != nil {
+		// Logs sent to a service over gRPC are skipped, because the cluster of the
+		// service is not part of the bootstrap configuration.
+		if proxyDefaults.AccessLogs != nil && !structs.LogSinkType(proxyDefaults.AccessLogs.Type).IsGRPC() {
 			AccessLogsConfig := &structs.AccessLogsConfig{
 				Enabled:             proxyDefaults.AccessLogs.Enabled,
 				DisableListenerLogs: false,
@@ -779,7 +781,7 @@ func generateAccessLogs(c *cmd, args *BootstrapTplArgs) error {
 				TextFormat:          proxyDefaults.AccessLogs.TextFormat,
 				Path:                proxyDefaults.AccessLogs.Path,
 			}
-			envoyLoggers, err := accesslogs.MakeAccessLogs(AccessLogsConfig, false)
+			envoyLoggers, err := accesslogs.MakeAccessLogs(AccessLogsConfig, false, "")
 			if err != nil {
 				return fmt.Errorf("failure generating Envoy access log configuration: %w", err)
 			}
@@ -25,12 +25,28 @@ type cmd struct {
 	http  *flags.HTTPFlags
 	help  string
 
+	// flags
+	flagHTTPMethod string
+	flagHTTPPath   string
+	flagHTTPHeader map[string]string
+	flagExplain    bool
+
 	// testStdin is the input for testing.
 	testStdin io.Reader
 }
 
 func (c *cmd) init() {
 	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+	c.flags.StringVar(&c.flagHTTPMethod, "http-method", "",
+		"The method of an HTTP request to evaluate against the L7 permissions of the matching intention.")
+	c.flags.StringVar(&c.flagHTTPPath, "http-path", "",
+		"The path of an HTTP request to evaluate against the L7 permissions of the matching intention.")
+	c.flags.Var((*flags.FlagMapValue)(&c.flagHTTPHeader), "http-header",
+		"A header of an HTTP request to evaluate against the L7 permissions of the matching "+
+			"intention, in the form name=value. This flag may be specified multiple times.")
+	c.flags.BoolVar(&c.flagExplain, "explain", false,
+		"Explain which intention and permission decided the outcome. This requires "+
+			"intention read access to the matching intention.")
 	c.http = &flags.HTTPFlags{}
 	flags.Merge(c.flags, c.http.ClientFlags())
 	flags.Merge(c.flags, c.http.ServerFlags())
@@ -56,23 +72,43 @@ func (c *cmd) Run(args []string) int {
 		return 2
 	}
 
-	// Check the intention
-	allowed, _, err := client.Connect().IntentionCheck(&api.IntentionCheck{
+	check := &api.IntentionCheck{
 		Source:      args[0],
 		Destination: args[1],
 		SourceType:  api.IntentionSourceConsul,
-	}, nil)
+	}
+	if c.flagHTTPMethod != "" || c.flagHTTPPath != "" || len(c.flagHTTPHeader) > 0 {
+		check.HTTP = &api.IntentionCheckHTTPRequest{
+			Method: c.flagHTTPMethod,
+			Path:   c.flagHTTPPath,
+			Header: c.flagHTTPHeader,
+		}
+	}
+
+	// Check the intention
+	result, _, err := client.Connect().IntentionCheckExplain(check, nil)
 	if err != nil {
 		c.UI.Error(fmt.Sprintf("Error checking the connection: %s", err))
 		return 2
 	}
 
-	if allowed {
+	if result.Allowed {
 		c.UI.Output("Allowed")
-		return 0
+	} else {
+		c.UI.Output("Denied")
 	}
 
-	c.UI.Output("Denied")
+	if c.flagExplain {
+		if result.Explanation == nil {
+			c.UI.Warn("No explanation is available because the token cannot read the matching intention.")
+		} else {
+			c.UI.Output(result.Explanation.Reason)
+		}
+	}
+
+	if result.Allowed {
+		return 0
+	}
 	return 1
 }
 
@@ -94,5 +130,11 @@ Usage: consul intention check [options] SRC DST
 
       $ consul intention check web db
 
+  Evaluate an HTTP request against the L7 permissions of the matching
+  intention and explain the outcome:
+
+      $ consul intention check -http-method=POST -http-path=/admin \
+          -http-header=x-role=ops -explain web api
+
 `
 )
@@ -116,3 +116,82 @@ func TestIntentionCheck(t *testing.T) {
 		require.Contains(t, ui.OutputWriter.String(), "Denied")
 	}
 }
+
+func TestIntentionCheck_L7(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	a := agent.NewTestAgent(t, ``)
+	defer a.Shutdown()
+	client := a.Client()
+
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+
+	// Create the intention
+	{
+		_, _, err := client.ConfigEntries().Set(&api.ServiceConfigEntry{
+			Kind:     api.ServiceDefaults,
+			Name:     "api",
+			Protocol: "http",
+		}, nil)
+		require.NoError(t, err)
+
+		_, _, err = client.ConfigEntries().Set(&api.ServiceIntentionsConfigEntry{
+			Kind: api.ServiceIntentions,
+			Name: "api",
+			Sources: []*api.SourceIntention{
+				{
+					Name: "web",
+					Permissions: []*api.IntentionPermission{
+						{
+							Action: api.IntentionActionAllow,
+							HTTP: &api.IntentionHTTPPermission{
+								PathPrefix: "/v1",
+								Header: []api.IntentionHTTPHeaderPermission{
+									{Name: "x-role", Exact: "ops"},
+								},
+							},
+						},
+					},
+				},
+			},
+		}, nil)
+		require.NoError(t, err)
+	}
+
+	cases := map[string]struct {
+		args   []string
+		code   int
+		output string
+	}{
+		"no http request": {
+			args:   []string{"-explain", "web", "api"},
+			code:   1,
+			output: "has L7 permissions",
+		},
+		"matching request": {
+			args:   []string{"-explain", "-http-path=/v1/users", "-http-header=X-Role=ops", "web", "api"},
+			code:   0,
+			output: "matched permission 0",
+		},
+		"non-matching request falls through to the default": {
+			args:   []string{"-explain", "-http-path=/v1/users", "web", "api"},
+			code:   0,
+			output: `matched none of its permissions, so the default intention policy "allow" applied`,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ui := cli.NewMockUi()
+			c := New(ui)
+
+			args := append([]string{"-http-addr=" + a.HTTPAddr()}, tc.args...)
+			require.Equal(t, tc.code, c.Run(args), ui.ErrorWriter.String())
+			require.Contains(t, ui.OutputWriter.String(), tc.output)
+		})
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schedule
+
+import (
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/mitchellh/cli"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/command/flags"
+)
+
+// cmd is a Command implementation that manages scheduled maintenance
+// windows for nodes and services.
+type cmd struct {
+	UI    cli.Ui
+	help  string
+	flags *flag.FlagSet
+	http  *flags.HTTPFlags
+
+	// flags
+	id         string
+	node       string
+	serviceID  string
+	start      string
+	duration   time.Duration
+	recurrence time.Duration
+	reason     string
+	delete     bool
+}
+
+func New(ui cli.Ui) *cmd {
+	c := &cmd{UI: ui}
+	c.init()
+	return c
+}
+
+func (c *cmd) init() {
+	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+	c.flags.StringVar(&c.id, "id", "",
+		"ID of the maintenance window to update or delete.")
+	c.flags.StringVar(&c.node, "node", "",
+		"Node the maintenance window applies to. Defaults to the node of the "+
+			"agent being queried when scheduling a window. When listing, only "+
+			"windows for this node are shown.")
+	c.flags.StringVar(&c.serviceID, "service", "",
+		"Schedule maintenance for a specific service ID instead of the node.")
+	c.flags.StringVar(&c.start, "start", "",
+		"Start time of the first occurrence of the window, in RFC 3339 format.")
+	c.flags.DurationVar(&c.duration, "duration", 0,
+		"How long each occurrence of the window lasts, such as \"2h\".")
+	c.flags.DurationVar(&c.recurrence, "recurrence", 0,
+		"Interval between the start of consecutive occurrences, such as "+
+			"\"168h\" for weekly. If not set, the window occurs once.")
+	c.flags.StringVar(&c.reason, "reason", "",
+		"Text describing the maintenance reason.")
+	c.flags.BoolVar(&c.delete, "delete", false,
+		"Delete the maintenance window given by -id.")
+
+	c.http = &flags.HTTPFlags{}
+	flags.Merge(c.flags, c.http.ClientFlags())
+	flags.Merge(c.flags, c.http.ServerFlags())
+	c.help = flags.Usage(help, c.flags)
+}
+
+func (c *cmd) Run(args []string) int {
+	if err := c.flags.Parse(args); err != nil {
+		return 1
+	}
+
+	create := c.start != "" || c.duration != 0 || c.recurrence != 0 || c.reason != "" || c.serviceID != ""
+	if c.delete && create {
+		c.UI.Error("-delete may only be combined with -id")
+		return 1
+	}
+	if c.delete && c.id == "" {
+		c.UI.Error("-delete requires -id")
+		return 1
+	}
+	if c.id != "" && !c.delete && !create {
+		c.UI.Error("-id requires either -delete or the window to schedule")
+		return 1
+	}
+
+	var start time.Time
+	if create {
+		if c.start == "" || c.duration <= 0 {
+			c.UI.Error("Scheduling a window requires -start and -duration")
+			return 1
+		}
+		var err error
+		if start, err = time.Parse(time.RFC3339, c.start); err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing -start: %s", err))
+			return 1
+		}
+	}
+
+	client, err := c.http.APIClient()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error connecting to Consul agent: %s", err))
+		return 1
+	}
+	catalog := client.Catalog()
+
+	switch {
+	case c.delete:
+		if _, err := catalog.MaintenanceWindowDelete(c.id, nil); err != nil {
+			c.UI.Error(fmt.Sprintf("Error deleting maintenance window: %s", err))
+			return 1
+		}
+		c.UI.Output(fmt.Sprintf("Maintenance window %q deleted", c.id))
+		return 0
+
+	case create:
+		node := c.node
+		if node == "" {
+			if node, err = client.Agent().NodeName(); err != nil {
+				c.UI.Error(fmt.Sprintf("Error querying Consul agent: %s", err))
+				return 1
+			}
+		}
+		window := &api.MaintenanceWindow{
+			ID:         c.id,
+			Node:       node,
+			ServiceID:  c.serviceID,
+			Start:      start,
+			Duration:   c.duration,
+			Recurrence: c.recurrence,
+			Reason:     c.reason,
+		}
+		id, _, err := catalog.MaintenanceWindowSet(window, nil)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error scheduling maintenance window: %s", err))
+			return 1
+		}
+		c.UI.Output(fmt.Sprintf("Maintenance window %q scheduled", id))
+		return 0
+	}
+
+	// List mode - show the scheduled windows
+	windows, _, err := catalog.MaintenanceWindows(c.node, &api.QueryOptions{
+		AllowStale: c.http.Stale(),
+	})
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error listing maintenance windows: %s", err))
+		return 1
+	}
+
+	for _, w := range windows {
+		c.UI.Output("Window:")
+		c.UI.Output("  ID:         " + w.ID)
+		c.UI.Output("  Node:       " + w.Node)
+		if w.ServiceID != "" {
+			c.UI.Output("  Service:    " + w.ServiceID)
+		}
+		c.UI.Output("  Start:      " + w.Start.Format(time.RFC3339))
+		c.UI.Output("  Duration:   " + w.Duration.String())
+		if w.Recurrence != 0 {
+			c.UI.Output("  Recurrence: " + w.Recurrence.String())
+		}
+		if w.Reason != "" {
+			c.UI.Output("  Reason:     " + w.Reason)
+		}
+		c.UI.Output("")
+	}
+
+	return 0
+}
+
+func (c *cmd) Synopsis() string {
+	return synopsis
+}
+
+func (c *cmd) Help() string {
+	return c.help
+}
+
+const synopsis = "Schedules node or service maintenance windows"
+const help = `
+Usage: consul maint schedule [options]
+
+  Schedules a window during which a node or service is placed into
+  maintenance mode. Windows are stored in the catalog, and the agent
+  running on the node enables maintenance mode when a window opens and
+  disables it again when the window closes. Maintenance mode enabled
+  manually with "consul maint" is never disabled by a window.
+
+  A window starts at the given time and lasts for the given duration.
+  It can optionally recur at a fixed interval:
+
+      $ consul maint schedule -start=2023-06-04T02:00:00Z -duration=2h \
+          -recurrence=168h -reason="weekly patching"
+
+  By specifying the "-service" argument, only a specific service on the
+  node is placed into maintenance.
+
+  An existing window can be updated by passing its ID with "-id", or
+  removed with "-id" and "-delete".
+
+  If no window is given, the scheduled windows will be listed.
+`
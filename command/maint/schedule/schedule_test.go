@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schedule
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+func TestMaintScheduleCommand_noTabs(t *testing.T) {
+	t.Parallel()
+	if strings.ContainsRune(New(cli.NewMockUi()).Help(), '\t') {
+		t.Fatal("help has tabs")
+	}
+}
+
+func TestMaintScheduleCommand_ConflictingArgs(t *testing.T) {
+	t.Parallel()
+
+	cases := [][]string{
+		{"-delete"},
+		{"-delete", "-id=foo", "-duration=1h"},
+		{"-id=foo"},
+		{"-duration=1h"},
+		{"-start=tomorrow", "-duration=1h"},
+	}
+	for _, args := range cases {
+		ui := cli.NewMockUi()
+		c := New(ui)
+		c.flags.SetOutput(ui.ErrorWriter)
+		if code := c.Run(args); code != 1 {
+			t.Fatalf("args %v: expected return code 1, got %d", args, code)
+		}
+	}
+}
+
+func TestMaintScheduleCommand(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := agent.NewTestAgent(t, ``)
+	defer a.Shutdown()
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+
+	run := func(args ...string) string {
+		ui := cli.NewMockUi()
+		c := New(ui)
+		c.flags.SetOutput(ui.ErrorWriter)
+		args = append([]string{"-http-addr=" + a.HTTPAddr()}, args...)
+		code := c.Run(args)
+		require.Equal(t, 0, code, ui.ErrorWriter.String())
+		return ui.OutputWriter.String()
+	}
+
+	out := run("-start=2030-06-04T02:00:00Z", "-duration=2h", "-recurrence=168h", "-reason=patching")
+	require.Contains(t, out, "scheduled")
+
+	out = run()
+	require.Contains(t, out, "Node:       "+a.Config.NodeName)
+	require.Contains(t, out, "Start:      2030-06-04T02:00:00Z")
+	require.Contains(t, out, "Duration:   2h0m0s")
+	require.Contains(t, out, "Recurrence: 168h0m0s")
+	require.Contains(t, out, "Reason:     patching")
+
+	windows, _, err := a.Client().Catalog().MaintenanceWindows(a.Config.NodeName, nil)
+	require.NoError(t, err)
+	require.Len(t, windows, 1)
+
+	out = run("-id="+windows[0].ID, "-delete")
+	require.Contains(t, out, "deleted")
+	require.Empty(t, run())
+}
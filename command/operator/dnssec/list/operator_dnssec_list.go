@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package list
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/miekg/dns"
+	"github.com/mitchellh/cli"
+	"github.com/ryanuber/columnize"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/command/flags"
+)
+
+func New(ui cli.Ui) *cmd {
+	c := &cmd{UI: ui}
+	c.init()
+	return c
+}
+
+type cmd struct {
+	UI     cli.Ui
+	flags  *flag.FlagSet
+	http   *flags.HTTPFlags
+	help   string
+	domain string
+}
+
+func (c *cmd) init() {
+	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+	c.http = &flags.HTTPFlags{}
+	c.flags.StringVar(&c.domain, "domain", "consul.",
+		"The DNS domain of the cluster, used as the owner name of the DS records.")
+	flags.Merge(c.flags, c.http.ClientFlags())
+	flags.Merge(c.flags, c.http.ServerFlags())
+	c.help = flags.Usage(help, c.flags)
+}
+
+func (c *cmd) Run(args []string) int {
+	if err := c.flags.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return 0
+		}
+		c.UI.Error(fmt.Sprintf("Failed to parse args: %v", err))
+		return 1
+	}
+
+	// Set up a client.
+	client, err := c.http.APIClient()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	result, err := dnssecListKeys(client, c.http.Stale(), dns.Fqdn(c.domain))
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error listing DNSSEC keys: %v", err))
+		return 1
+	}
+
+	c.UI.Output(result)
+	return 0
+}
+
+func dnssecListKeys(client *api.Client, stale bool, domain string) (string, error) {
+	q := &api.QueryOptions{
+		AllowStale: stale,
+	}
+	keys, _, err := client.Operator().DNSSECKeys(q)
+	if err != nil {
+		return "", fmt.Errorf("Failed to retrieve DNSSEC keys: %v", err)
+	}
+	if len(keys) == 0 {
+		return "No DNSSEC keys found", nil
+	}
+
+	// Format it as a nice table.
+	table := []string{"Key Tag\x1fAlgorithm\x1fFlags\x1fActive\x1fCreated\x1fActivates"}
+	var ds []string
+	for _, key := range keys {
+		activates := "-"
+		if !key.ActivateTime.IsZero() {
+			activates = key.ActivateTime.String()
+		}
+		table = append(table, fmt.Sprintf("%d\x1f%s\x1f%d\x1f%v\x1f%s\x1f%s",
+			key.KeyTag, dns.AlgorithmToString[key.Algorithm], key.Flags, key.Active, key.CreateTime, activates))
+
+		dnskey := &dns.DNSKEY{
+			Hdr: dns.RR_Header{
+				Name:   domain,
+				Rrtype: dns.TypeDNSKEY,
+				Class:  dns.ClassINET,
+			},
+			Flags:     key.Flags,
+			Protocol:  3,
+			Algorithm: key.Algorithm,
+			PublicKey: key.PublicKey,
+		}
+		if rr := dnskey.ToDS(dns.SHA256); rr != nil {
+			ds = append(ds, rr.String())
+		}
+	}
+
+	result := columnize.Format(table, &columnize.Config{Delim: string([]byte{0x1f})})
+	if len(ds) > 0 {
+		result += "\n\nDS records:\n" + strings.Join(ds, "\n")
+	}
+	return result, nil
+}
+
+func (c *cmd) Synopsis() string {
+	return synopsis
+}
+
+func (c *cmd) Help() string {
+	return c.help
+}
+
+const synopsis = "Display the DNSSEC keys and their DS records"
+const help = `
+Usage: consul operator dnssec list [options]
+
+  Displays the DNSSEC keys Consul DNS answers are signed with, along with the
+  DS records to publish in the parent zone of the Consul domain.
+`
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package list
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent"
+	"github.com/hashicorp/consul/sdk/testutil/retry"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+func TestOperatorDNSSECListCommand_noTabs(t *testing.T) {
+	t.Parallel()
+	if strings.ContainsRune(New(cli.NewMockUi()).Help(), '\t') {
+		t.Fatal("help has tabs")
+	}
+}
+
+func TestOperatorDNSSECListCommand(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := agent.NewTestAgent(t, `
+		dns_config {
+			dnssec {
+				enabled = true
+			}
+		}
+	`)
+	defer a.Shutdown()
+	testrpc.WaitForLeader(t, a.RPC, "dc1")
+
+	// The leader generates the first key once it is established.
+	var keyTag uint16
+	retry.Run(t, func(r *retry.R) {
+		keys, _, err := a.Client().Operator().DNSSECKeys(nil)
+		require.NoError(r, err)
+		require.Len(r, keys, 1)
+		keyTag = keys[0].KeyTag
+	})
+
+	ui := cli.NewMockUi()
+	c := New(ui)
+	code := c.Run([]string{"-http-addr=" + a.HTTPAddr(), "-domain=example.com"})
+	require.Equal(t, 0, code, ui.ErrorWriter.String())
+
+	output := ui.OutputWriter.String()
+	require.Contains(t, output, "ECDSAP256SHA256")
+	require.Contains(t, output, fmt.Sprintf("example.com.\t0\tIN\tDS\t%d 13 2 ", keyTag))
+}
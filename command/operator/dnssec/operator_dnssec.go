@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dnssec
+
+import (
+	"github.com/hashicorp/consul/command/flags"
+	"github.com/mitchellh/cli"
+)
+
+func New() *cmd {
+	return &cmd{}
+}
+
+type cmd struct{}
+
+func (c *cmd) Run(args []string) int {
+	return cli.RunResultHelp
+}
+
+func (c *cmd) Synopsis() string {
+	return synopsis
+}
+
+func (c *cmd) Help() string {
+	return flags.Usage(help, nil)
+}
+
+const synopsis = "Manage the keys Consul signs DNS answers with"
+const help = `
+Usage: consul operator dnssec <subcommand> [options]
+
+The DNSSEC operator command is used to manage the keys Consul DNS answers are
+signed with when dns_config.dnssec is enabled. Keys can be listed along with
+the DS records to publish in the parent zone, rotated, and removed once the
+parent zone no longer refers to them.
+`
@@ -0,0 +1,16 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dnssec
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestOperatorDNSSECCommand_noTabs(t *testing.T) {
+	t.Parallel()
+	if strings.ContainsRune(New().Help(), '\t') {
+		t.Fatal("help has tabs")
+	}
+}
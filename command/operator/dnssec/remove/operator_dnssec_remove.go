@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package remove
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+
+	"github.com/mitchellh/cli"
+
+	"github.com/hashicorp/consul/command/flags"
+)
+
+func New(ui cli.Ui) *cmd {
+	c := &cmd{UI: ui}
+	c.init()
+	return c
+}
+
+type cmd struct {
+	UI     cli.Ui
+	flags  *flag.FlagSet
+	http   *flags.HTTPFlags
+	help   string
+	keyTag string
+}
+
+func (c *cmd) init() {
+	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+	c.http = &flags.HTTPFlags{}
+	c.flags.StringVar(&c.keyTag, "key-tag", "",
+		"The tag of the DNSSEC key to remove.")
+	flags.Merge(c.flags, c.http.ClientFlags())
+	flags.Merge(c.flags, c.http.ServerFlags())
+	c.help = flags.Usage(help, c.flags)
+}
+
+func (c *cmd) Run(args []string) int {
+	if err := c.flags.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return 0
+		}
+		c.UI.Error(fmt.Sprintf("Failed to parse args: %v", err))
+		return 1
+	}
+
+	if c.keyTag == "" {
+		c.UI.Error("Must specify the -key-tag of the key to remove")
+		return 1
+	}
+	keyTag, err := strconv.ParseUint(c.keyTag, 10, 16)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Invalid -key-tag %q: %v", c.keyTag, err))
+		return 1
+	}
+
+	// Set up a client.
+	client, err := c.http.APIClient()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	if err := client.Operator().DNSSECRemoveKey(uint16(keyTag), nil); err != nil {
+		c.UI.Error(fmt.Sprintf("Error removing DNSSEC key: %v", err))
+		return 1
+	}
+
+	c.UI.Output(fmt.Sprintf("Removed DNSSEC key %d", keyTag))
+	return 0
+}
+
+func (c *cmd) Synopsis() string {
+	return synopsis
+}
+
+func (c *cmd) Help() string {
+	return c.help
+}
+
+const synopsis = "Remove an inactive DNSSEC key"
+const help = `
+Usage: consul operator dnssec remove -key-tag=<tag> [options]
+
+  Removes an inactive DNSSEC key. The active key cannot be removed, rotate to
+  a new key first.
+`
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package remove
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+func TestOperatorDNSSECRemoveCommand_noTabs(t *testing.T) {
+	t.Parallel()
+	if strings.ContainsRune(New(cli.NewMockUi()).Help(), '\t') {
+		t.Fatal("help has tabs")
+	}
+}
+
+func TestOperatorDNSSECRemoveCommand(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := agent.NewTestAgent(t, ``)
+	defer a.Shutdown()
+	testrpc.WaitForLeader(t, a.RPC, "dc1")
+
+	first, err := a.Client().Operator().DNSSECRotate(nil)
+	require.NoError(t, err)
+	second, err := a.Client().Operator().DNSSECRotate(nil)
+	require.NoError(t, err)
+
+	t.Run("missing key tag", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		code := New(ui).Run([]string{"-http-addr=" + a.HTTPAddr()})
+		require.Equal(t, 1, code)
+		require.Contains(t, ui.ErrorWriter.String(), "Must specify the -key-tag")
+	})
+
+	require.True(t, first.Active)
+	require.False(t, second.Active)
+
+	t.Run("active key", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		code := New(ui).Run([]string{"-http-addr=" + a.HTTPAddr(), fmt.Sprintf("-key-tag=%d", first.KeyTag)})
+		require.Equal(t, 1, code)
+		require.Contains(t, ui.ErrorWriter.String(), "is the active key")
+	})
+
+	t.Run("pending key", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		code := New(ui).Run([]string{"-http-addr=" + a.HTTPAddr(), fmt.Sprintf("-key-tag=%d", second.KeyTag)})
+		require.Equal(t, 0, code, ui.ErrorWriter.String())
+
+		keys, _, err := a.Client().Operator().DNSSECKeys(nil)
+		require.NoError(t, err)
+		require.Len(t, keys, 1)
+		require.Equal(t, first.KeyTag, keys[0].KeyTag)
+	})
+}
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rotate
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/mitchellh/cli"
+
+	"github.com/hashicorp/consul/command/flags"
+)
+
+func New(ui cli.Ui) *cmd {
+	c := &cmd{UI: ui}
+	c.init()
+	return c
+}
+
+type cmd struct {
+	UI    cli.Ui
+	flags *flag.FlagSet
+	http  *flags.HTTPFlags
+	help  string
+}
+
+func (c *cmd) init() {
+	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+	c.http = &flags.HTTPFlags{}
+	flags.Merge(c.flags, c.http.ClientFlags())
+	flags.Merge(c.flags, c.http.ServerFlags())
+	c.help = flags.Usage(help, c.flags)
+}
+
+func (c *cmd) Run(args []string) int {
+	if err := c.flags.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return 0
+		}
+		c.UI.Error(fmt.Sprintf("Failed to parse args: %v", err))
+		return 1
+	}
+
+	// Set up a client.
+	client, err := c.http.APIClient()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	key, err := client.Operator().DNSSECRotate(nil)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error rotating DNSSEC key: %v", err))
+		return 1
+	}
+
+	if key.Active {
+		c.UI.Output(fmt.Sprintf("Generated DNSSEC key, the active key tag is now %d", key.KeyTag))
+		return 0
+	}
+	c.UI.Output(fmt.Sprintf("Published DNSSEC key %d, it becomes the active key at %s", key.KeyTag, key.ActivateTime))
+	return 0
+}
+
+func (c *cmd) Synopsis() string {
+	return synopsis
+}
+
+func (c *cmd) Help() string {
+	return c.help
+}
+
+const synopsis = "Generate a new DNSSEC key"
+const help = `
+Usage: consul operator dnssec rotate [options]
+
+  Generates a new DNSSEC key and publishes it in the DNSKEY RRset. Consul DNS
+  answers are signed with the new key once it has been published for
+  dns_config.dnssec.key_publish_delay, so that resolvers have dropped any
+  cached DNSKEY RRset without it. The previously active key stays published
+  so that cached signatures remain valid. Remove it with
+  "consul operator dnssec remove" once the DS record of the new key is
+  published in the parent zone.
+`
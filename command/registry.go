@@ -88,6 +88,7 @@ import (
 	"github.com/hashicorp/consul/command/login"
 	"github.com/hashicorp/consul/command/logout"
 	"github.com/hashicorp/consul/command/maint"
+	maintschedule "github.com/hashicorp/consul/command/maint/schedule"
 	"github.com/hashicorp/consul/command/members"
 	"github.com/hashicorp/consul/command/monitor"
 	"github.com/hashicorp/consul/command/operator"
@@ -95,6 +96,10 @@ import (
 	operautoget "github.com/hashicorp/consul/command/operator/autopilot/get"
 	operautoset "github.com/hashicorp/consul/command/operator/autopilot/set"
 	operautostate "github.com/hashicorp/consul/command/operator/autopilot/state"
+	operdnssec "github.com/hashicorp/consul/command/operator/dnssec"
+	operdnsseclist "github.com/hashicorp/consul/command/operator/dnssec/list"
+	operdnssecremove "github.com/hashicorp/consul/command/operator/dnssec/remove"
+	operdnssecrotate "github.com/hashicorp/consul/command/operator/dnssec/rotate"
 	operraft "github.com/hashicorp/consul/command/operator/raft"
 	operraftlist "github.com/hashicorp/consul/command/operator/raft/listpeers"
 	operraftremove "github.com/hashicorp/consul/command/operator/raft/removepeer"
@@ -122,6 +127,7 @@ import (
 	tlscert "github.com/hashicorp/consul/command/tls/cert"
 	tlscertcreate "github.com/hashicorp/consul/command/tls/cert/create"
 	troubleshoot "github.com/hashicorp/consul/command/troubleshoot"
+	troubleshootintentions "github.com/hashicorp/consul/command/troubleshoot/intentions"
 	troubleshootproxy "github.com/hashicorp/consul/command/troubleshoot/proxy"
 	troubleshootupstreams "github.com/hashicorp/consul/command/troubleshoot/upstreams"
 	"github.com/hashicorp/consul/command/validate"
@@ -217,6 +223,7 @@ func RegisteredCommands(ui cli.Ui) map[string]mcli.CommandFactory {
 		entry{"login", func(ui cli.Ui) (cli.Command, error) { return login.New(ui), nil }},
 		entry{"logout", func(ui cli.Ui) (cli.Command, error) { return logout.New(ui), nil }},
 		entry{"maint", func(ui cli.Ui) (cli.Command, error) { return maint.New(ui), nil }},
+		entry{"maint schedule", func(ui cli.Ui) (cli.Command, error) { return maintschedule.New(ui), nil }},
 		entry{"members", func(ui cli.Ui) (cli.Command, error) { return members.New(ui), nil }},
 		entry{"monitor", func(ui cli.Ui) (cli.Command, error) { return monitor.New(ui, MakeShutdownCh()), nil }},
 		entry{"operator", func(cli.Ui) (cli.Command, error) { return operator.New(), nil }},
@@ -224,6 +231,10 @@ func RegisteredCommands(ui cli.Ui) map[string]mcli.CommandFactory {
 		entry{"operator autopilot get-config", func(ui cli.Ui) (cli.Command, error) { return operautoget.New(ui), nil }},
 		entry{"operator autopilot set-config", func(ui cli.Ui) (cli.Command, error) { return operautoset.New(ui), nil }},
 		entry{"operator autopilot state", func(ui cli.Ui) (cli.Command, error) { return operautostate.New(ui), nil }},
+		entry{"operator dnssec", func(cli.Ui) (cli.Command, error) { return operdnssec.New(), nil }},
+		entry{"operator dnssec list", func(ui cli.Ui) (cli.Command, error) { return operdnsseclist.New(ui), nil }},
+		entry{"operator dnssec remove", func(ui cli.Ui) (cli.Command, error) { return operdnssecremove.New(ui), nil }},
+		entry{"operator dnssec rotate", func(ui cli.Ui) (cli.Command, error) { return operdnssecrotate.New(ui), nil }},
 		entry{"operator raft", func(cli.Ui) (cli.Command, error) { return operraft.New(), nil }},
 		entry{"operator raft list-peers", func(ui cli.Ui) (cli.Command, error) { return operraftlist.New(ui), nil }},
 		entry{"operator raft remove-peer", func(ui cli.Ui) (cli.Command, error) { return operraftremove.New(ui), nil }},
@@ -251,6 +262,7 @@ func RegisteredCommands(ui cli.Ui) map[string]mcli.CommandFactory {
 		entry{"tls cert", func(ui cli.Ui) (cli.Command, error) { return tlscert.New(), nil }},
 		entry{"tls cert create", func(ui cli.Ui) (cli.Command, error) { return tlscertcreate.New(ui), nil }},
 		entry{"troubleshoot", func(ui cli.Ui) (cli.Command, error) { return troubleshoot.New(), nil }},
+		entry{"troubleshoot intentions", func(ui cli.Ui) (cli.Command, error) { return troubleshootintentions.New(ui), nil }},
 		entry{"troubleshoot proxy", func(ui cli.Ui) (cli.Command, error) { return troubleshootproxy.New(ui), nil }},
 		entry{"troubleshoot upstreams", func(ui cli.Ui) (cli.Command, error) { return troubleshootupstreams.New(ui), nil }},
 		entry{"validate", func(ui cli.Ui) (cli.Command, error) { return validate.New(ui), nil }},
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package intentions
+
+import (
+	"flag"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+
+	"github.com/hashicorp/consul/command/cli"
+	"github.com/hashicorp/consul/command/flags"
+	troubleshoot "github.com/hashicorp/consul/troubleshoot/proxy"
+)
+
+func New(ui cli.Ui) *cmd {
+	c := &cmd{UI: ui}
+	c.init()
+	return c
+}
+
+type cmd struct {
+	UI    cli.Ui
+	flags *flag.FlagSet
+	http  *flags.HTTPFlags
+	help  string
+
+	// flags
+	envoyAdminEndpoint string
+}
+
+func (c *cmd) init() {
+	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+
+	defaultEnvoyAdminEndpoint := "localhost:19000"
+	if envoyAdminEndpoint := os.Getenv("ENVOY_ADMIN_ENDPOINT"); envoyAdminEndpoint != "" {
+		defaultEnvoyAdminEndpoint = envoyAdminEndpoint
+	}
+	c.flags.StringVar(&c.envoyAdminEndpoint, "envoy-admin-endpoint", defaultEnvoyAdminEndpoint, "The address:port that envoy's admin endpoint is on.")
+
+	c.http = &flags.HTTPFlags{}
+	flags.Merge(c.flags, c.http.ClientFlags())
+	flags.Merge(c.flags, c.http.ServerFlags())
+	c.help = flags.Usage(help, c.flags)
+}
+
+func (c *cmd) Run(args []string) int {
+	if err := c.flags.Parse(args); err != nil {
+		c.UI.Error(fmt.Sprintf("Failed to parse args: %v", err))
+		return 1
+	}
+
+	adminAddr, adminPort, err := net.SplitHostPort(c.envoyAdminEndpoint)
+	if err != nil {
+		c.UI.Error("Invalid Envoy Admin endpoint: " + err.Error())
+		return 1
+	}
+
+	// Envoy requires IP addresses to bind too when using static so resolve DNS or
+	// localhost here.
+	adminBindIP, err := net.ResolveIPAddr("ip", adminAddr)
+	if err != nil {
+		c.UI.Error("Failed to resolve envoy admin endpoint: " + err.Error())
+		c.UI.Error("Please make sure Envoy's Admin API is enabled.")
+		return 1
+	}
+
+	t, err := troubleshoot.NewTroubleshoot(adminBindIP, adminPort)
+	if err != nil {
+		c.UI.Error("error generating troubleshoot client: " + err.Error())
+		return 1
+	}
+	denials, err := t.GetAuditDenials()
+	if err != nil {
+		c.UI.Error("error calling GetAuditDenials: " + err.Error())
+		return 1
+	}
+
+	if len(denials) == 0 {
+		c.UI.Info("No intentions in audit mode apply to this proxy.")
+		return 0
+	}
+
+	c.UI.HeaderOutput(fmt.Sprintf("Audit denials (%v)", len(denials)))
+	tbl := cli.NewTable("Stat Prefix ", "Denied")
+	for _, d := range denials {
+		tbl.AddRow([]string{d.StatPrefix, strconv.FormatUint(d.Count, 10)}, []string{})
+	}
+	c.UI.Table(tbl)
+
+	c.UI.UnchangedOutput("\nThe counts are the connections and requests that intentions in audit mode would deny if they were enforced.")
+	c.UI.UnchangedOutput("-> To see which connections and requests would be denied, enable access logs and log the " +
+		"`%DYNAMIC_METADATA(envoy.filters.http.rbac:shadow_engine_result)%` and " +
+		"`%DYNAMIC_METADATA(envoy.filters.network.rbac:shadow_engine_result)%` values.")
+	return 0
+}
+
+func (c *cmd) Synopsis() string {
+	return synopsis
+}
+
+func (c *cmd) Help() string {
+	return c.help
+}
+
+const (
+	synopsis = "List the connections and requests that intentions in audit mode would deny"
+	help     = `
+Usage: consul troubleshoot intentions [options]
+
+  Connects to local Envoy and lists the number of connections and requests
+  that intentions in audit mode would deny if they were enforced. Use this
+  command before switching intentions in audit mode to enforcement.
+  Examples:
+    $ consul troubleshoot intentions
+`
+)
@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package intentions
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	mcli "github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/command/cli"
+)
+
+func TestTroubleshootIntentionsCommand_noTabs(t *testing.T) {
+	t.Parallel()
+
+	if strings.ContainsRune(New(&cli.BasicUI{}).Help(), '\t') {
+		t.Fatal("help has tabs")
+	}
+}
+
+func TestTroubleshootIntentionsCommand_invalidEnvoyAdminEndpoint(t *testing.T) {
+	t.Parallel()
+
+	var stdout, stderr bytes.Buffer
+	ui := &cli.BasicUI{BasicUi: mcli.BasicUi{Writer: &stdout, ErrorWriter: &stderr}}
+	c := New(ui)
+
+	code := c.Run([]string{"-envoy-admin-endpoint", "localhost"})
+	require.Equal(t, 1, code)
+	require.Contains(t, stderr.String(), "Invalid Envoy Admin endpoint")
+}
@@ -43,5 +43,9 @@ Usage: consul troubleshoot <subcommand> [options]
 
     $ consul troubleshoot proxy -upstream [options]
 
+  Troubleshoot Intentions in Audit Mode
+
+    $ consul troubleshoot intentions
+
   For more examples, ask for subcommand help or view the documentation.
 `
@@ -0,0 +1,222 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/attributes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/consul/agent/connect"
+	"github.com/hashicorp/consul/api"
+)
+
+// grpcDefaultServiceConfig balances calls across all resolved instances
+// rather than using the first one that works.
+const grpcDefaultServiceConfig = `{"loadBalancingConfig":[{"round_robin":{}}]}`
+
+// GRPCServerOptions returns the options for a gRPC server of the service. The
+// server presents the service's leaf certificate, verifies the certificates of
+// clients, and authorizes every call against the intentions for the service,
+// including their L7 permissions. Calls are matched as POST requests for the
+// path "/<package>.<service>/<method>".
+func (s *Service) GRPCServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.Creds(s.GRPCServerCredentials()),
+		grpc.ChainUnaryInterceptor(s.GRPCUnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(s.GRPCStreamServerInterceptor()),
+	}
+}
+
+// GRPCDialOptions returns the options for dialing upstream services over gRPC.
+// Targets use the consul-connect scheme, which is described by
+// GRPCResolverBuilder. Calls are balanced across the healthy instances of the
+// target using the round_robin policy, unless overridden by the options passed
+// after these.
+func (s *Service) GRPCDialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithTransportCredentials(s.GRPCClientCredentials()),
+		grpc.WithResolvers(s.GRPCResolverBuilder()),
+		grpc.WithDefaultServiceConfig(grpcDefaultServiceConfig),
+	}
+}
+
+// GRPCDial creates a client connection to an upstream service, for example
+// "consul-connect:///db". The options passed are applied after those returned
+// by GRPCDialOptions.
+func (s *Service) GRPCDial(ctx context.Context, target string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	return grpc.DialContext(ctx, target, append(s.GRPCDialOptions(), opts...)...)
+}
+
+// GRPCServerCredentials returns transport credentials for gRPC servers. They
+// verify client certificates without authorizing them, so intentions must be
+// enforced with the interceptors returned by GRPCUnaryServerInterceptor and
+// GRPCStreamServerInterceptor.
+func (s *Service) GRPCServerCredentials() credentials.TransportCredentials {
+	return &grpcCredentials{service: s}
+}
+
+// GRPCClientCredentials returns transport credentials for gRPC clients. They
+// verify that each server presents the identity the resolver discovered for
+// its address, so they only work with addresses resolved by the resolver
+// returned by GRPCResolverBuilder.
+func (s *Service) GRPCClientCredentials() credentials.TransportCredentials {
+	return &grpcCredentials{service: s}
+}
+
+// GRPCUnaryServerInterceptor returns a unary interceptor that authorizes each
+// call with AuthorizeGRPCRequest.
+func (s *Service) GRPCUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		if err := s.authorizeGRPC(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// GRPCStreamServerInterceptor returns a stream interceptor that authorizes
+// each call with AuthorizeGRPCRequest.
+func (s *Service) GRPCStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) error {
+		if err := s.authorizeGRPC(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+func (s *Service) authorizeGRPC(ctx context.Context, fullMethod string) error {
+	allowed, reason, err := s.AuthorizeGRPCRequest(ctx, fullMethod)
+	if err != nil {
+		s.logger.Error("failed to authorize call", "method", fullMethod, "error", err)
+		return status.Error(codes.PermissionDenied, "permission denied")
+	}
+	if !allowed {
+		s.logger.Debug("call denied", "method", fullMethod, "reason", reason)
+		return status.Error(codes.PermissionDenied, "permission denied")
+	}
+	return nil
+}
+
+// AuthorizeGRPCRequest authorizes a gRPC call received over a connection
+// secured with GRPCServerCredentials, in the same way as AuthorizeHTTPRequest.
+// The call is matched as a POST request for the full method name, with the
+// incoming metadata as headers.
+func (s *Service) AuthorizeGRPCRequest(ctx context.Context, fullMethod string) (bool, string, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return false, "", errors.New("connect: call has no peer")
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.PeerCertificates) == 0 {
+		return false, "", errors.New("connect: call has no client certificate")
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	httpReq := &api.IntentionCheckHTTPRequest{
+		Method: "POST",
+		Path:   fullMethod,
+		Header: make(map[string]string, len(md)),
+	}
+	for name, values := range md {
+		httpReq.Header[name] = strings.Join(values, ",")
+	}
+
+	return s.authorizeRequest(tlsInfo.State.PeerCertificates[0], httpReq)
+}
+
+// grpcCertURIKey is the key of the resolver.Address attribute holding the
+// identity that the instance at the address must present.
+type grpcCertURIKey struct{}
+
+func grpcAddressAttributes(certURI connect.CertURI) *attributes.Attributes {
+	return attributes.New(grpcCertURIKey{}, certURI)
+}
+
+// grpcCredentials implements credentials.TransportCredentials with the
+// certificates of a Service.
+type grpcCredentials struct {
+	service    *Service
+	serverName string
+}
+
+// ClientHandshake implements credentials.TransportCredentials.
+func (c *grpcCredentials) ClientHandshake(ctx context.Context, _ string,
+	rawConn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	attrs := credentials.ClientHandshakeInfoFromContext(ctx).Attributes
+	certURI, ok := attrs.Value(grpcCertURIKey{}).(connect.CertURI)
+	if !ok {
+		return nil, nil, errors.New("connect: no identity was resolved for the address")
+	}
+
+	cfg := c.service.tlsCfg.Get(clientSideVerifier)
+	cfg.NextProtos = []string{"h2"}
+	conn := tls.Client(rawConn, cfg)
+	if err := conn.HandshakeContext(ctx); err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+
+	// Verify that the connect server's URI matches certURI
+	err := verifyServerCertMatchesURI(conn.ConnectionState().PeerCertificates, certURI)
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	return conn, grpcTLSInfo(conn), nil
+}
+
+// ServerHandshake implements credentials.TransportCredentials.
+func (c *grpcCredentials) ServerHandshake(rawConn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	conn := tls.Server(rawConn, c.service.requestAuthzTLSConfig("h2"))
+	if err := conn.Handshake(); err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	return conn, grpcTLSInfo(conn), nil
+}
+
+func grpcTLSInfo(conn *tls.Conn) credentials.TLSInfo {
+	return credentials.TLSInfo{
+		State: conn.ConnectionState(),
+		CommonAuthInfo: credentials.CommonAuthInfo{
+			SecurityLevel: credentials.PrivacyAndIntegrity,
+		},
+	}
+}
+
+// Info implements credentials.TransportCredentials.
+func (c *grpcCredentials) Info() credentials.ProtocolInfo {
+	return credentials.ProtocolInfo{
+		SecurityProtocol: "tls",
+		SecurityVersion:  "1.2",
+		ServerName:       c.serverName,
+	}
+}
+
+// Clone implements credentials.TransportCredentials.
+func (c *grpcCredentials) Clone() credentials.TransportCredentials {
+	clone := *c
+	return &clone
+}
+
+// OverrideServerName implements credentials.TransportCredentials. The server
+// name is not used to verify servers, which are identified by their SPIFFE ID.
+func (c *grpcCredentials) OverrideServerName(serverName string) error {
+	c.serverName = serverName
+	return nil
+}
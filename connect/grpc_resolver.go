@@ -0,0 +1,335 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"google.golang.org/grpc/resolver"
+
+	"github.com/hashicorp/consul/agent/connect"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/ipaddr"
+)
+
+// GRPCResolverScheme is the scheme of the gRPC targets resolved by the
+// builder returned by GRPCResolverBuilder.
+const GRPCResolverScheme = "consul-connect"
+
+// grpcResolverRetryWait is how long to wait before retrying a failed query.
+const grpcResolverRetryWait = 5 * time.Second
+
+// GRPCResolverBuilder returns a gRPC resolver builder for targets of the form
+// "consul-connect:///<service>", which may set the "dc", "ns" and "partition"
+// query parameters. A target resolves to the healthy instances of the target
+// of the service's discovery chain, or of its first failover target with
+// healthy instances, which are kept up to date with blocking queries. Each
+// address carries the identity that the instance must present, which is
+// verified by GRPCClientCredentials.
+//
+// Routes and splits cannot be applied to the calls of a client connection, so
+// discovery chains starting with a router or splitter fail to resolve, as do
+// targets in peered clusters.
+func (s *Service) GRPCResolverBuilder() resolver.Builder {
+	return &grpcResolverBuilder{client: s.client, logger: s.logger}
+}
+
+type grpcResolverBuilder struct {
+	client *api.Client
+	logger hclog.Logger
+}
+
+// Scheme implements resolver.Builder.
+func (b *grpcResolverBuilder) Scheme() string {
+	return GRPCResolverScheme
+}
+
+// Build implements resolver.Builder.
+func (b *grpcResolverBuilder) Build(target resolver.Target, cc resolver.ClientConn,
+	_ resolver.BuildOptions) (resolver.Resolver, error) {
+	if b.client == nil {
+		return nil, errors.New("connect: resolving gRPC targets requires a Consul client")
+	}
+
+	name := strings.TrimPrefix(target.URL.Path, "/")
+	if name == "" {
+		name = target.URL.Opaque
+	}
+	if name == "" || strings.Contains(name, "/") {
+		return nil, fmt.Errorf("connect: invalid gRPC target %q: must be %s:///<service>",
+			target.URL.String(), GRPCResolverScheme)
+	}
+	query := target.URL.Query()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &grpcResolver{
+		client:     b.client,
+		cc:         cc,
+		logger:     b.logger.With("target", name),
+		name:       name,
+		namespace:  query.Get("ns"),
+		partition:  query.Get("partition"),
+		datacenter: query.Get("dc"),
+		ctx:        ctx,
+		cancel:     cancel,
+	}
+	r.wg.Add(1)
+	go r.watchDiscoveryChain()
+	return r, nil
+}
+
+// grpcResolver keeps the addresses of a gRPC client connection up to date
+// with the healthy instances of the targets of a discovery chain.
+type grpcResolver struct {
+	client *api.Client
+	cc     resolver.ClientConn
+	logger hclog.Logger
+
+	name       string
+	namespace  string
+	partition  string
+	datacenter string
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// wg tracks the watch goroutines so that Close can wait for them.
+	wg sync.WaitGroup
+
+	// lock guards the fields below.
+	lock sync.Mutex
+
+	// cancelTargets stops the watches of the current targets.
+	cancelTargets context.CancelFunc
+
+	// targets are the IDs of the current targets in failover order and
+	// instances holds the addresses of those that have been resolved, by
+	// target ID.
+	targets   []string
+	instances map[string][]resolver.Address
+}
+
+// ResolveNow implements resolver.Resolver. Addresses are always up to date
+// thanks to blocking queries, so it is a no-op.
+func (r *grpcResolver) ResolveNow(resolver.ResolveNowOptions) {}
+
+// Close implements resolver.Resolver. It stops the watches and waits for
+// them to return.
+func (r *grpcResolver) Close() {
+	r.cancel()
+	r.wg.Wait()
+}
+
+// watchDiscoveryChain watches the discovery chain of the service and restarts
+// the target watches whenever it changes, until the resolver is closed.
+func (r *grpcResolver) watchDiscoveryChain() {
+	defer r.wg.Done()
+
+	var index uint64
+	for {
+		opts := &api.DiscoveryChainOptions{EvaluateInDatacenter: r.datacenter}
+		q := &api.QueryOptions{
+			Namespace: connect.DefaultAsEmpty(r.namespace),
+			Partition: connect.DefaultAsEmpty(r.partition),
+			WaitIndex: index,
+		}
+		resp, meta, err := r.client.DiscoveryChain().Get(r.name, opts, q.WithContext(r.ctx))
+		if r.ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			r.logger.Warn("failed to fetch discovery chain", "error", err)
+			r.cc.ReportError(err)
+			if !r.wait() {
+				return
+			}
+			continue
+		}
+
+		if meta.LastIndex != index {
+			targets, err := grpcChainTargets(resp.Chain)
+			if err != nil {
+				r.logger.Warn("cannot resolve discovery chain", "error", err)
+				r.cc.ReportError(err)
+			}
+			r.watchTargets(targets)
+		}
+		index = meta.LastIndex
+	}
+}
+
+// grpcChainTargets returns the target of the resolver the chain starts with,
+// followed by its failover targets in order.
+func grpcChainTargets(chain *api.CompiledDiscoveryChain) ([]*api.DiscoveryTarget, error) {
+	node := chain.Nodes[chain.StartNode]
+	if node == nil {
+		return nil, fmt.Errorf("discovery chain of %q has no start node", chain.ServiceName)
+	}
+	if node.Type != api.DiscoveryGraphNodeTypeResolver || node.Resolver == nil {
+		return nil, fmt.Errorf("discovery chain of %q starts with a %s, which gRPC targets do not support",
+			chain.ServiceName, node.Type)
+	}
+
+	ids := []string{node.Resolver.Target}
+	if node.Resolver.Failover != nil {
+		ids = append(ids, node.Resolver.Failover.Targets...)
+	}
+	targets := make([]*api.DiscoveryTarget, 0, len(ids))
+	for _, id := range ids {
+		target, ok := chain.Targets[id]
+		if !ok {
+			return nil, fmt.Errorf("discovery chain of %q has no target %q", chain.ServiceName, id)
+		}
+		if target.Peer != "" {
+			return nil, fmt.Errorf("discovery chain of %q routes to peer %q, which gRPC targets do not support",
+				chain.ServiceName, target.Peer)
+		}
+		targets = append(targets, target)
+	}
+	return targets, nil
+}
+
+// watchTargets replaces the watches of the current targets with watches of
+// the given ones.
+func (r *grpcResolver) watchTargets(targets []*api.DiscoveryTarget) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.cancelTargets != nil {
+		r.cancelTargets()
+	}
+	ctx, cancel := context.WithCancel(r.ctx)
+	r.cancelTargets = cancel
+	r.targets = make([]string, 0, len(targets))
+	r.instances = make(map[string][]resolver.Address, len(targets))
+
+	for _, target := range targets {
+		r.targets = append(r.targets, target.ID)
+		r.wg.Add(1)
+		go r.watchTarget(ctx, target)
+	}
+}
+
+// watchTarget watches the healthy instances of a target until ctx is done.
+func (r *grpcResolver) watchTarget(ctx context.Context, target *api.DiscoveryTarget) {
+	defer r.wg.Done()
+
+	var index uint64
+	for {
+		q := &api.QueryOptions{
+			AllowStale: true,
+			Namespace:  connect.DefaultAsEmpty(target.Namespace),
+			Partition:  connect.DefaultAsEmpty(target.Partition),
+			Datacenter: target.Datacenter,
+			Filter:     target.Subset.Filter,
+			WaitIndex:  index,
+		}
+		entries, meta, err := r.client.Health().Connect(target.Service, "", true, q.WithContext(ctx))
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			r.logger.Warn("failed to fetch healthy instances",
+				"discovery_chain_target", target.ID,
+				"error", err,
+			)
+			r.cc.ReportError(err)
+			if !r.wait() {
+				return
+			}
+			continue
+		}
+		index = meta.LastIndex
+
+		addrs := make([]resolver.Address, 0, len(entries))
+		for _, entry := range entries {
+			addr, ok := grpcServiceEntryAddress(entry)
+			if !ok {
+				continue
+			}
+			addrs = append(addrs, addr)
+		}
+		r.setInstances(ctx, target.ID, addrs)
+	}
+}
+
+// setInstances records the addresses of a target and, once all current
+// targets have been resolved, updates the client connection with the
+// instances of the first target in failover order that has any.
+func (r *grpcResolver) setInstances(ctx context.Context, targetID string, addrs []resolver.Address) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	// The targets changed since the query was made.
+	if ctx.Err() != nil {
+		return
+	}
+
+	r.instances[targetID] = addrs
+	if len(r.instances) < len(r.targets) {
+		return
+	}
+
+	for _, id := range r.targets {
+		if len(r.instances[id]) == 0 {
+			continue
+		}
+		if err := r.cc.UpdateState(resolver.State{Addresses: r.instances[id]}); err != nil {
+			r.logger.Debug("failed to update addresses", "error", err)
+		}
+		return
+	}
+	r.cc.ReportError(fmt.Errorf("no healthy instances found for %q", r.name))
+}
+
+// wait waits before retrying a failed query, and returns false if the
+// resolver was closed in the meantime.
+func (r *grpcResolver) wait() bool {
+	select {
+	case <-time.After(grpcResolverRetryWait):
+		return true
+	case <-r.ctx.Done():
+		return false
+	}
+}
+
+// grpcServiceEntryAddress returns the address of a Connect service instance
+// along with the identity it must present.
+func grpcServiceEntryAddress(entry *api.ServiceEntry) (resolver.Address, bool) {
+	addr := entry.Service.Address
+	if addr == "" {
+		addr = entry.Node.Address
+	}
+
+	var service string
+	switch {
+	case entry.Service.Connect != nil && entry.Service.Connect.Native:
+		service = entry.Service.Service
+	case entry.Service.Proxy != nil:
+		service = entry.Service.Proxy.DestinationServiceName
+	}
+	if service == "" {
+		return resolver.Address{}, false
+	}
+
+	certURI := connect.SpiffeIDService{
+		// No host since we don't validate trust domain here (we rely on x509 to
+		// prove trust).
+		Namespace:  entry.Service.Namespace,
+		Partition:  entry.Service.Partition,
+		Datacenter: entry.Node.Datacenter,
+		Service:    service,
+	}
+
+	return resolver.Address{
+		Addr:       ipaddr.FormatAddressPort(addr, entry.Service.Port),
+		Attributes: grpcAddressAttributes(certURI),
+	}, true
+}
@@ -0,0 +1,265 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/resolver/manual"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/consul/agent"
+	"github.com/hashicorp/consul/agent/connect"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/sdk/freeport"
+	"github.com/hashicorp/consul/sdk/testutil/retry"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+// testGRPCServer runs a gRPC server with the health service for the given
+// Connect service and returns its address.
+func testGRPCServer(t *testing.T, s *Service, addr string) string {
+	lis, err := net.Listen("tcp", addr)
+	require.NoError(t, err)
+
+	srv := grpc.NewServer(s.GRPCServerOptions()...)
+	healthpb.RegisterHealthServer(srv, health.NewServer())
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	return lis.Addr().String()
+}
+
+func TestService_GRPCCredentials(t *testing.T) {
+	ca := connect.TestCA(t, nil)
+
+	server := TestService(t, "db", ca)
+	addr := testGRPCServer(t, server, "127.0.0.1:0")
+
+	client := TestService(t, "web", ca)
+
+	cases := map[string]struct {
+		certURI connect.CertURI
+		wantErr bool
+	}{
+		"matching identity": {
+			certURI: connect.TestSpiffeIDService(t, "db"),
+		},
+		"mismatched identity": {
+			certURI: connect.TestSpiffeIDService(t, "api"),
+			wantErr: true,
+		},
+		"no identity": {
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			res := manual.NewBuilderWithScheme("test")
+			address := resolver.Address{Addr: addr}
+			if tc.certURI != nil {
+				address.Attributes = grpcAddressAttributes(tc.certURI)
+			}
+			res.InitialState(resolver.State{Addresses: []resolver.Address{address}})
+
+			conn, err := grpc.Dial("test:///db",
+				grpc.WithTransportCredentials(client.GRPCClientCredentials()),
+				grpc.WithResolvers(res),
+			)
+			require.NoError(t, err)
+			defer conn.Close()
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+			resp, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.Status)
+		})
+	}
+}
+
+func TestService_GRPC(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	// The agent is shut down last, after the services, the gRPC server and
+	// the client connection that use it.
+	a := agent.StartTestAgent(t, agent.TestAgent{Name: "007"})
+	t.Cleanup(func() { a.Shutdown() })
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+	client := a.Client()
+
+	// Register db as a Connect native gRPC service that only allows web to
+	// check its health.
+	port := freeport.GetOne(t)
+	require.NoError(t, client.Agent().ServiceRegister(&api.AgentServiceRegistration{
+		Name:    "db",
+		Port:    port,
+		Address: "127.0.0.1",
+		Connect: &api.AgentServiceConnect{Native: true},
+	}))
+	require.NoError(t, client.Agent().ServiceRegister(&api.AgentServiceRegistration{
+		Name:    "web",
+		Port:    8080,
+		Connect: &api.AgentServiceConnect{Native: true},
+	}))
+	_, _, err := client.ConfigEntries().Set(&api.ServiceConfigEntry{
+		Kind:     api.ServiceDefaults,
+		Name:     "db",
+		Protocol: "grpc",
+	}, nil)
+	require.NoError(t, err)
+	_, _, err = client.ConfigEntries().Set(&api.ServiceIntentionsConfigEntry{
+		Kind: api.ServiceIntentions,
+		Name: "db",
+		Sources: []*api.SourceIntention{
+			{
+				Name: "web",
+				Permissions: []*api.IntentionPermission{
+					{
+						Action: api.IntentionActionAllow,
+						HTTP:   &api.IntentionHTTPPermission{PathExact: "/grpc.health.v1.Health/Check"},
+					},
+					{
+						Action: api.IntentionActionDeny,
+						HTTP:   &api.IntentionHTTPPermission{PathPrefix: "/"},
+					},
+				},
+			},
+		},
+	}, nil)
+	require.NoError(t, err)
+
+	newService := func(name string) *Service {
+		s, err := NewService(name, client)
+		require.NoError(t, err)
+		t.Cleanup(func() { s.Close() })
+		select {
+		case <-s.ReadyWait():
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timeout waiting for %s Service.ReadyWait after 5s", name)
+		}
+		return s
+	}
+	db := newService("db")
+	web := newService("web")
+
+	testGRPCServer(t, db, fmt.Sprintf("127.0.0.1:%d", port))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	conn, err := web.GRPCDial(ctx, "consul-connect:///db")
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	healthClient := healthpb.NewHealthClient(conn)
+
+	retry.Run(t, func(r *retry.R) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		resp, err := healthClient.Check(ctx, &healthpb.HealthCheckRequest{})
+		r.Check(err)
+		if resp.Status != healthpb.HealthCheckResponse_SERVING {
+			r.Fatalf("unexpected status %s", resp.Status)
+		}
+	})
+
+	stream, err := healthClient.Watch(ctx, &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestGRPCResolverBuilder_InvalidTarget(t *testing.T) {
+	client, err := api.NewClient(api.DefaultConfig())
+	require.NoError(t, err)
+	s, err := NewService("web", client)
+	require.NoError(t, err)
+	defer s.Close()
+
+	_, err = grpc.Dial("consul-connect:///db/extra", s.GRPCDialOptions()...)
+	require.ErrorContains(t, err, "invalid gRPC target")
+}
+
+func TestGRPCChainTargets(t *testing.T) {
+	targets := map[string]*api.DiscoveryTarget{
+		"db.default.default.dc1":   {ID: "db.default.default.dc1", Service: "db", Datacenter: "dc1"},
+		"db.default.default.dc2":   {ID: "db.default.default.dc2", Service: "db", Datacenter: "dc2"},
+		"db.default.default.peer1": {ID: "db.default.default.peer1", Service: "db", Peer: "peer1"},
+	}
+	resolverNode := func(target string, failover ...string) *api.DiscoveryGraphNode {
+		node := &api.DiscoveryGraphNode{
+			Type:     api.DiscoveryGraphNodeTypeResolver,
+			Resolver: &api.DiscoveryResolver{Target: target},
+		}
+		if len(failover) > 0 {
+			node.Resolver.Failover = &api.DiscoveryFailover{Targets: failover}
+		}
+		return node
+	}
+
+	cases := map[string]struct {
+		node   *api.DiscoveryGraphNode
+		expIDs []string
+		expErr string
+	}{
+		"resolver": {
+			node:   resolverNode("db.default.default.dc1"),
+			expIDs: []string{"db.default.default.dc1"},
+		},
+		"failover in order": {
+			node:   resolverNode("db.default.default.dc2", "db.default.default.dc1"),
+			expIDs: []string{"db.default.default.dc2", "db.default.default.dc1"},
+		},
+		"router": {
+			node:   &api.DiscoveryGraphNode{Type: api.DiscoveryGraphNodeTypeRouter},
+			expErr: "starts with a router",
+		},
+		"splitter": {
+			node:   &api.DiscoveryGraphNode{Type: api.DiscoveryGraphNodeTypeSplitter},
+			expErr: "starts with a splitter",
+		},
+		"peer": {
+			node:   resolverNode("db.default.default.dc1", "db.default.default.peer1"),
+			expErr: `routes to peer "peer1"`,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			chain := &api.CompiledDiscoveryChain{
+				ServiceName: "db",
+				StartNode:   "start",
+				Nodes:       map[string]*api.DiscoveryGraphNode{"start": tc.node},
+				Targets:     targets,
+			}
+			out, err := grpcChainTargets(chain)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			var ids []string
+			for _, target := range out {
+				ids = append(ids, target.ID)
+			}
+			require.Equal(t, tc.expIDs, ids)
+		})
+	}
+}
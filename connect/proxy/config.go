@@ -73,6 +73,17 @@ type PublicListenerConfig struct {
 	// handshake. Setting this low avoids DOS by malicious clients holding
 	// resources open. Defaults to 10000 (10s).
 	HandshakeTimeoutMs int `json:"handshake_timeout_ms" hcl:"handshake_timeout_ms" mapstructure:"handshake_timeout_ms"`
+
+	// Protocol is the protocol spoken by the proxied application. For "http",
+	// "http2" and "grpc" the public listener proxies individual requests and
+	// enforces L7 intentions on each of them. Anything else is proxied as
+	// plain TCP. Defaults to "tcp".
+	Protocol string `json:"protocol" hcl:"protocol" mapstructure:"protocol"`
+
+	// LocalRequestTimeoutMs is the timeout for HTTP requests to the local
+	// application. It is only used for HTTP based protocols. Defaults to 0,
+	// which means no timeout.
+	LocalRequestTimeoutMs int `json:"local_request_timeout_ms" hcl:"local_request_timeout_ms" mapstructure:"local_request_timeout_ms"`
 }
 
 // applyDefaults sets zero-valued params to a reasonable default.
@@ -86,6 +97,9 @@ func (plc *PublicListenerConfig) applyDefaults() {
 	if plc.BindAddress == "" {
 		plc.BindAddress = "0.0.0.0"
 	}
+	if plc.Protocol == "" {
+		plc.Protocol = "tcp"
+	}
 }
 
 // UpstreamConfig is an alias for api.Upstream so we can parse in a compatible
@@ -101,6 +115,13 @@ func (uc *UpstreamConfig) ConnectTimeout() time.Duration {
 	return 10000 * time.Millisecond
 }
 
+// Protocol returns the protocol override from the nested config struct, or
+// an empty string if the protocol of the discovery chain should be used.
+func (uc *UpstreamConfig) Protocol() string {
+	protocol, _ := uc.Config["protocol"].(string)
+	return protocol
+}
+
 // applyDefaults sets zero-valued params to a reasonable default.
 func (uc *UpstreamConfig) applyDefaults() {
 	if uc.DestinationType == "" {
@@ -134,7 +134,8 @@ func TestAgentConfigWatcherSidecarProxy(t *testing.T) {
 			BindPort:              21000,
 			LocalServiceAddress:   "127.0.0.1:8080",
 			HandshakeTimeoutMs:    999,
-			LocalConnectTimeoutMs: 1000, // from applyDefaults
+			LocalConnectTimeoutMs: 1000,  // from applyDefaults
+			Protocol:              "tcp", // from applyDefaults
 		},
 		Upstreams: []UpstreamConfig{
 			{
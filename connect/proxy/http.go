@@ -0,0 +1,621 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proxy
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	metrics "github.com/armon/go-metrics"
+	"github.com/hashicorp/go-hclog"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+
+	agConnect "github.com/hashicorp/consul/agent/connect"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/connect"
+	"github.com/hashicorp/consul/ipaddr"
+)
+
+// discoveryChainRetryWait is how long to wait before retrying a failed
+// discovery chain query.
+const discoveryChainRetryWait = 5 * time.Second
+
+// HTTPListener is a proxy listener for HTTP based protocols. Unlike Listener,
+// which copies bytes between two connections, it proxies each request
+// separately so that L7 intentions and discovery chain routes apply to it.
+type HTTPListener struct {
+	// Service is the connect service instance to use.
+	Service *connect.Service
+
+	// listenFunc and bindAddr are set by type-specific constructors.
+	listenFunc func() (net.Listener, error)
+	bindAddr   string
+
+	// server serves all connections of upstream listeners, and the TLS
+	// connections of public listeners that use ALPN to negotiate a protocol.
+	server *http.Server
+
+	// h2Server serves the TLS connections of HTTP/2 public listeners from
+	// clients that speak HTTP/2 with prior knowledge, like Envoy.
+	h2Server *http2.Server
+
+	// isPublic is true for public mTLS listeners and http2 is true if the
+	// protocol requires HTTP/2.
+	isPublic bool
+	http2    bool
+
+	// handshakeTimeout is the timeout for TLS handshakes on public listeners.
+	handshakeTimeout time.Duration
+
+	stopFlag int32
+	stopChan chan struct{}
+
+	// listeningChan is closed when listener is opened successfully.
+	listeningChan chan struct{}
+
+	// listenerLock guards access to the listener field
+	listenerLock sync.Mutex
+	listener     net.Listener
+
+	// connsLock guards conns, which tracks the connections served by h2Server
+	// so that they are closed with the listener.
+	connsLock sync.Mutex
+	conns     map[net.Conn]struct{}
+
+	logger hclog.Logger
+}
+
+// NewPublicHTTPListener returns a listener setup to accept public mTLS
+// connections and proxy the HTTP requests received over them to the local
+// application. Each request is authorized separately, including the L7
+// permissions of any matching intention.
+func NewPublicHTTPListener(svc *connect.Service, cfg PublicListenerConfig,
+	logger hclog.Logger) *HTTPListener {
+	bindAddr := ipaddr.FormatAddressPort(cfg.BindAddress, cfg.BindPort)
+	logger = logger.Named(publicListenerPrefix)
+	labels := []metrics.Label{{Name: "dst", Value: svc.Name()}}
+
+	dialer := &net.Dialer{
+		Timeout: time.Duration(cfg.LocalConnectTimeoutMs) * time.Millisecond,
+	}
+	proxy := &httputil.ReverseProxy{
+		Director: func(r *http.Request) {
+			r.URL.Scheme = "http"
+			r.URL.Host = cfg.LocalServiceAddress
+		},
+		Transport:     newHTTPTransport(dialer.DialContext, isHTTP2Protocol(cfg.Protocol)),
+		FlushInterval: -1,
+		ErrorHandler:  proxyErrorHandler(logger),
+	}
+	timeout := time.Duration(cfg.LocalRequestTimeoutMs) * time.Millisecond
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		metrics.IncrCounterWithLabels([]string{publicListenerPrefix, "requests"}, 1, labels)
+
+		allowed, reason, err := svc.AuthorizeHTTPRequest(r)
+		if err != nil {
+			logger.Error("failed to authorize request", "error", err)
+		}
+		if !allowed {
+			logger.Debug("request denied",
+				"method", r.Method,
+				"path", r.URL.Path,
+				"reason", reason,
+			)
+			metrics.IncrCounterWithLabels([]string{publicListenerPrefix, "denied"}, 1, labels)
+			http.Error(w, "RBAC: access denied", http.StatusForbidden)
+			return
+		}
+
+		serveWithTimeout(proxy, w, r, timeout)
+	}
+
+	l := newHTTPListener(svc, bindAddr, http.HandlerFunc(handler), logger)
+	l.isPublic = true
+	l.http2 = isHTTP2Protocol(cfg.Protocol)
+	l.handshakeTimeout = time.Duration(cfg.HandshakeTimeoutMs) * time.Millisecond
+	// Like the TLS handshake, keep clients from holding connections open
+	// without sending requests.
+	l.server.ReadHeaderTimeout = l.handshakeTimeout
+	l.server.IdleTimeout = l.handshakeTimeout
+	l.h2Server.IdleTimeout = l.handshakeTimeout
+	l.listenFunc = func() (net.Listener, error) {
+		return tls.Listen("tcp", bindAddr, svc.HTTPServerTLSConfig())
+	}
+	return l
+}
+
+// newUpstreamHTTPListenerWithResolver returns a listener setup to accept local
+// HTTP requests and route them through the discovery chain of the upstream to
+// a Connect service instance. The returned handler's chain is updated by
+// Proxy.watchUpstream.
+func newUpstreamHTTPListenerWithResolver(svc *connect.Service, cfg UpstreamConfig,
+	chain *api.CompiledDiscoveryChain,
+	resolverFunc func(*api.DiscoveryTarget) (connect.Resolver, error),
+	logger hclog.Logger) (*HTTPListener, *upstreamHTTPHandler) {
+	bindAddr := ipaddr.FormatAddressPort(cfg.LocalBindAddress, cfg.LocalBindPort)
+	logger = logger.Named(upstreamListenerPrefix)
+
+	h := &upstreamHTTPHandler{
+		svc:          svc,
+		cfg:          cfg,
+		resolverFunc: resolverFunc,
+		targets:      make(map[string]*api.DiscoveryTarget),
+		failover:     make(map[string][]string),
+		logger:       logger,
+		metricLabels: []metrics.Label{
+			{Name: "src", Value: svc.Name()},
+			{Name: "dst_type", Value: string(cfg.DestinationType)},
+			{Name: "dst", Value: cfg.DestinationName},
+		},
+	}
+	h.setChain(chain)
+	h.proxy = &httputil.ReverseProxy{
+		// The request is already directed to the target by ServeHTTP.
+		Director:      func(*http.Request) {},
+		Transport:     newHTTPTLSTransport(h.dialTarget, isHTTP2Protocol(chain.Protocol)),
+		FlushInterval: -1,
+		ErrorHandler:  proxyErrorHandler(logger),
+	}
+
+	var handler http.Handler = h
+	if isHTTP2Protocol(chain.Protocol) {
+		// Local applications speak HTTP/2 over plain text.
+		handler = h2c.NewHandler(h, &http2.Server{})
+	}
+
+	l := newHTTPListener(svc, bindAddr, handler, logger)
+	l.listenFunc = func() (net.Listener, error) {
+		return net.Listen("tcp", bindAddr)
+	}
+	return l, h
+}
+
+func newHTTPListener(svc *connect.Service, bindAddr string, handler http.Handler,
+	logger hclog.Logger) *HTTPListener {
+	l := &HTTPListener{
+		Service:       svc,
+		bindAddr:      bindAddr,
+		server:        &http.Server{Handler: handler},
+		h2Server:      &http2.Server{},
+		stopChan:      make(chan struct{}),
+		listeningChan: make(chan struct{}),
+		conns:         make(map[net.Conn]struct{}),
+		logger:        logger,
+	}
+	l.server.ErrorLog = logger.StandardLogger(&hclog.StandardLoggerOptions{InferLevels: true})
+	http2.ConfigureServer(l.server, l.h2Server)
+	return l
+}
+
+// Serve runs the listener until it is stopped. It is an error to call Serve
+// more than once for any given HTTPListener instance.
+func (l *HTTPListener) Serve() error {
+	// Ensure we mark state closed if we fail before Close is called externally.
+	defer l.Close()
+
+	if atomic.LoadInt32(&l.stopFlag) != 0 {
+		return errors.New("serve called on a closed listener")
+	}
+
+	listener, err := l.listenFunc()
+	if err != nil {
+		return err
+	}
+
+	l.setListener(listener)
+
+	if !l.isPublic {
+		close(l.listeningChan)
+		err := l.server.Serve(listener)
+		if errors.Is(err, http.ErrServerClosed) || atomic.LoadInt32(&l.stopFlag) == 1 {
+			return nil
+		}
+		return err
+	}
+
+	// Public listeners complete the TLS handshake themselves to detect
+	// clients that speak HTTP/2 without negotiating it, and pass all other
+	// connections on to the HTTP server.
+	tlsConns := &connListener{
+		addr:   listener.Addr(),
+		conns:  make(chan net.Conn),
+		closed: make(chan struct{}),
+	}
+	go l.server.Serve(tlsConns)
+
+	close(l.listeningChan)
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if atomic.LoadInt32(&l.stopFlag) == 1 {
+				return nil
+			}
+			return err
+		}
+
+		go l.handleTLSConn(conn, tlsConns)
+	}
+}
+
+// handleTLSConn is the connection handler goroutine for public listeners.
+func (l *HTTPListener) handleTLSConn(conn net.Conn, tlsConns *connListener) {
+	tlsConn, ok := conn.(*tls.Conn)
+	if !ok || !l.http2 {
+		tlsConns.push(conn)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), l.handshakeTimeout)
+	err := tlsConn.HandshakeContext(ctx)
+	cancel()
+	if err != nil {
+		l.logger.Debug("TLS handshake failed", "error", err)
+		conn.Close()
+		return
+	}
+
+	if tlsConn.ConnectionState().NegotiatedProtocol != "" {
+		tlsConns.push(conn)
+		return
+	}
+
+	if !l.trackConn(conn) {
+		conn.Close()
+		return
+	}
+	defer l.untrackConn(conn)
+
+	l.h2Server.ServeConn(conn, &http2.ServeConnOpts{
+		BaseConfig: l.server,
+		Handler:    l.server.Handler,
+	})
+}
+
+func (l *HTTPListener) trackConn(conn net.Conn) bool {
+	l.connsLock.Lock()
+	defer l.connsLock.Unlock()
+	if atomic.LoadInt32(&l.stopFlag) != 0 {
+		return false
+	}
+	l.conns[conn] = struct{}{}
+	return true
+}
+
+func (l *HTTPListener) untrackConn(conn net.Conn) {
+	l.connsLock.Lock()
+	defer l.connsLock.Unlock()
+	delete(l.conns, conn)
+	conn.Close()
+}
+
+// Close terminates the listener and all active connections.
+func (l *HTTPListener) Close() error {
+	// Prevent the listener from being started.
+	oldFlag := atomic.SwapInt32(&l.stopFlag, 1)
+	if oldFlag != 0 {
+		return nil
+	}
+
+	// Stop the current listener and stop accepting new requests.
+	if listener := l.getListener(); listener != nil {
+		listener.Close()
+	}
+
+	close(l.stopChan)
+
+	l.server.Close()
+
+	l.connsLock.Lock()
+	for conn := range l.conns {
+		conn.Close()
+	}
+	l.connsLock.Unlock()
+
+	return nil
+}
+
+// Wait for the listener to be ready to accept connections.
+func (l *HTTPListener) Wait() {
+	<-l.listeningChan
+}
+
+// BindAddr returns the address the listen is bound to.
+func (l *HTTPListener) BindAddr() string {
+	return l.bindAddr
+}
+
+func (l *HTTPListener) setListener(listener net.Listener) {
+	l.listenerLock.Lock()
+	l.listener = listener
+	l.listenerLock.Unlock()
+}
+
+func (l *HTTPListener) getListener() net.Listener {
+	l.listenerLock.Lock()
+	defer l.listenerLock.Unlock()
+	return l.listener
+}
+
+// upstreamHTTPHandler routes the requests of an upstream listener through its
+// discovery chain.
+type upstreamHTTPHandler struct {
+	svc          *connect.Service
+	cfg          UpstreamConfig
+	resolverFunc func(*api.DiscoveryTarget) (connect.Resolver, error)
+	proxy        *httputil.ReverseProxy
+	logger       hclog.Logger
+	metricLabels []metrics.Label
+
+	// lock guards router, targets and failover. Targets are never removed,
+	// so that requests in flight can still dial them after the chain
+	// changes.
+	lock    sync.RWMutex
+	router  *httpRouter
+	targets map[string]*api.DiscoveryTarget
+
+	// failover holds the IDs of the failover targets of each target, in the
+	// order they are tried.
+	failover map[string][]string
+}
+
+// ServeHTTP implements http.Handler.
+func (h *upstreamHTTPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	metrics.IncrCounterWithLabels([]string{upstreamListenerPrefix, "requests"}, 1, h.metricLabels)
+
+	h.lock.RLock()
+	router := h.router
+	h.lock.RUnlock()
+
+	result, err := router.route(r)
+	if err != nil {
+		h.logger.Debug("failed to route request", "path", r.URL.Path, "error", err)
+		http.Error(w, "no route matched the request", http.StatusNotFound)
+		return
+	}
+
+	// Direct the request at the target. Its ID is used as the host so that
+	// each target gets its own connection pool.
+	out := r.Clone(r.Context())
+	out.URL.Scheme = "https"
+	out.URL.Host = result.Target.ID
+
+	var timeout time.Duration
+	if dest := result.Destination; dest != nil {
+		timeout = dest.RequestTimeout
+		if dest.PrefixRewrite != "" && result.Match != nil {
+			switch {
+			case result.Match.PathExact != "":
+				out.URL.Path = dest.PrefixRewrite
+			case result.Match.PathPrefix != "":
+				out.URL.Path = dest.PrefixRewrite + strings.TrimPrefix(out.URL.Path, result.Match.PathPrefix)
+			}
+			out.URL.RawPath = ""
+		}
+	}
+
+	serveWithTimeout(h.proxy, w, out, timeout)
+}
+
+// dialTarget dials an instance of the discovery chain target whose ID is the
+// host of addr. If that fails, its failover targets are dialed in order.
+func (h *upstreamHTTPHandler) dialTarget(ctx context.Context, network, addr string) (net.Conn, error) {
+	id, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		id = addr
+	}
+
+	h.lock.RLock()
+	target, ok := h.targets[id]
+	var targets []*api.DiscoveryTarget
+	if ok {
+		targets = append(targets, target)
+		for _, failoverID := range h.failover[id] {
+			if t, ok := h.targets[failoverID]; ok {
+				targets = append(targets, t)
+			}
+		}
+	}
+	h.lock.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown discovery chain target %q", id)
+	}
+
+	for i, target := range targets {
+		var conn net.Conn
+		conn, err = h.dialOne(ctx, target)
+		if err == nil {
+			return conn, nil
+		}
+		if i < len(targets)-1 {
+			h.logger.Debug("failed to dial discovery chain target, failing over",
+				"target", target.ID, "error", err)
+		}
+	}
+	return nil, err
+}
+
+// dialOne dials an instance of a single discovery chain target.
+func (h *upstreamHTTPHandler) dialOne(ctx context.Context, target *api.DiscoveryTarget) (net.Conn, error) {
+	resolver, err := h.resolverFunc(target)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := target.ConnectTimeout
+	if timeout == 0 {
+		timeout = h.cfg.ConnectTimeout()
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	return h.svc.Dial(ctx, resolver)
+}
+
+func (h *upstreamHTTPHandler) setChain(chain *api.CompiledDiscoveryChain) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	h.router = newHTTPRouter(chain)
+	for id, target := range chain.Targets {
+		h.targets[id] = target
+	}
+	for _, node := range chain.Nodes {
+		if node.Type != api.DiscoveryGraphNodeTypeResolver || node.Resolver == nil {
+			continue
+		}
+		var failover []string
+		if node.Resolver.Failover != nil {
+			failover = node.Resolver.Failover.Targets
+		}
+		h.failover[node.Resolver.Target] = failover
+	}
+}
+
+// fetchDiscoveryChain fetches the discovery chain for an upstream. If index
+// is non-zero, it blocks until the chain changes.
+func fetchDiscoveryChain(ctx context.Context, client *api.Client, cfg UpstreamConfig,
+	index uint64) (*api.CompiledDiscoveryChain, *api.QueryMeta, error) {
+	opts := &api.DiscoveryChainOptions{
+		EvaluateInDatacenter: cfg.Datacenter,
+		OverrideProtocol:     cfg.Protocol(),
+	}
+	if _, ok := cfg.Config["connect_timeout_ms"]; ok {
+		opts.OverrideConnectTimeout = cfg.ConnectTimeout()
+	}
+	q := &api.QueryOptions{
+		Namespace: agConnect.DefaultAsEmpty(cfg.DestinationNamespace),
+		Partition: agConnect.DefaultAsEmpty(cfg.DestinationPartition),
+		WaitIndex: index,
+	}
+	resp, meta, err := client.DiscoveryChain().Get(cfg.DestinationName, opts, q.WithContext(ctx))
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp.Chain, meta, nil
+}
+
+// UpstreamTargetResolverFuncFromClient returns a closure that captures a
+// consul client and when called provides a ConsulResolver for the given
+// discovery chain target of the upstream. Targets in peered clusters can't
+// be resolved, since the built-in proxy doesn't dial through mesh gateways.
+func UpstreamTargetResolverFuncFromClient(client *api.Client,
+	cfg UpstreamConfig) func(*api.DiscoveryTarget) (connect.Resolver, error) {
+	return func(target *api.DiscoveryTarget) (connect.Resolver, error) {
+		if target.Peer != "" {
+			return nil, fmt.Errorf("discovery chain target %q is in peer %q, which is not supported",
+				target.ID, target.Peer)
+		}
+		return &connect.ConsulResolver{
+			Client:     client,
+			Namespace:  agConnect.DefaultAsEmpty(target.Namespace),
+			Partition:  agConnect.DefaultAsEmpty(target.Partition),
+			Name:       target.Service,
+			Type:       connect.ConsulResolverTypeService,
+			Datacenter: target.Datacenter,
+			Filter:     target.Subset.Filter,
+		}, nil
+	}
+}
+
+type dialFunc func(ctx context.Context, network, addr string) (net.Conn, error)
+
+// newHTTPTransport returns a transport for plain text connections to the
+// local application.
+func newHTTPTransport(dial dialFunc, http2Only bool) http.RoundTripper {
+	if http2Only {
+		return &http2.Transport{
+			AllowHTTP: true,
+			DialTLSContext: func(ctx context.Context, network, addr string, _ *tls.Config) (net.Conn, error) {
+				return dial(ctx, network, addr)
+			},
+		}
+	}
+	return &http.Transport{DialContext: dial}
+}
+
+// newHTTPTLSTransport returns a transport for mTLS connections to Connect
+// services. Like Envoy, it speaks HTTP/2 with prior knowledge if the protocol
+// requires it, since proxies don't negotiate a protocol.
+func newHTTPTLSTransport(dial dialFunc, http2Only bool) http.RoundTripper {
+	if http2Only {
+		return &http2.Transport{
+			DialTLSContext: func(ctx context.Context, network, addr string, _ *tls.Config) (net.Conn, error) {
+				return dial(ctx, network, addr)
+			},
+		}
+	}
+	return &http.Transport{DialTLSContext: dial, ForceAttemptHTTP2: true}
+}
+
+// serveWithTimeout proxies the request, canceling it after timeout if it is
+// non-zero.
+func serveWithTimeout(proxy *httputil.ReverseProxy, w http.ResponseWriter, r *http.Request, timeout time.Duration) {
+	if timeout > 0 {
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+		r = r.WithContext(ctx)
+	}
+	proxy.ServeHTTP(w, r)
+}
+
+// proxyErrorHandler returns an error handler for httputil.ReverseProxy that
+// responds with the same status codes as Envoy.
+func proxyErrorHandler(logger hclog.Logger) func(http.ResponseWriter, *http.Request, error) {
+	return func(w http.ResponseWriter, r *http.Request, err error) {
+		if errors.Is(err, context.DeadlineExceeded) {
+			http.Error(w, "upstream request timeout", http.StatusGatewayTimeout)
+			return
+		}
+		logger.Error("failed to proxy request", "error", err)
+		http.Error(w, "upstream connect error", http.StatusServiceUnavailable)
+	}
+}
+
+// connListener is a net.Listener for connections that were accepted
+// elsewhere and handed to it with push.
+type connListener struct {
+	addr      net.Addr
+	conns     chan net.Conn
+	closeOnce sync.Once
+	closed    chan struct{}
+}
+
+func (cl *connListener) push(conn net.Conn) {
+	select {
+	case cl.conns <- conn:
+	case <-cl.closed:
+		conn.Close()
+	}
+}
+
+// Accept implements net.Listener.
+func (cl *connListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-cl.conns:
+		return conn, nil
+	case <-cl.closed:
+		return nil, net.ErrClosed
+	}
+}
+
+// Close implements net.Listener.
+func (cl *connListener) Close() error {
+	cl.closeOnce.Do(func() { close(cl.closed) })
+	return nil
+}
+
+// Addr implements net.Listener.
+func (cl *connListener) Addr() net.Addr {
+	return cl.addr
+}
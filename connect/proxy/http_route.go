@@ -0,0 +1,294 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proxy
+
+import (
+	"fmt"
+	"math/rand"
+	"net/http"
+	"regexp"
+	"strings"
+
+	agConnect "github.com/hashicorp/consul/agent/connect"
+	"github.com/hashicorp/consul/api"
+)
+
+// isHTTPProtocol reports whether the protocol is proxied per request rather
+// than per connection.
+func isHTTPProtocol(protocol string) bool {
+	switch protocol {
+	case "http", "http2", "grpc":
+		return true
+	}
+	return false
+}
+
+// isHTTP2Protocol reports whether the protocol requires HTTP/2.
+func isHTTP2Protocol(protocol string) bool {
+	return protocol == "http2" || protocol == "grpc"
+}
+
+// httpRouter routes HTTP requests through a compiled discovery chain.
+type httpRouter struct {
+	chain *api.CompiledDiscoveryChain
+
+	// matches holds the compiled match criteria of the routes of each router
+	// node, in the same order as its routes.
+	matches map[string][]*httpRouteMatch
+}
+
+// newHTTPRouter returns a router for the chain. The match criteria of its
+// routes are compiled up front rather than for every request.
+func newHTTPRouter(chain *api.CompiledDiscoveryChain) *httpRouter {
+	r := &httpRouter{
+		chain:   chain,
+		matches: make(map[string][]*httpRouteMatch),
+	}
+	for name, node := range chain.Nodes {
+		if node.Type != api.DiscoveryGraphNodeTypeRouter {
+			continue
+		}
+		matches := make([]*httpRouteMatch, 0, len(node.Routes))
+		for _, route := range node.Routes {
+			var match *api.ServiceRouteHTTPMatch
+			if route.Definition != nil && route.Definition.Match != nil {
+				match = route.Definition.Match.HTTP
+			}
+			matches = append(matches, compileRouteMatch(match))
+		}
+		r.matches[name] = matches
+	}
+	return r
+}
+
+// httpRouteResult is where a request is routed to.
+type httpRouteResult struct {
+	// Target is the discovery chain target to send the request to.
+	Target *api.DiscoveryTarget
+
+	// Destination is the destination of the matching service-router route,
+	// which may be nil if there is no router or it has no destination.
+	Destination *api.ServiceRouteDestination
+
+	// Match is the match criteria of the matching service-router route.
+	Match *api.ServiceRouteHTTPMatch
+}
+
+// route walks the discovery chain for the request in the same order as
+// Envoy: the first matching route of a router wins, splitters pick a branch
+// at random according to their weights, and resolvers end the walk.
+func (r *httpRouter) route(req *http.Request) (*httpRouteResult, error) {
+	var result httpRouteResult
+
+	nodeName := r.chain.StartNode
+	for i := 0; i <= len(r.chain.Nodes); i++ {
+		node, ok := r.chain.Nodes[nodeName]
+		if !ok {
+			return nil, fmt.Errorf("discovery chain node %q not found", nodeName)
+		}
+
+		switch node.Type {
+		case api.DiscoveryGraphNodeTypeRouter:
+			var matched *api.DiscoveryRoute
+			for i, route := range node.Routes {
+				match := r.matches[nodeName][i]
+				ok, err := match.match(req)
+				if err != nil {
+					return nil, err
+				}
+				if ok {
+					matched = route
+					result.Match = match.def
+					if route.Definition != nil {
+						result.Destination = route.Definition.Destination
+					}
+					break
+				}
+			}
+			if matched == nil {
+				return nil, fmt.Errorf("no route matched the request")
+			}
+			nodeName = matched.NextNode
+
+		case api.DiscoveryGraphNodeTypeSplitter:
+			nodeName = pickSplit(node.Splits)
+
+		case api.DiscoveryGraphNodeTypeResolver:
+			target, ok := r.chain.Targets[node.Resolver.Target]
+			if !ok {
+				return nil, fmt.Errorf("discovery chain target %q not found", node.Resolver.Target)
+			}
+			result.Target = target
+			return &result, nil
+
+		default:
+			return nil, fmt.Errorf("unknown discovery chain node type %q", node.Type)
+		}
+	}
+	return nil, fmt.Errorf("discovery chain has a cycle")
+}
+
+// pickSplit chooses the next node of a splitter at random, weighted by the
+// split weights which add up to 100.
+func pickSplit(splits []*api.DiscoverySplit) string {
+	n := rand.Float32() * 100
+	var total float32
+	for _, split := range splits {
+		total += split.Weight
+		if n < total {
+			return split.NextNode
+		}
+	}
+	return splits[len(splits)-1].NextNode
+}
+
+// httpRouteMatch is the match criteria of a service-router route, with its
+// regular expressions compiled.
+type httpRouteMatch struct {
+	def        *api.ServiceRouteHTTPMatch
+	pathRegex  *regexp.Regexp
+	headers    []agConnect.HTTPHeaderMatcher
+	queryRegex []*regexp.Regexp
+
+	// err is set if any of the regular expressions is invalid, in which case
+	// requests that reach the route fail.
+	err error
+}
+
+// compileRouteMatch compiles the match criteria of a service-router route. A
+// nil match matches every request.
+func compileRouteMatch(def *api.ServiceRouteHTTPMatch) *httpRouteMatch {
+	m := &httpRouteMatch{def: def}
+	if def == nil {
+		return m
+	}
+
+	if def.PathExact == "" && def.PathPrefix == "" && def.PathRegex != "" {
+		m.pathRegex, m.err = agConnect.CompileFullRegex(def.PathRegex)
+	}
+
+	for _, hdr := range def.Header {
+		matcher := agConnect.HTTPHeaderMatcher{
+			Exact:   hdr.Exact,
+			Prefix:  hdr.Prefix,
+			Suffix:  hdr.Suffix,
+			Present: hdr.Present,
+			Invert:  hdr.Invert,
+		}
+		if hdr.Exact == "" && hdr.Regex != "" {
+			re, err := agConnect.CompileFullRegex(hdr.Regex)
+			if err != nil && m.err == nil {
+				m.err = err
+			}
+			matcher.Regex = re
+		}
+		m.headers = append(m.headers, matcher)
+	}
+
+	m.queryRegex = make([]*regexp.Regexp, len(def.QueryParam))
+	for i, qp := range def.QueryParam {
+		if qp.Exact == "" && qp.Regex != "" {
+			re, err := agConnect.CompileFullRegex(qp.Regex)
+			if err != nil && m.err == nil {
+				m.err = err
+			}
+			m.queryRegex[i] = re
+		}
+	}
+	return m
+}
+
+// match reports whether the request matches all of the criteria of the
+// route.
+func (m *httpRouteMatch) match(req *http.Request) (bool, error) {
+	if m.err != nil {
+		return false, m.err
+	}
+	def := m.def
+	if def == nil {
+		return true, nil
+	}
+
+	path := req.URL.Path
+	switch {
+	case def.PathExact != "":
+		if path != def.PathExact {
+			return false, nil
+		}
+	case def.PathPrefix != "":
+		if !strings.HasPrefix(path, def.PathPrefix) {
+			return false, nil
+		}
+	case m.pathRegex != nil:
+		if !m.pathRegex.MatchString(path) {
+			return false, nil
+		}
+	}
+
+	for i, hdr := range def.Header {
+		value, present := requestHeader(req, hdr.Name)
+		if !m.headers[i].Match(value, present) {
+			return false, nil
+		}
+	}
+
+	query := req.URL.Query()
+	for i, qp := range def.QueryParam {
+		values, present := query[qp.Name]
+		var value string
+		if len(values) > 0 {
+			value = values[0]
+		}
+		switch {
+		case qp.Exact != "":
+			if !present || value != qp.Exact {
+				return false, nil
+			}
+		case m.queryRegex[i] != nil:
+			if !present || !m.queryRegex[i].MatchString(value) {
+				return false, nil
+			}
+		case qp.Present:
+			if !present {
+				return false, nil
+			}
+		}
+	}
+
+	if len(def.Methods) > 0 {
+		var found bool
+		for _, method := range def.Methods {
+			if method == req.Method {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// requestHeader returns the value of a request header, including the HTTP/2
+// pseudo-headers that Envoy exposes. Multiple values are joined by commas.
+func requestHeader(req *http.Request, name string) (string, bool) {
+	switch strings.ToLower(name) {
+	case ":authority":
+		return req.Host, true
+	case ":method":
+		return req.Method, true
+	case ":path":
+		return req.URL.RequestURI(), true
+	case ":scheme":
+		if req.TLS != nil {
+			return "https", true
+		}
+		return "http", true
+	}
+
+	values, present := req.Header[http.CanonicalHeaderKey(name)]
+	return strings.Join(values, ","), present
+}
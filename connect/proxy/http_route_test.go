@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proxy
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/api"
+)
+
+func TestRouteMatch(t *testing.T) {
+	cases := map[string]struct {
+		match  *api.ServiceRouteHTTPMatch
+		method string
+		target string
+		header map[string]string
+		want   bool
+	}{
+		"nil match": {
+			target: "/anything",
+			want:   true,
+		},
+		"path exact": {
+			match:  &api.ServiceRouteHTTPMatch{PathExact: "/foo"},
+			target: "/foo?bar=baz",
+			want:   true,
+		},
+		"path exact mismatch": {
+			match:  &api.ServiceRouteHTTPMatch{PathExact: "/foo"},
+			target: "/foo/bar",
+		},
+		"path prefix": {
+			match:  &api.ServiceRouteHTTPMatch{PathPrefix: "/foo/"},
+			target: "/foo/bar",
+			want:   true,
+		},
+		"path regex must match fully": {
+			match:  &api.ServiceRouteHTTPMatch{PathRegex: "/v[0-9]+"},
+			target: "/v1/users",
+		},
+		"header exact": {
+			match: &api.ServiceRouteHTTPMatch{
+				Header: []api.ServiceRouteHTTPMatchHeader{{Name: "x-debug", Exact: "1"}},
+			},
+			target: "/",
+			header: map[string]string{"X-Debug": "1"},
+			want:   true,
+		},
+		"missing header": {
+			match: &api.ServiceRouteHTTPMatch{
+				Header: []api.ServiceRouteHTTPMatchHeader{{Name: "x-debug", Exact: "1", Invert: true}},
+			},
+			target: "/",
+		},
+		"inverted presence of missing header": {
+			match: &api.ServiceRouteHTTPMatch{
+				Header: []api.ServiceRouteHTTPMatchHeader{{Name: "x-debug", Present: true, Invert: true}},
+			},
+			target: "/",
+			want:   true,
+		},
+		"authority pseudo-header": {
+			match: &api.ServiceRouteHTTPMatch{
+				Header: []api.ServiceRouteHTTPMatchHeader{{Name: ":authority", Prefix: "api."}},
+			},
+			target: "http://api.example.com/",
+			want:   true,
+		},
+		"query param regex": {
+			match: &api.ServiceRouteHTTPMatch{
+				QueryParam: []api.ServiceRouteHTTPMatchQueryParam{{Name: "v", Regex: "[0-9]+"}},
+			},
+			target: "/?v=12",
+			want:   true,
+		},
+		"query param missing": {
+			match: &api.ServiceRouteHTTPMatch{
+				QueryParam: []api.ServiceRouteHTTPMatchQueryParam{{Name: "v", Present: true}},
+			},
+			target: "/?w=1",
+		},
+		"method mismatch": {
+			match:  &api.ServiceRouteHTTPMatch{Methods: []string{"PUT", "POST"}},
+			method: "GET",
+			target: "/",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			method := tc.method
+			if method == "" {
+				method = "GET"
+			}
+			req := httptest.NewRequest(method, tc.target, nil)
+			for k, v := range tc.header {
+				req.Header.Set(k, v)
+			}
+
+			got, err := compileRouteMatch(tc.match).match(req)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestHTTPRouter_Route(t *testing.T) {
+	chain := &api.CompiledDiscoveryChain{
+		ServiceName: "api",
+		Protocol:    "http",
+		StartNode:   "router:api.default.default",
+		Nodes: map[string]*api.DiscoveryGraphNode{
+			"router:api.default.default": {
+				Type: api.DiscoveryGraphNodeTypeRouter,
+				Routes: []*api.DiscoveryRoute{
+					{
+						Definition: &api.ServiceRoute{
+							Match: &api.ServiceRouteMatch{
+								HTTP: &api.ServiceRouteHTTPMatch{PathPrefix: "/admin"},
+							},
+							Destination: &api.ServiceRouteDestination{Service: "admin"},
+						},
+						NextNode: "resolver:admin.default.default.dc1",
+					},
+					{
+						Definition: &api.ServiceRoute{
+							Match: &api.ServiceRouteMatch{
+								HTTP: &api.ServiceRouteHTTPMatch{PathPrefix: "/"},
+							},
+						},
+						NextNode: "splitter:api.default.default",
+					},
+				},
+			},
+			"splitter:api.default.default": {
+				Type: api.DiscoveryGraphNodeTypeSplitter,
+				Splits: []*api.DiscoverySplit{
+					{Weight: 100, NextNode: "resolver:api.default.default.dc1"},
+					{Weight: 0, NextNode: "resolver:admin.default.default.dc1"},
+				},
+			},
+			"resolver:api.default.default.dc1": {
+				Type:     api.DiscoveryGraphNodeTypeResolver,
+				Resolver: &api.DiscoveryResolver{Target: "api.default.default.dc1"},
+			},
+			"resolver:admin.default.default.dc1": {
+				Type:     api.DiscoveryGraphNodeTypeResolver,
+				Resolver: &api.DiscoveryResolver{Target: "admin.default.default.dc1"},
+			},
+		},
+		Targets: map[string]*api.DiscoveryTarget{
+			"api.default.default.dc1":   {ID: "api.default.default.dc1", Service: "api"},
+			"admin.default.default.dc1": {ID: "admin.default.default.dc1", Service: "admin"},
+		},
+	}
+	router := newHTTPRouter(chain)
+
+	res, err := router.route(httptest.NewRequest("GET", "/admin/users", nil))
+	require.NoError(t, err)
+	require.Equal(t, "admin.default.default.dc1", res.Target.ID)
+	require.Equal(t, "admin", res.Destination.Service)
+	require.Equal(t, "/admin", res.Match.PathPrefix)
+
+	for i := 0; i < 10; i++ {
+		res, err = router.route(httptest.NewRequest("GET", "/users", nil))
+		require.NoError(t, err)
+		require.Equal(t, "api.default.default.dc1", res.Target.ID)
+		require.Nil(t, res.Destination)
+	}
+
+	// Remove the catch-all route.
+	chain.Nodes["router:api.default.default"].Routes = chain.Nodes["router:api.default.default"].Routes[:1]
+	_, err = router.route(httptest.NewRequest("GET", "/users", nil))
+	require.EqualError(t, err, "no route matched the request")
+
+	// Invalid regular expressions are reported when a request reaches the
+	// route.
+	chain.Nodes["router:api.default.default"].Routes[0].Definition.Match.HTTP = &api.ServiceRouteHTTPMatch{PathRegex: "("}
+	router = newHTTPRouter(chain)
+	_, err = router.route(httptest.NewRequest("GET", "/users", nil))
+	require.ErrorContains(t, err, "invalid regex")
+}
@@ -0,0 +1,430 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proxy
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+
+	agConnect "github.com/hashicorp/consul/agent/connect"
+	agMetrics "github.com/hashicorp/consul/agent/metrics"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/connect"
+	"github.com/hashicorp/consul/ipaddr"
+	"github.com/hashicorp/consul/sdk/freeport"
+	"github.com/hashicorp/consul/sdk/testutil"
+)
+
+// testHTTPApp returns a local application that echoes the protocol and path
+// of requests, and sleeps for the duration given by the "sleep" query
+// parameter.
+func testHTTPApp(t *testing.T, http2Only bool) *httptest.Server {
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if d, err := time.ParseDuration(r.URL.Query().Get("sleep")); err == nil {
+			select {
+			case <-time.After(d):
+			case <-r.Context().Done():
+				return
+			}
+		}
+		fmt.Fprintf(w, "%s %s", r.Proto, r.URL.Path)
+	})
+	if http2Only {
+		handler = h2c.NewHandler(handler, &http2.Server{})
+	}
+	app := httptest.NewServer(handler)
+	t.Cleanup(app.Close)
+	return app
+}
+
+func testHTTPGet(t *testing.T, client *http.Client, url string) (int, string) {
+	t.Helper()
+	resp, err := client.Get(url)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp.StatusCode, string(body)
+}
+
+func TestPublicHTTPListener(t *testing.T) {
+	// Can't enable t.Parallel since we rely on the global metrics instance.
+
+	cases := map[string]struct {
+		protocol  string
+		wantProto string
+	}{
+		"http":  {protocol: "http", wantProto: "HTTP/1.1"},
+		"http2": {protocol: "http2", wantProto: "HTTP/2.0"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ca := agConnect.TestCA(t, nil)
+			testApp := testHTTPApp(t, isHTTP2Protocol(tc.protocol))
+
+			port := freeport.GetOne(t)
+			cfg := PublicListenerConfig{
+				BindAddress:           "127.0.0.1",
+				BindPort:              port,
+				LocalServiceAddress:   testApp.Listener.Addr().String(),
+				HandshakeTimeoutMs:    100,
+				LocalConnectTimeoutMs: 100,
+				LocalRequestTimeoutMs: 200,
+				Protocol:              tc.protocol,
+			}
+
+			sink := agMetrics.TestSetupMetrics(t, "consul.proxy.test")
+
+			svc := connect.TestService(t, "db", ca)
+			l := NewPublicHTTPListener(svc, cfg, testutil.Logger(t))
+
+			go func() {
+				if err := l.Serve(); err != nil {
+					t.Errorf("failed to listen: %v", err.Error())
+				}
+			}()
+			defer l.Close()
+			l.Wait()
+
+			// Play the part of a Connect client that doesn't negotiate a
+			// protocol, and speaks HTTP/2 with prior knowledge like Envoy.
+			tlsCfg := connect.TestTLSConfig(t, "web", ca)
+			tlsCfg.InsecureSkipVerify = true
+			tlsCfg.NextProtos = nil
+			dialer := &tls.Dialer{Config: tlsCfg}
+			dial := func(ctx context.Context, network, _ string) (net.Conn, error) {
+				return dialer.DialContext(ctx, network, TestLocalAddr(port))
+			}
+			client := &http.Client{Transport: newHTTPTLSTransport(dial, isHTTP2Protocol(tc.protocol))}
+			defer client.CloseIdleConnections()
+
+			url := "https://db.service.consul"
+			code, body := testHTTPGet(t, client, url+"/hello")
+			require.Equal(t, http.StatusOK, code)
+			require.Equal(t, tc.wantProto+" /hello", body)
+
+			code, _ = testHTTPGet(t, client, url+"/slow?sleep=1s")
+			require.Equal(t, http.StatusGatewayTimeout, code)
+
+			agMetrics.AssertCounter(t, sink, "consul.proxy.test.inbound.requests;dst=db", 2)
+		})
+	}
+}
+
+func TestPublicHTTPListener_ALPN(t *testing.T) {
+	ca := agConnect.TestCA(t, nil)
+	testApp := testHTTPApp(t, true)
+
+	port := freeport.GetOne(t)
+	cfg := PublicListenerConfig{
+		BindAddress:           "127.0.0.1",
+		BindPort:              port,
+		LocalServiceAddress:   testApp.Listener.Addr().String(),
+		HandshakeTimeoutMs:    100,
+		LocalConnectTimeoutMs: 100,
+		Protocol:              "grpc",
+	}
+
+	svc := connect.TestService(t, "db", ca)
+	l := NewPublicHTTPListener(svc, cfg, testutil.Logger(t))
+
+	go func() {
+		if err := l.Serve(); err != nil {
+			t.Errorf("failed to listen: %v", err.Error())
+		}
+	}()
+	defer l.Close()
+	l.Wait()
+
+	for _, proto := range []string{"h2", "http/1.1"} {
+		tlsCfg := connect.TestTLSConfig(t, "web", ca)
+		tlsCfg.InsecureSkipVerify = true
+		tlsCfg.NextProtos = []string{proto}
+
+		transport := &http.Transport{TLSClientConfig: tlsCfg, ForceAttemptHTTP2: proto == "h2"}
+		client := &http.Client{Transport: transport}
+
+		code, body := testHTTPGet(t, client, "https://"+TestLocalAddr(port)+"/hello")
+		require.Equal(t, http.StatusOK, code)
+		require.Equal(t, "HTTP/2.0 /hello", body)
+		transport.CloseIdleConnections()
+	}
+}
+
+func TestPublicHTTPListener_ReadHeaderTimeout(t *testing.T) {
+	ca := agConnect.TestCA(t, nil)
+	testApp := testHTTPApp(t, false)
+
+	port := freeport.GetOne(t)
+	cfg := PublicListenerConfig{
+		BindAddress:           "127.0.0.1",
+		BindPort:              port,
+		LocalServiceAddress:   testApp.Listener.Addr().String(),
+		HandshakeTimeoutMs:    100,
+		LocalConnectTimeoutMs: 100,
+		Protocol:              "http",
+	}
+
+	svc := connect.TestService(t, "db", ca)
+	l := NewPublicHTTPListener(svc, cfg, testutil.Logger(t))
+
+	go func() {
+		if err := l.Serve(); err != nil {
+			t.Errorf("failed to listen: %v", err.Error())
+		}
+	}()
+	defer l.Close()
+	l.Wait()
+
+	tlsCfg := connect.TestTLSConfig(t, "web", ca)
+	tlsCfg.InsecureSkipVerify = true
+	tlsCfg.NextProtos = []string{"http/1.1"}
+	conn, err := tls.Dial("tcp", TestLocalAddr(port), tlsCfg)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	// A client that completes the handshake but never sends a request is
+	// disconnected after the handshake timeout.
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	_, err = conn.Read(make([]byte, 1))
+	require.ErrorIs(t, err, io.EOF)
+}
+
+func TestUpstreamHTTPListener(t *testing.T) {
+	// Can't enable t.Parallel since we rely on the global metrics instance.
+
+	ca := agConnect.TestCA(t, nil)
+
+	// Run a test server for each target.
+	servers := make(map[string]*connect.TestServer)
+	for _, id := range []string{"api.default.default.dc1", "v2.api.default.default.dc1"} {
+		id := id
+		srv := connect.NewTestServer(t, "api", ca)
+		go func() {
+			err := srv.ServeHTTPS(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if d, err := time.ParseDuration(r.URL.Query().Get("sleep")); err == nil {
+					select {
+					case <-time.After(d):
+					case <-r.Context().Done():
+						return
+					}
+				}
+				fmt.Fprintf(w, "%s %s", id, r.URL.Path)
+			}))
+			require.NoError(t, err)
+		}()
+		defer srv.Close()
+		<-srv.Listening
+		servers[id] = srv
+	}
+
+	chain := &api.CompiledDiscoveryChain{
+		ServiceName: "api",
+		Protocol:    "http",
+		StartNode:   "router:api.default.default",
+		Nodes: map[string]*api.DiscoveryGraphNode{
+			"router:api.default.default": {
+				Type: api.DiscoveryGraphNodeTypeRouter,
+				Name: "api.default.default",
+				Routes: []*api.DiscoveryRoute{
+					{
+						Definition: &api.ServiceRoute{
+							Match: &api.ServiceRouteMatch{
+								HTTP: &api.ServiceRouteHTTPMatch{PathPrefix: "/v2/"},
+							},
+							Destination: &api.ServiceRouteDestination{
+								ServiceSubset:  "v2",
+								PrefixRewrite:  "/",
+								RequestTimeout: 200 * time.Millisecond,
+							},
+						},
+						NextNode: "resolver:v2.api.default.default.dc1",
+					},
+					{
+						Definition: &api.ServiceRoute{
+							Match: &api.ServiceRouteMatch{
+								HTTP: &api.ServiceRouteHTTPMatch{PathPrefix: "/down/"},
+							},
+							Destination: &api.ServiceRouteDestination{
+								ServiceSubset: "down",
+								PrefixRewrite: "/",
+							},
+						},
+						NextNode: "resolver:down.api.default.default.dc1",
+					},
+					{
+						Definition: &api.ServiceRoute{
+							Match: &api.ServiceRouteMatch{
+								HTTP: &api.ServiceRouteHTTPMatch{PathPrefix: "/"},
+							},
+						},
+						NextNode: "resolver:api.default.default.dc1",
+					},
+				},
+			},
+			"resolver:v2.api.default.default.dc1": {
+				Type:     api.DiscoveryGraphNodeTypeResolver,
+				Name:     "v2.api.default.default.dc1",
+				Resolver: &api.DiscoveryResolver{Target: "v2.api.default.default.dc1"},
+			},
+			"resolver:down.api.default.default.dc1": {
+				Type: api.DiscoveryGraphNodeTypeResolver,
+				Name: "down.api.default.default.dc1",
+				Resolver: &api.DiscoveryResolver{
+					Target: "down.api.default.default.dc1",
+					Failover: &api.DiscoveryFailover{
+						Targets: []string{"v2.api.default.default.dc1"},
+					},
+				},
+			},
+			"resolver:api.default.default.dc1": {
+				Type:     api.DiscoveryGraphNodeTypeResolver,
+				Name:     "api.default.default.dc1",
+				Resolver: &api.DiscoveryResolver{Target: "api.default.default.dc1"},
+			},
+		},
+		Targets: map[string]*api.DiscoveryTarget{
+			"api.default.default.dc1": {
+				ID:      "api.default.default.dc1",
+				Service: "api",
+			},
+			"v2.api.default.default.dc1": {
+				ID:            "v2.api.default.default.dc1",
+				Service:       "api",
+				ServiceSubset: "v2",
+			},
+			"down.api.default.default.dc1": {
+				ID:            "down.api.default.default.dc1",
+				Service:       "api",
+				ServiceSubset: "down",
+			},
+		},
+	}
+
+	cfg := UpstreamConfig{
+		DestinationType:      "service",
+		DestinationNamespace: "default",
+		DestinationName:      "api",
+		Config:               map[string]interface{}{"connect_timeout_ms": 100},
+		LocalBindAddress:     "localhost",
+		LocalBindPort:        freeport.GetOne(t),
+	}
+
+	sink := agMetrics.TestSetupMetrics(t, "consul.proxy.test")
+
+	svc := connect.TestService(t, "web", ca)
+
+	rf := func(target *api.DiscoveryTarget) (connect.Resolver, error) {
+		if _, ok := servers[target.ID]; !ok {
+			return nil, fmt.Errorf("no healthy instances found")
+		}
+		return &connect.StaticResolver{
+			Addr:    servers[target.ID].Addr,
+			CertURI: agConnect.TestSpiffeIDService(t, "api"),
+		}, nil
+	}
+
+	l, _ := newUpstreamHTTPListenerWithResolver(svc, cfg, chain, rf, testutil.Logger(t))
+
+	go func() {
+		if err := l.Serve(); err != nil {
+			t.Errorf("failed to listen: %v", err.Error())
+		}
+	}()
+	defer l.Close()
+	l.Wait()
+
+	client := &http.Client{}
+	url := "http://" + ipaddr.FormatAddressPort(cfg.LocalBindAddress, cfg.LocalBindPort)
+
+	code, body := testHTTPGet(t, client, url+"/hello")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "api.default.default.dc1 /hello", body)
+
+	code, body = testHTTPGet(t, client, url+"/v2/hello")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "v2.api.default.default.dc1 /hello", body)
+
+	code, _ = testHTTPGet(t, client, url+"/v2/slow?sleep=1s")
+	require.Equal(t, http.StatusGatewayTimeout, code)
+
+	// The first route has no timeout.
+	code, _ = testHTTPGet(t, client, url+"/slow?sleep=300ms")
+	require.Equal(t, http.StatusOK, code)
+
+	// Requests fail over when the target has no instances.
+	code, body = testHTTPGet(t, client, url+"/down/hello")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "v2.api.default.default.dc1 /hello", body)
+
+	agMetrics.AssertCounter(t, sink, "consul.proxy.test.upstream.requests;src=web;dst_type=service;dst=api", 5)
+}
+
+func TestUpstreamTargetResolverFuncFromClient(t *testing.T) {
+	rf := UpstreamTargetResolverFuncFromClient(nil, UpstreamConfig{})
+
+	resolver, err := rf(&api.DiscoveryTarget{
+		ID:         "api.default.ap1.dc2",
+		Service:    "api",
+		Namespace:  "default",
+		Partition:  "ap1",
+		Datacenter: "dc2",
+	})
+	require.NoError(t, err)
+	require.Equal(t, &connect.ConsulResolver{
+		Name:       "api",
+		Type:       connect.ConsulResolverTypeService,
+		Partition:  "ap1",
+		Datacenter: "dc2",
+	}, resolver)
+
+	_, err = rf(&api.DiscoveryTarget{
+		ID:      "api.default.default.external-peer",
+		Service: "api",
+		Peer:    "peer1",
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "peer1")
+}
+
+func TestConnListener(t *testing.T) {
+	addr := &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 1234}
+	cl := &connListener{
+		addr:   addr,
+		conns:  make(chan net.Conn),
+		closed: make(chan struct{}),
+	}
+	require.Equal(t, addr, cl.Addr())
+
+	client, server := net.Pipe()
+	defer client.Close()
+	go cl.push(server)
+
+	conn, err := cl.Accept()
+	require.NoError(t, err)
+	require.Equal(t, server, conn)
+
+	require.NoError(t, cl.Close())
+	require.NoError(t, cl.Close())
+	_, err = cl.Accept()
+	require.ErrorIs(t, err, net.ErrClosed)
+
+	// Connections pushed after close are closed.
+	client2, server2 := net.Pipe()
+	cl.push(server2)
+	_, err = client2.Read(make([]byte, 1))
+	require.ErrorIs(t, err, io.EOF)
+}
@@ -4,7 +4,9 @@
 package proxy
 
 import (
+	"context"
 	"crypto/x509"
+	"time"
 
 	"github.com/hashicorp/go-hclog"
 
@@ -22,6 +24,13 @@ type Proxy struct {
 	service    *connect.Service
 }
 
+// proxyListener is implemented by Listener and HTTPListener.
+type proxyListener interface {
+	Serve() error
+	Close() error
+	BindAddr() string
+}
+
 // New returns a proxy with the given configuration source.
 //
 // The ConfigWatcher can be used to update the configuration of the proxy.
@@ -84,7 +93,12 @@ func (p *Proxy) Serve() error {
 					// the configuration to disable our public listener.
 					if newCfg.PublicListener.BindPort != 0 {
 						newCfg.PublicListener.applyDefaults()
-						l := NewPublicListener(p.service, newCfg.PublicListener, p.logger)
+						var l proxyListener
+						if isHTTPProtocol(newCfg.PublicListener.Protocol) {
+							l = NewPublicHTTPListener(p.service, newCfg.PublicListener, p.logger)
+						} else {
+							l = NewPublicListener(p.service, newCfg.PublicListener, p.logger)
+						}
 						err = p.startListener("public listener", l)
 						if err != nil {
 							// This should probably be fatal.
@@ -114,6 +128,11 @@ func (p *Proxy) Serve() error {
 					continue
 				}
 
+				if uc.DestinationType != api.UpstreamDestTypePreparedQuery {
+					go p.watchUpstream(uc)
+					continue
+				}
+
 				l := NewUpstreamListener(p.service, p.client, uc, p.logger)
 				err := p.startListener(uc.String(), l)
 				if err != nil {
@@ -134,18 +153,106 @@ func (p *Proxy) Serve() error {
 	}
 }
 
-// startPublicListener is run from the internal state machine loop
-func (p *Proxy) startListener(name string, l *Listener) error {
-	p.logger.Info("Starting listener", "listener", name, "bind_addr", l.BindAddr())
+// watchUpstream runs the listener of a service upstream until the proxy is
+// closed. It watches the discovery chain of the upstream and serves it with an
+// HTTP listener if the chain uses an HTTP based protocol, and a TCP listener
+// otherwise. The listener is rebuilt whenever the protocol of the chain
+// changes. Until the chain is first fetched, the upstream is proxied as TCP.
+func (p *Proxy) watchUpstream(uc UpstreamConfig) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 	go func() {
-		err := l.Serve()
-		if err != nil {
-			p.logger.Error("listener stopped with error", "listener", name, "error", err)
-			return
+		<-p.stopChan
+		cancel()
+	}()
+
+	var (
+		index    uint64
+		protocol string
+		l        proxyListener
+		handler  *upstreamHTTPHandler
+	)
+	defer func() {
+		if l != nil {
+			l.Close()
 		}
-		p.logger.Info("listener stopped", "listener", name)
 	}()
 
+	for {
+		chain, meta, err := fetchDiscoveryChain(ctx, p.client, uc, index)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			if l == nil {
+				p.logger.Warn("failed to fetch discovery chain, proxying upstream as TCP until it is fetched",
+					"upstream", uc.String(),
+					"error", err,
+				)
+				protocol = "tcp"
+				l = NewUpstreamListener(p.service, p.client, uc, p.logger)
+				p.serveListener(uc.String(), l)
+			} else {
+				p.logger.Warn("failed to fetch discovery chain",
+					"upstream", uc.String(),
+					"error", err,
+				)
+			}
+			select {
+			case <-time.After(discoveryChainRetryWait):
+			case <-ctx.Done():
+				return
+			}
+			continue
+		}
+		if meta.LastIndex == index {
+			continue
+		}
+		index = meta.LastIndex
+
+		if l != nil && upstreamListenerProtocol(chain.Protocol) == protocol {
+			if handler != nil {
+				handler.setChain(chain)
+			}
+			continue
+		}
+
+		if l != nil {
+			p.logger.Info("upstream protocol changed, restarting listener",
+				"upstream", uc.String(),
+				"protocol", chain.Protocol,
+			)
+			l.Close()
+		}
+		protocol = upstreamListenerProtocol(chain.Protocol)
+		if isHTTPProtocol(chain.Protocol) {
+			l, handler = newUpstreamHTTPListenerWithResolver(p.service, uc, chain,
+				UpstreamTargetResolverFuncFromClient(p.client, uc), p.logger)
+		} else {
+			l, handler = NewUpstreamListener(p.service, p.client, uc, p.logger), nil
+		}
+		p.serveListener(uc.String(), l)
+	}
+}
+
+// upstreamListenerProtocol returns the protocol of the listener that serves
+// an upstream whose discovery chain uses the given protocol. Upstreams whose
+// protocols map to the same listener protocol can share a listener.
+func upstreamListenerProtocol(protocol string) string {
+	switch {
+	case isHTTP2Protocol(protocol):
+		return "http2"
+	case isHTTPProtocol(protocol):
+		return "http"
+	default:
+		return "tcp"
+	}
+}
+
+// startPublicListener is run from the internal state machine loop
+func (p *Proxy) startListener(name string, l proxyListener) error {
+	p.serveListener(name, l)
+
 	go func() {
 		<-p.stopChan
 		l.Close()
@@ -155,6 +262,19 @@ func (p *Proxy) startListener(name string, l *Listener) error {
 	return nil
 }
 
+// serveListener runs the listener in the background until it is closed.
+func (p *Proxy) serveListener(name string, l proxyListener) {
+	p.logger.Info("Starting listener", "listener", name, "bind_addr", l.BindAddr())
+	go func() {
+		err := l.Serve()
+		if err != nil {
+			p.logger.Error("listener stopped with error", "listener", name, "error", err)
+			return
+		}
+		p.logger.Info("listener stopped", "listener", name)
+	}()
+}
+
 // Close stops the proxy and terminates all active connections. It must be
 // called only once.
 func (p *Proxy) Close() {
@@ -6,7 +6,10 @@ package proxy
 import (
 	"context"
 	"crypto/tls"
+	"fmt"
+	"io"
 	"net"
+	"net/http"
 	"path/filepath"
 	"runtime"
 	"strconv"
@@ -129,3 +132,92 @@ func TestProxy_public(t *testing.T) {
 		require.NoFileExists(t, unixSocket)
 	})
 }
+
+func TestProxy_upstreamProtocolChange(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	a := agent.NewTestAgent(t, "")
+	t.Cleanup(func() { a.Shutdown() })
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+	client := a.Client()
+
+	// Run api as a Connect native service that echoes the request path.
+	api1, err := connect.NewService("api", client)
+	require.NoError(t, err)
+	t.Cleanup(func() { api1.Close() })
+	select {
+	case <-api1.ReadyWait():
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for api Service.ReadyWait after 5s")
+	}
+	lis, err := tls.Listen("tcp", "127.0.0.1:0", api1.ServerTLSConfig())
+	require.NoError(t, err)
+	srv := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, r.URL.Path)
+	})}
+	go srv.Serve(lis)
+	t.Cleanup(func() { srv.Close() })
+
+	require.NoError(t, client.Agent().ServiceRegister(&api.AgentServiceRegistration{
+		Name:    "api",
+		Address: "127.0.0.1",
+		Port:    lis.Addr().(*net.TCPAddr).Port,
+		Connect: &api.AgentServiceConnect{Native: true},
+	}))
+
+	port := freeport.GetOne(t)
+	p, err := New(client, NewStaticConfigWatcher(&Config{
+		ProxiedServiceName: "web",
+		Upstreams: []UpstreamConfig{
+			{
+				DestinationName:  "api",
+				LocalBindAddress: "127.0.0.1",
+				LocalBindPort:    port,
+			},
+		},
+	}), testutil.Logger(t))
+	require.NoError(t, err)
+	t.Cleanup(p.Close)
+	go p.Serve()
+
+	httpClient := &http.Client{Transport: &http.Transport{DisableKeepAlives: true}}
+	get := func(r *retry.R, expected string) {
+		resp, err := httpClient.Get(fmt.Sprintf("http://%s/v2/hello", TestLocalAddr(port)))
+		if err != nil {
+			r.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		r.Check(err)
+		if string(body) != expected {
+			r.Fatalf("expected %q, got %q", expected, body)
+		}
+	}
+
+	// Requests are proxied as TCP, so routes don't apply.
+	retry.Run(t, func(r *retry.R) { get(r, "/v2/hello") })
+
+	// Once the upstream uses HTTP, its routes apply.
+	_, _, err = client.ConfigEntries().Set(&api.ServiceConfigEntry{
+		Kind:     api.ServiceDefaults,
+		Name:     "api",
+		Protocol: "http",
+	}, nil)
+	require.NoError(t, err)
+	_, _, err = client.ConfigEntries().Set(&api.ServiceRouterConfigEntry{
+		Kind: api.ServiceRouter,
+		Name: "api",
+		Routes: []api.ServiceRoute{
+			{
+				Match: &api.ServiceRouteMatch{
+					HTTP: &api.ServiceRouteHTTPMatch{PathPrefix: "/v2/"},
+				},
+				Destination: &api.ServiceRouteDestination{PrefixRewrite: "/"},
+			},
+		},
+	}, nil)
+	require.NoError(t, err)
+	retry.Run(t, func(r *retry.R) { get(r, "/hello") })
+}
@@ -10,8 +10,10 @@ import (
 	"errors"
 	"net"
 	"net/http"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/consul/agent/connect"
 	"github.com/hashicorp/consul/api"
 	"github.com/hashicorp/consul/api/watch"
 	"github.com/hashicorp/consul/logging"
@@ -182,6 +184,84 @@ func (s *Service) ServerTLSConfig() *tls.Config {
 	return s.tlsCfg.Get(newServerSideVerifier(s.logger, s.client, s.service))
 }
 
+// HTTPServerTLSConfig returns a *tls.Config for HTTP servers that authorize
+// each request rather than each connection, which is needed to enforce
+// intentions with L7 permissions. Client certificates are verified during the
+// handshake like with ServerTLSConfig, but intentions are not checked: the
+// server must call AuthorizeHTTPRequest for every request it handles. Both
+// HTTP/2 and HTTP/1.1 are advertised via ALPN.
+func (s *Service) HTTPServerTLSConfig() *tls.Config {
+	return s.requestAuthzTLSConfig("h2", "http/1.1")
+}
+
+// requestAuthzTLSConfig returns a server *tls.Config that verifies client
+// certificates without authorizing them, and advertises the given protocols
+// via ALPN.
+func (s *Service) requestAuthzTLSConfig(nextProtos ...string) *tls.Config {
+	cfg := s.tlsCfg.Get(httpServerSideVerifier(s.logger))
+	cfg.NextProtos = nextProtos
+	getConfigForClient := cfg.GetConfigForClient
+	cfg.GetConfigForClient = func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+		clientCfg, err := getConfigForClient(hello)
+		if clientCfg != nil {
+			clientCfg.NextProtos = nextProtos
+		}
+		return clientCfg, err
+	}
+	return cfg
+}
+
+// AuthorizeHTTPRequest authorizes an HTTP request received over a connection
+// configured with HTTPServerTLSConfig. The caller is identified by its client
+// certificate and the L7 permissions of any matching intention are evaluated
+// against the request. It returns whether the request is allowed and the
+// reason for the decision.
+func (s *Service) AuthorizeHTTPRequest(r *http.Request) (bool, string, error) {
+	if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+		return false, "", errors.New("connect: request has no client certificate")
+	}
+
+	httpReq := &api.IntentionCheckHTTPRequest{
+		Method: r.Method,
+		Path:   r.URL.RequestURI(),
+		Header: make(map[string]string, len(r.Header)+1),
+	}
+	for name, values := range r.Header {
+		httpReq.Header[name] = strings.Join(values, ",")
+	}
+	httpReq.Header[":authority"] = r.Host
+
+	return s.authorizeRequest(r.TLS.PeerCertificates[0], httpReq)
+}
+
+// authorizeRequest authorizes a request from the client identified by leaf
+// against the intentions for the service.
+func (s *Service) authorizeRequest(leaf *x509.Certificate, req *api.IntentionCheckHTTPRequest) (bool, string, error) {
+	if len(leaf.URIs) < 1 {
+		return false, "", errors.New("connect: invalid leaf certificate")
+	}
+	certURI, err := connect.ParseCertURI(leaf.URIs[0])
+	if err != nil {
+		return false, "", errors.New("connect: invalid leaf certificate URI")
+	}
+
+	// No AuthZ if there is no client.
+	if s.client == nil {
+		return true, "", nil
+	}
+
+	resp, err := s.client.Agent().ConnectAuthorize(&api.AgentAuthorizeParams{
+		Target:           s.service,
+		ClientCertURI:    certURI.URI().String(),
+		ClientCertSerial: connect.EncodeSerialNumber(leaf.SerialNumber),
+		HTTP:             req,
+	})
+	if err != nil {
+		return false, "", errors.New("connect: authz call failed: " + err.Error())
+	}
+	return resp.Authorized, resp.Reason, nil
+}
+
 // Dial connects to a remote Connect-enabled server. The passed Resolver is used
 // to discover a single candidate instance which will be dialed and have it's
 // TLS certificate verified against the expected identity. Failures are returned
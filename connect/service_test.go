@@ -268,6 +268,29 @@ func TestService_HTTPClient(t *testing.T) {
 	})
 }
 
+func TestService_AuthorizeHTTPRequest(t *testing.T) {
+	ca := connect.TestCA(t, nil)
+
+	s := TestService(t, "web", ca)
+
+	// Requests must carry a client certificate.
+	req, err := http.NewRequest("GET", "https://web.service.consul/foo", nil)
+	require.NoError(t, err)
+	_, _, err = s.AuthorizeHTTPRequest(req)
+	require.Error(t, err)
+
+	// Without a client there is no authorization.
+	req.TLS = &tls.ConnectionState{
+		PeerCertificates: TestPeerCertificates(t, "api", ca),
+	}
+	allowed, _, err := s.AuthorizeHTTPRequest(req)
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	// The HTTP TLS config advertises both HTTP versions.
+	require.Equal(t, []string{"h2", "http/1.1"}, s.HTTPServerTLSConfig().NextProtos)
+}
+
 func TestService_HasDefaultHTTPResolverFromAddr(t *testing.T) {
 
 	client, err := api.NewClient(api.DefaultConfig())
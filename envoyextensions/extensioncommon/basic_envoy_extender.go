@@ -40,6 +40,15 @@ type BasicExtension interface {
 	PatchFilter(*RuntimeConfig, *envoy_listener_v3.Filter) (*envoy_listener_v3.Filter, bool, error)
 }
 
+// ClusterInserter may be implemented by a BasicExtension that needs clusters
+// of its own, such as a cluster for a service outside of the mesh that the
+// extension configures Envoy to call.
+type ClusterInserter interface {
+	// InsertClusters returns the clusters to add to the resources. Clusters
+	// with the name of an existing cluster replace it.
+	InsertClusters(*RuntimeConfig) ([]*envoy_cluster_v3.Cluster, error)
+}
+
 var _ EnvoyExtender = (*BasicEnvoyExtender)(nil)
 
 // BasicEnvoyExtender provides convenience functions for iterating and applying modifications
@@ -131,6 +140,19 @@ func (envoyExtender *BasicEnvoyExtender) Extend(resources *xdscommon.IndexedReso
 		}
 	}
 
+	if inserter, ok := envoyExtender.Extension.(ClusterInserter); ok {
+		clusters, err := inserter.InsertClusters(config)
+		if err != nil {
+			resultErr = multierror.Append(resultErr, fmt.Errorf("error inserting clusters: %w", err))
+		}
+		for _, cluster := range clusters {
+			if resources.Index[xdscommon.ClusterType] == nil {
+				resources.Index[xdscommon.ClusterType] = make(map[string]proto.Message)
+			}
+			resources.Index[xdscommon.ClusterType][cluster.Name] = cluster
+		}
+	}
+
 	return resources, resultErr
 }
 
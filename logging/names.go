@@ -67,6 +67,7 @@ const (
 	UIMetricsProxy        string = "ui_metrics_proxy"
 	WAN                   string = "wan"
 	Watch                 string = "watch"
+	WorkloadAPI           string = "workload_api"
 	XDS                   string = "xds"
 	XDSCapacityController string = "xds_capacity_controller"
 	Vault                 string = "vault"
@@ -1384,6 +1384,11 @@ func ServiceDefaultsToStructs(s *ServiceDefaults, t *structs.ServiceConfigEntry)
 	t.LocalRequestTimeoutMs = int(s.LocalRequestTimeoutMs)
 	t.BalanceInboundConnections = s.BalanceInboundConnections
 	t.EnvoyExtensions = EnvoyExtensionsToStructs(s.EnvoyExtensions)
+	if s.Tracing != nil {
+		var x structs.TracingConfig
+		TracingConfigToStructs(s.Tracing, &x)
+		t.Tracing = &x
+	}
 	t.Meta = s.Meta
 }
 func ServiceDefaultsFromStructs(t *structs.ServiceConfigEntry, s *ServiceDefaults) {
@@ -1424,6 +1429,11 @@ func ServiceDefaultsFromStructs(t *structs.ServiceConfigEntry, s *ServiceDefault
 	s.LocalRequestTimeoutMs = int32(t.LocalRequestTimeoutMs)
 	s.BalanceInboundConnections = t.BalanceInboundConnections
 	s.EnvoyExtensions = EnvoyExtensionsFromStructs(t.EnvoyExtensions)
+	if t.Tracing != nil {
+		var x TracingConfig
+		TracingConfigFromStructs(t.Tracing, &x)
+		s.Tracing = &x
+	}
 	s.Meta = t.Meta
 }
 func ServiceIntentionsToStructs(s *ServiceIntentions, t *structs.ServiceIntentionsConfigEntry) {
@@ -1446,6 +1456,7 @@ func ServiceIntentionsToStructs(s *ServiceIntentions, t *structs.ServiceIntentio
 		t.JWT = &x
 	}
 	t.Meta = s.Meta
+	t.Mode = structs.IntentionMode(s.Mode)
 }
 func ServiceIntentionsFromStructs(t *structs.ServiceIntentionsConfigEntry, s *ServiceIntentions) {
 	if s == nil {
@@ -1467,6 +1478,7 @@ func ServiceIntentionsFromStructs(t *structs.ServiceIntentionsConfigEntry, s *Se
 		s.JWT = &x
 	}
 	s.Meta = t.Meta
+	s.Mode = string(t.Mode)
 }
 func ServiceResolverToStructs(s *ServiceResolver, t *structs.ServiceResolverConfigEntry) {
 	if s == nil {
@@ -1720,6 +1732,7 @@ func SourceIntentionToStructs(s *SourceIntention, t *structs.SourceIntention) {
 	t.EnterpriseMeta = enterpriseMetaToStructs(s.EnterpriseMeta)
 	t.Peer = s.Peer
 	t.SamenessGroup = s.SamenessGroup
+	t.Mode = structs.IntentionMode(s.Mode)
 }
 func SourceIntentionFromStructs(t *structs.SourceIntention, s *SourceIntention) {
 	if s == nil {
@@ -1747,6 +1760,7 @@ func SourceIntentionFromStructs(t *structs.SourceIntention, s *SourceIntention)
 	s.EnterpriseMeta = enterpriseMetaFromStructs(t.EnterpriseMeta)
 	s.Peer = t.Peer
 	s.SamenessGroup = t.SamenessGroup
+	s.Mode = string(t.Mode)
 }
 func StatusToStructs(s *Status, t *structs.Status) {
 	if s == nil {
@@ -1846,6 +1860,70 @@ func TCPServiceFromStructs(t *structs.TCPService, s *TCPService) {
 	s.Name = t.Name
 	s.EnterpriseMeta = enterpriseMetaFromStructs(t.EnterpriseMeta)
 }
+func TracingConfigToStructs(s *TracingConfig, t *structs.TracingConfig) {
+	if s == nil {
+		return
+	}
+	t.Provider = structs.TracingProvider(s.Provider)
+	t.ServiceName = s.ServiceName
+	t.ServiceNamespace = s.ServiceNamespace
+	t.ServicePartition = s.ServicePartition
+	t.CollectorEndpoint = s.CollectorEndpoint
+	t.ClientSampling = s.ClientSampling
+	t.RandomSampling = s.RandomSampling
+	t.OverallSampling = s.OverallSampling
+	{
+		t.CustomTags = make([]structs.TracingCustomTag, len(s.CustomTags))
+		for i := range s.CustomTags {
+			if s.CustomTags[i] != nil {
+				TracingCustomTagToStructs(s.CustomTags[i], &t.CustomTags[i])
+			}
+		}
+	}
+}
+func TracingConfigFromStructs(t *structs.TracingConfig, s *TracingConfig) {
+	if s == nil {
+		return
+	}
+	s.Provider = string(t.Provider)
+	s.ServiceName = t.ServiceName
+	s.ServiceNamespace = t.ServiceNamespace
+	s.ServicePartition = t.ServicePartition
+	s.CollectorEndpoint = t.CollectorEndpoint
+	s.ClientSampling = t.ClientSampling
+	s.RandomSampling = t.RandomSampling
+	s.OverallSampling = t.OverallSampling
+	{
+		s.CustomTags = make([]*TracingCustomTag, len(t.CustomTags))
+		for i := range t.CustomTags {
+			{
+				var x TracingCustomTag
+				TracingCustomTagFromStructs(&t.CustomTags[i], &x)
+				s.CustomTags[i] = &x
+			}
+		}
+	}
+}
+func TracingCustomTagToStructs(s *TracingCustomTag, t *structs.TracingCustomTag) {
+	if s == nil {
+		return
+	}
+	t.Tag = s.Tag
+	t.Literal = s.Literal
+	t.Environment = s.Environment
+	t.RequestHeader = s.RequestHeader
+	t.DefaultValue = s.DefaultValue
+}
+func TracingCustomTagFromStructs(t *structs.TracingCustomTag, s *TracingCustomTag) {
+	if s == nil {
+		return
+	}
+	s.Tag = t.Tag
+	s.Literal = t.Literal
+	s.Environment = t.Environment
+	s.RequestHeader = t.RequestHeader
+	s.DefaultValue = t.DefaultValue
+}
 func TransparentProxyConfigToStructs(s *TransparentProxyConfig, t *structs.TransparentProxyConfig) {
 	if s == nil {
 		return
@@ -83,7 +83,7 @@ func randStructsConnectProxyConfig(p *structs.ConnectProxyConfig, c fuzz.Continu
 	v := reflect.ValueOf(p).Elem()
 	for i := 0; i < v.NumField(); i++ {
 		switch v.Type().Field(i).Name {
-		case "MutualTLSMode":
+		case "MutualTLSMode", "Tracing":
 			continue
 		}
 		c.Fuzz(v.Field(i).Addr().Interface())
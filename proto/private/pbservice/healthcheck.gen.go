@@ -25,6 +25,8 @@ func CheckTypeToStructs(s *CheckType, t *structs.CheckType) {
 	t.Interval = structs.DurationFromProto(s.Interval)
 	t.AliasNode = s.AliasNode
 	t.AliasService = s.AliasService
+	t.AliasDatacenter = s.AliasDatacenter
+	t.AliasPeer = s.AliasPeer
 	t.DockerContainerID = s.DockerContainerID
 	t.Shell = s.Shell
 	t.GRPC = s.GRPC
@@ -37,6 +39,8 @@ func CheckTypeToStructs(s *CheckType, t *structs.CheckType) {
 	t.SuccessBeforePassing = int(s.SuccessBeforePassing)
 	t.FailuresBeforeWarning = int(s.FailuresBeforeWarning)
 	t.FailuresBeforeCritical = int(s.FailuresBeforeCritical)
+	t.DynamicWeight = s.DynamicWeight
+	t.DependsOn = CheckIDType(s.DependsOn)
 	t.ProxyHTTP = s.ProxyHTTP
 	t.ProxyGRPC = s.ProxyGRPC
 	t.DeregisterCriticalServiceAfter = structs.DurationFromProto(s.DeregisterCriticalServiceAfter)
@@ -63,6 +67,8 @@ func CheckTypeFromStructs(t *structs.CheckType, s *CheckType) {
 	s.Interval = structs.DurationToProto(t.Interval)
 	s.AliasNode = t.AliasNode
 	s.AliasService = t.AliasService
+	s.AliasDatacenter = t.AliasDatacenter
+	s.AliasPeer = t.AliasPeer
 	s.DockerContainerID = t.DockerContainerID
 	s.Shell = t.Shell
 	s.GRPC = t.GRPC
@@ -75,6 +81,8 @@ func CheckTypeFromStructs(t *structs.CheckType, s *CheckType) {
 	s.SuccessBeforePassing = int32(t.SuccessBeforePassing)
 	s.FailuresBeforeWarning = int32(t.FailuresBeforeWarning)
 	s.FailuresBeforeCritical = int32(t.FailuresBeforeCritical)
+	s.DynamicWeight = t.DynamicWeight
+	s.DependsOn = string(t.DependsOn)
 	s.ProxyHTTP = t.ProxyHTTP
 	s.ProxyGRPC = t.ProxyGRPC
 	s.DeregisterCriticalServiceAfter = structs.DurationToProto(t.DeregisterCriticalServiceAfter)
@@ -98,6 +106,8 @@ func HealthCheckToStructs(s *HealthCheck, t *structs.HealthCheck) {
 	t.Timeout = s.Timeout
 	t.ExposedPort = int(s.ExposedPort)
 	t.PeerName = s.PeerName
+	t.DependsOn = CheckIDType(s.DependsOn)
+	t.Suppressed = s.Suppressed
 	if s.Definition != nil {
 		HealthCheckDefinitionToStructs(s.Definition, &t.Definition)
 	}
@@ -122,6 +132,8 @@ func HealthCheckFromStructs(t *structs.HealthCheck, s *HealthCheck) {
 	s.Timeout = t.Timeout
 	s.ExposedPort = int32(t.ExposedPort)
 	s.PeerName = t.PeerName
+	s.DependsOn = string(t.DependsOn)
+	s.Suppressed = t.Suppressed
 	{
 		var x HealthCheckDefinition
 		HealthCheckDefinitionFromStructs(&t.Definition, &x)
@@ -14,6 +14,10 @@ func AccessLogsConfigToStructs(s *AccessLogsConfig, t *structs.AccessLogsConfig)
 	t.Path = s.Path
 	t.JSONFormat = s.JSONFormat
 	t.TextFormat = s.TextFormat
+	t.ServiceName = s.ServiceName
+	t.ServiceNamespace = s.ServiceNamespace
+	t.ServicePartition = s.ServicePartition
+	t.LogName = s.LogName
 }
 func AccessLogsConfigFromStructs(t *structs.AccessLogsConfig, s *AccessLogsConfig) {
 	if s == nil {
@@ -25,6 +29,10 @@ func AccessLogsConfigFromStructs(t *structs.AccessLogsConfig, s *AccessLogsConfi
 	s.Path = t.Path
 	s.JSONFormat = t.JSONFormat
 	s.TextFormat = t.TextFormat
+	s.ServiceName = t.ServiceName
+	s.ServiceNamespace = t.ServiceNamespace
+	s.ServicePartition = t.ServicePartition
+	s.LogName = t.LogName
 }
 func ConnectProxyConfigToStructs(s *ConnectProxyConfig, t *structs.ConnectProxyConfig) {
 	if s == nil {
@@ -857,6 +857,26 @@ func (c *Configurator) IncomingHTTPSConfig() *tls.Config {
 	return config
 }
 
+// IncomingDNSOverTLSConfig generates a *tls.Config for incoming DNS over TLS
+// connections. It shares the settings of the HTTPS API.
+func (c *Configurator) IncomingDNSOverTLSConfig() *tls.Config {
+	c.log("IncomingDNSOverTLSConfig")
+
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	config := c.commonTLSConfig(
+		c.https,
+		c.base.HTTPS,
+		c.base.HTTPS.VerifyIncoming,
+	)
+	config.NextProtos = []string{"dot"}
+	config.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		return c.IncomingDNSOverTLSConfig(), nil
+	}
+	return config
+}
+
 // OutgoingTLSConfigForCheck generates a *tls.Config for outgoing TLS connections
 // for checks. This function is separated because there is an extra flag to
 // consider for checks. EnableAgentTLSForChecks and InsecureSkipVerify has to
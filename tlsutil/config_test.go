@@ -43,6 +43,10 @@ func TestConfigurator_IncomingConfig_Common(t *testing.T) {
 			func(lc ProtocolConfig) Config { return Config{HTTPS: lc} },
 			func(c *Configurator) *tls.Config { return c.IncomingHTTPSConfig() },
 		},
+		"DNS over TLS": {
+			func(lc ProtocolConfig) Config { return Config{HTTPS: lc} },
+			func(c *Configurator) *tls.Config { return c.IncomingDNSOverTLSConfig() },
+		},
 	}
 
 	for desc, tc := range testCases {
@@ -6,11 +6,27 @@ package troubleshoot
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 
 	envoy_admin_v3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
 	"github.com/hashicorp/consul/troubleshoot/validate"
 )
 
+// shadowDeniedStat is the counter of the RBAC filters for the connections and
+// requests that the shadow rules deny. Consul renders the intentions in audit
+// mode as shadow rules.
+const shadowDeniedStat = "shadow_denied"
+
+// AuditDenial is the number of connections or requests that an RBAC filter
+// would have denied if the intentions in audit mode were enforced.
+type AuditDenial struct {
+	// StatPrefix is the prefix of the stats of the RBAC filter, which
+	// identifies the listener of the filter.
+	StatPrefix string
+	Count      uint64
+}
+
 type statsJson struct {
 	Stats []simpleMetric `json:"stats"`
 }
@@ -65,9 +81,52 @@ func (t *Troubleshoot) troubleshootStats() (validate.Messages, error) {
 		Success: true,
 		Message: fmt.Sprintf("Envoy has detected %v connection failure(s)", totalCxFailures),
 	})
+
+	auditDenials, err := t.GetAuditDenials()
+	if err != nil {
+		return nil, err
+	}
+	if len(auditDenials) > 0 {
+		var totalAuditDenials uint64
+		for _, ad := range auditDenials {
+			totalAuditDenials += ad.Count
+		}
+		statMessages = append(statMessages, validate.Message{
+			Success: true,
+			Message: fmt.Sprintf("Envoy has detected %v connection(s) or request(s) that intentions in audit mode would deny", totalAuditDenials),
+		})
+	}
 	return statMessages, nil
 }
 
+// GetAuditDenials returns the number of connections and requests that each
+// RBAC filter of the proxy would deny if the intentions in audit mode were
+// enforced. Filters without intentions in audit mode are not returned.
+func (t *Troubleshoot) GetAuditDenials() ([]AuditDenial, error) {
+	stats, err := t.getEnvoyStats(shadowDeniedStat)
+	if err != nil {
+		return nil, fmt.Errorf("could not get audit denial stats from envoy admin API: %w", err)
+	}
+	return auditDenialsFromStats(stats), nil
+}
+
+func auditDenialsFromStats(stats []*envoy_admin_v3.SimpleMetric) []AuditDenial {
+	var denials []AuditDenial
+	for _, s := range stats {
+		if !strings.HasSuffix(s.Name, "."+shadowDeniedStat) {
+			continue
+		}
+		denials = append(denials, AuditDenial{
+			StatPrefix: strings.TrimSuffix(s.Name, "."+shadowDeniedStat),
+			Count:      s.Value,
+		})
+	}
+	sort.Slice(denials, func(i, j int) bool {
+		return denials[i].StatPrefix < denials[j].StatPrefix
+	})
+	return denials
+}
+
 func (t *Troubleshoot) getEnvoyStats(filter string) ([]*envoy_admin_v3.SimpleMetric, error) {
 
 	var resultErr error
@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package troubleshoot
+
+import (
+	"testing"
+
+	envoy_admin_v3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditDenialsFromStats(t *testing.T) {
+	stats := []*envoy_admin_v3.SimpleMetric{
+		{Name: "http.public_listener.rbac.shadow_denied", Value: 3},
+		{Name: "connect_authz.rbac.shadow_denied", Value: 0},
+		{Name: "http.public_listener.rbac.shadow_denied_total", Value: 7},
+	}
+
+	expected := []AuditDenial{
+		{StatPrefix: "connect_authz.rbac", Count: 0},
+		{StatPrefix: "http.public_listener.rbac", Count: 3},
+	}
+	require.Equal(t, expected, auditDenialsFromStats(stats))
+	require.Nil(t, auditDenialsFromStats(nil))
+}